@@ -3,7 +3,7 @@
 data "metabase_collection_graph" "current" {}
 
 # You can specify groups to ignore when reading the graph.
-# By default, the Administrators group (ID 2) is ignored.
+# By default, the Administrators group is ignored.
 data "metabase_collection_graph" "with_ignored_groups" {
   ignored_groups = [2]
 }
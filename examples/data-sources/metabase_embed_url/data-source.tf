@@ -0,0 +1,28 @@
+resource "metabase_dashboard" "customer_dashboard" {
+  name             = "📊 Customer dashboard"
+  cards_json       = jsonencode([])
+  enable_embedding = true
+  embedding_params = {
+    customer_id = "locked"
+    date        = "enabled"
+  }
+}
+
+variable "metabase_embedding_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+data "metabase_embed_url" "customer_dashboard" {
+  dashboard_id = metabase_dashboard.customer_dashboard.id
+  secret_key   = var.metabase_embedding_secret_key
+  params_json  = jsonencode({ customer_id = 42 })
+  expires_at   = timeadd(plantimestamp(), "10m")
+  bordered     = false
+  titled       = true
+}
+
+output "customer_dashboard_url" {
+  value     = data.metabase_embed_url.customer_dashboard.url
+  sensitive = true
+}
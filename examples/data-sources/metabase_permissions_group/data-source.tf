@@ -0,0 +1,8 @@
+data "metabase_permissions_group" "analysts" {
+  name = "Analysts"
+}
+
+resource "metabase_permissions_group_membership" "analyst" {
+  group_id = data.metabase_permissions_group.analysts.id
+  user_id  = metabase_user.analyst.id
+}
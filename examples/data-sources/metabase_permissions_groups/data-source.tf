@@ -0,0 +1,8 @@
+data "metabase_permissions_groups" "teams" {
+  names = ["Analysts", "Engineers"]
+}
+
+resource "metabase_permissions_group_membership" "analyst" {
+  group_id = data.metabase_permissions_groups.teams.ids["Analysts"]
+  user_id  = metabase_user.analyst.id
+}
@@ -0,0 +1,8 @@
+data "metabase_revisions" "dashboard" {
+  entity    = "dashboard"
+  entity_id = metabase_dashboard.some_great_dashboard.id
+}
+
+output "last_dashboard_change" {
+  value = data.metabase_revisions.dashboard.revisions[0]
+}
@@ -0,0 +1,8 @@
+data "metabase_user" "analyst" {
+  email = "analyst@example.com"
+}
+
+resource "metabase_permissions_group_membership" "analyst" {
+  group_id = metabase_permissions_group.analysts.id
+  user_id  = data.metabase_user.analyst.id
+}
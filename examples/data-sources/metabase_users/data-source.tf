@@ -0,0 +1,8 @@
+data "metabase_users" "analysts" {
+  group_id = data.metabase_permissions_group.analysts.id
+  status   = "all"
+}
+
+output "analyst_emails" {
+  value = data.metabase_users.analysts.users[*].email
+}
@@ -0,0 +1,61 @@
+data "metabase_virtual_dashcard" "section_heading" {
+  type = "heading"
+  text = "📈 Key metrics"
+}
+
+data "metabase_virtual_dashcard" "documentation" {
+  type       = "text"
+  text       = "# About this dashboard\n\nMetrics are refreshed every day."
+  background = false
+}
+
+data "metabase_virtual_dashcard" "handbook_link" {
+  type = "link"
+  url  = "https://www.metabase.com/docs/latest/"
+}
+
+resource "metabase_dashboard" "dashboard" {
+  name = "📊 Documented dashboard"
+
+  dashcard {
+    col                         = 0
+    row                         = 0
+    size_x                      = 24
+    size_y                      = 1
+    visualization_settings_json = data.metabase_virtual_dashcard.section_heading.visualization_settings_json
+  }
+
+  dashcard {
+    col                         = 0
+    row                         = 1
+    size_x                      = 18
+    size_y                      = 3
+    visualization_settings_json = data.metabase_virtual_dashcard.documentation.visualization_settings_json
+  }
+
+  dashcard {
+    col                         = 18
+    row                         = 1
+    size_x                      = 6
+    size_y                      = 1
+    visualization_settings_json = data.metabase_virtual_dashcard.handbook_link.visualization_settings_json
+  }
+}
+
+# The settings can also be decoded and used in `cards_json`.
+resource "metabase_dashboard" "json_dashboard" {
+  name = "📊 Dashboard using JSON"
+
+  cards_json = jsonencode([
+    {
+      card_id                = null
+      col                    = 0
+      row                    = 0
+      size_x                 = 24
+      size_y                 = 1
+      series                 = []
+      parameter_mappings     = []
+      visualization_settings = jsondecode(data.metabase_virtual_dashcard.section_heading.visualization_settings_json)
+    }
+  ])
+}
@@ -0,0 +1,52 @@
+# Actions must be enabled on the database of the model.
+resource "metabase_action" "update_order" {
+  model_id = metabase_card.orders_model.id
+  name     = "Update order"
+  type     = "implicit"
+  kind     = "row/update"
+}
+
+resource "metabase_action" "approve_order" {
+  model_id    = metabase_card.orders_model.id
+  name        = "Approve order"
+  description = "Marks the order as approved."
+  type        = "query"
+  database_id = metabase_database.warehouse.id
+  query       = "UPDATE orders SET status = 'approved', comment = {{comment}} WHERE id = {{order_id}}"
+
+  parameter {
+    name     = "order_id"
+    type     = "number"
+    required = true
+  }
+
+  parameter {
+    name         = "comment"
+    display_name = "Approval comment"
+    type         = "text"
+  }
+
+  visualization_settings_json = jsonencode({
+    submitButtonLabel = "Approve"
+    successMessage    = "The order has been approved."
+  })
+}
+
+# The action can be run from a button on a dashboard.
+data "metabase_virtual_dashcard" "approve_button" {
+  type = "action"
+  text = "Approve order"
+}
+
+resource "metabase_dashboard" "orders" {
+  name = "Orders"
+
+  dashcard {
+    action_id                   = metabase_action.approve_order.id
+    row                         = 0
+    col                         = 0
+    size_x                      = 4
+    size_y                      = 1
+    visualization_settings_json = data.metabase_virtual_dashcard.approve_button.visualization_settings_json
+  }
+}
@@ -0,0 +1,2 @@
+# Use the integer ID of the alert from the Metabase API.
+terraform import metabase_alert.alert 1
@@ -0,0 +1,29 @@
+# Notifies the data team on Slack whenever the card returns rows, checking every hour.
+resource "metabase_alert" "failed_jobs" {
+  card_id   = metabase_card.failed_jobs.id
+  condition = "rows"
+
+  schedule = {
+    type = "hourly"
+  }
+
+  slack = {
+    channel = "#data-ops"
+  }
+}
+
+# Sends an email the first time daily revenue goes below the goal line of the card.
+resource "metabase_alert" "revenue_below_goal" {
+  card_id          = metabase_card.daily_revenue.id
+  condition        = "goal_below"
+  first_alert_only = true
+
+  schedule = {
+    type = "daily"
+    hour = 9
+  }
+
+  email = {
+    group_ids = [metabase_permissions_group.finance.id]
+  }
+}
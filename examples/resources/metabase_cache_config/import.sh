@@ -0,0 +1,3 @@
+# Use the model type and the ID of the model, or only `root` for the default configuration.
+terraform import metabase_cache_config.daily_dashboard dashboard/1
+terraform import metabase_cache_config.default root
@@ -0,0 +1,31 @@
+# By default, results are cached for 10 times the average query duration.
+resource "metabase_cache_config" "default" {
+  model           = "root"
+  strategy        = "ttl"
+  multiplier      = 10
+  min_duration_ms = 1000
+}
+
+# Results of the dashboard are cached for a day.
+resource "metabase_cache_config" "daily_dashboard" {
+  model    = "dashboard"
+  model_id = metabase_dashboard.some_great_dashboard.id
+  strategy = "duration"
+  duration = 24
+  unit     = "hours"
+}
+
+# The cache of the database is invalidated every hour, after the data is refreshed.
+resource "metabase_cache_config" "warehouse" {
+  model    = "database"
+  model_id = metabase_database.warehouse.id
+  strategy = "schedule"
+  schedule = "0 0 * * * ? *"
+}
+
+# Results of the question are never cached.
+resource "metabase_cache_config" "live_question" {
+  model    = "question"
+  model_id = metabase_card.live_metrics.id
+  strategy = "nocache"
+}
@@ -0,0 +1,16 @@
+# The permissions of the group are managed by this resource, so the group is ignored by the collection graph resource
+# (if any) managed elsewhere.
+resource "metabase_collection_permissions" "data_analysts" {
+  group_id = metabase_permissions_group.data_analysts.id
+
+  permissions = [
+    {
+      collection = "root"
+      permission = "read"
+    },
+    {
+      collection_path = "Marketing/Reports"
+      permission      = "write"
+    },
+  ]
+}
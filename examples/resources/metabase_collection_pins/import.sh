@@ -0,0 +1,2 @@
+# Use the ID of the collection, or `root` for the root collection.
+terraform import metabase_collection_pins.business_reports 1
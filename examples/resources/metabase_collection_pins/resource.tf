@@ -0,0 +1,9 @@
+resource "metabase_collection_pins" "business_reports" {
+  collection_id = metabase_collection.business_reports.id
+
+  # Items are displayed in this order. Any other pinned item of the collection is unpinned.
+  items = [
+    { dashboard_id = metabase_dashboard.some_great_dashboard.id },
+    { card_id = metabase_card.some_great_insights.id },
+  ]
+}
@@ -87,3 +87,75 @@ resource "metabase_dashboard" "some_great_dashboard" {
     }
   ])
 }
+
+resource "metabase_dashboard" "dashboard_with_blocks" {
+  name = "📊 Dashboard defined with blocks"
+
+  parameter {
+    name         = "Date range"
+    slug         = "date_filter"
+    type         = "date/all-options"
+    section_id   = "date"
+    default_json = jsonencode("past30days")
+  }
+
+  tab {
+    name = "Overview"
+  }
+
+  tab {
+    name = "Details"
+  }
+
+  dashcard {
+    tab    = "Overview"
+    col    = 0
+    row    = 0
+    size_x = 24
+    size_y = 2
+    # Virtual cards can also be defined using the `metabase_virtual_dashcard` data source.
+    visualization_settings_json = jsonencode({
+      virtual_card = {
+        name                   = null
+        display                = "heading"
+        visualization_settings = {}
+        dataset_query          = {}
+        archived               = false
+      }
+      text = "Some great insights"
+    })
+  }
+
+  dashcard {
+    card_id = metabase_card.some_great_insights.id
+    tab     = "Overview"
+    col     = 0
+    row     = 2
+    size_x  = 12
+    size_y  = 6
+
+    parameter_mappings = [
+      {
+        parameter = "Date range"
+        target_json = jsonencode([
+          "dimension",
+          ["field", data.metabase_table.table.fields["filter_date_column"], null]
+        ])
+      }
+    ]
+
+    # Clicking the card opens the other dashboard, and sets its date filter from the clicked row.
+    click_behavior = [
+      {
+        action    = "dashboard"
+        target_id = metabase_dashboard.some_great_dashboard.id
+        parameter_mappings = [
+          {
+            source_column = "filter_date_column"
+            parameter_id  = "83e68ca2"
+          }
+        ]
+      }
+    ]
+  }
+}
@@ -0,0 +1,24 @@
+resource "metabase_collection" "regions" {
+  for_each = toset(["EMEA", "AMER", "APAC"])
+
+  name = "🌍 ${each.key}"
+}
+
+# The template dashboard is copied for each region, along with its cards.
+resource "metabase_dashboard_copy" "kpis" {
+  for_each = metabase_collection.regions
+
+  source_dashboard_id = metabase_dashboard.kpi_template.id
+  is_deep_copy        = true
+  name                = "📈 ${each.key} KPIs"
+  collection_id       = each.value.id
+
+  parameter_defaults = {
+    region = jsonencode([each.key])
+  }
+}
+
+# The copied cards can be referenced using the IDs of the template cards.
+output "emea_revenue_card_id" {
+  value = metabase_dashboard_copy.kpis["EMEA"].card_ids[metabase_card.revenue.id]
+}
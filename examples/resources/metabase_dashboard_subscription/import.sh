@@ -0,0 +1,2 @@
+# Use the integer ID of the subscription (pulse) from the Metabase API.
+terraform import metabase_dashboard_subscription.subscription 1
@@ -0,0 +1,48 @@
+resource "metabase_dashboard_subscription" "weekly_exec" {
+  dashboard_id = metabase_dashboard.exec.id
+  name         = "📬 Weekly exec report"
+
+  # Keys are the IDs of the dashboard parameters. When using `parameter` blocks, they default to the slug.
+  parameter_values = {
+    region = jsonencode(["EMEA"])
+  }
+
+  # Sent every Monday at 8am.
+  schedule = {
+    type = "weekly"
+    hour = 8
+    day  = "mon"
+  }
+
+  email = {
+    user_ids  = [metabase_user.ceo.id]
+    group_ids = [metabase_permissions_group.execs.id]
+    emails    = ["board@example.com"]
+  }
+
+  slack = {
+    channel = "#exec"
+  }
+
+  attachments = {
+    format   = "xlsx"
+    card_ids = [metabase_card.revenue.id]
+  }
+}
+
+resource "metabase_dashboard_subscription" "monthly_ops" {
+  dashboard_id  = metabase_dashboard.ops.id
+  skip_if_empty = true
+
+  # Sent on the first Monday of every month.
+  schedule = {
+    type  = "monthly"
+    hour  = 6
+    frame = "first"
+    day   = "mon"
+  }
+
+  slack = {
+    channel = "#data-ops"
+  }
+}
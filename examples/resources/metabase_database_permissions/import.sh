@@ -0,0 +1,2 @@
+# Use the integer ID of the permissions group from the Metabase API.
+terraform import metabase_database_permissions.data_analysts 3
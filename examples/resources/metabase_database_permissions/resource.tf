@@ -0,0 +1,13 @@
+# The permissions of the group are managed by this resource, so the group is ignored by the permissions graph resource
+# (if any) managed elsewhere.
+resource "metabase_database_permissions" "data_analysts" {
+  group_id = metabase_permissions_group.data_analysts.id
+
+  permissions = [
+    {
+      database_name  = "Warehouse"
+      view_data      = "unrestricted"
+      create_queries = "query-builder-and-native"
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Use the integer ID from the Metabase API.
+terraform import metabase_impersonation.analysts_warehouse 1
@@ -0,0 +1,6 @@
+# Queries of the analysts on the warehouse are run using the database role in their `db_role` attribute.
+resource "metabase_impersonation" "analysts_warehouse" {
+  group_id    = metabase_permissions_group.analysts.id
+  database_id = metabase_database.warehouse.id
+  attribute   = "db_role"
+}
@@ -31,14 +31,29 @@ resource "metabase_permissions_graph" "graph" {
       group    = metabase_permissions_group.business_stakeholders.id
       database = metabase_database.bigquery.id
       # This looks like no other value can be set, at least in the free version of Metabase.
-      view_data      = "unrestricted"
-      create_queries = "query-builder"
+      view_data = "unrestricted"
+      # Queries can be created on a single dataset, and on some tables of another one.
+      schema = [
+        {
+          name           = "finance"
+          create_queries = "query-builder"
+        },
+        {
+          name = "marketing"
+          table = [
+            {
+              id             = 42
+              create_queries = "query-builder"
+            },
+          ]
+        },
+      ]
     },
     # Permissions for the "All Users" group. Those cannot be removed entirely, but they can be limited.
     # The example below gives the minimum set of permissions for the free version of Metabase:
     {
-      group    = 1 # ID for the "All Users" group.
-      database = metabase_database.bigquery.id
+      group_name = "All Users"
+      database   = metabase_database.bigquery.id
       # Cannot be removed but has no impact when using the free version of Metabase.
       download = {
         schemas = "full"
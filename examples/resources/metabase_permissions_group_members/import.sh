@@ -0,0 +1,2 @@
+# Use the integer ID of the permissions group from the Metabase API.
+terraform import metabase_permissions_group_members.analysts 3
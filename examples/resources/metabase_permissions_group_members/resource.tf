@@ -0,0 +1,5 @@
+resource "metabase_permissions_group_members" "analysts" {
+  group_id    = metabase_permissions_group.analysts.id
+  user_ids    = [metabase_user.alice.id, metabase_user.bob.id]
+  manager_ids = [metabase_user.alice.id]
+}
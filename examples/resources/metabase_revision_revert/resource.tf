@@ -0,0 +1,11 @@
+data "metabase_revisions" "card" {
+  entity    = "card"
+  entity_id = 42
+}
+
+# Reverts the card to the revision preceding the last change.
+resource "metabase_revision_revert" "card" {
+  entity      = "card"
+  entity_id   = 42
+  revision_id = data.metabase_revisions.card.revisions[1].id
+}
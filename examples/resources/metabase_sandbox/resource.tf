@@ -0,0 +1,29 @@
+# Members of the group only view the orders of their own region, based on the `region` user attribute.
+resource "metabase_sandbox" "orders_by_region" {
+  group_id = metabase_permissions_group.regional_managers.id
+  table_id = metabase_table.orders.id
+
+  attribute_remappings = {
+    "region" = {
+      field_id = metabase_table.orders.fields["region"]
+    }
+  }
+
+  # The view data permission of the group on the table must be `sandboxed`.
+  depends_on = [metabase_permissions_graph.graph]
+}
+
+# The rows are filtered using a native question, with a variable set from the `account_id` user attribute.
+resource "metabase_sandbox" "accounts" {
+  group_id = metabase_permissions_group.customers.id
+  table_id = metabase_table.accounts.id
+  card_id  = metabase_card.customer_accounts.id
+
+  attribute_remappings = {
+    "account_id" = {
+      template_tag = "account_id"
+    }
+  }
+
+  depends_on = [metabase_permissions_graph.graph]
+}
@@ -0,0 +1,6 @@
+resource "metabase_timeline" "releases" {
+  name          = "🚀 Releases"
+  description   = "Releases of the product."
+  icon          = "balloons"
+  collection_id = metabase_collection.business_reports.id
+}
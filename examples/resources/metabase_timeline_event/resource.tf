@@ -0,0 +1,16 @@
+resource "metabase_timeline_event" "v2" {
+  timeline_id  = metabase_timeline.releases.id
+  name         = "v2.0"
+  description  = "Major release, with the new onboarding."
+  timestamp    = "2025-01-15T14:30:00+01:00"
+  time_matters = true
+  timezone     = "Europe/Paris"
+}
+
+# Events for which only the date matters can be defined using a date.
+resource "metabase_timeline_event" "incident" {
+  timeline_id = metabase_timeline.releases.id
+  name        = "Payment outage"
+  timestamp   = "2025-02-03"
+  icon        = "warning"
+}
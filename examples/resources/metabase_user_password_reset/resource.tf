@@ -0,0 +1,8 @@
+# Sends a password reset email to the user. Changing the date sends the email again.
+resource "metabase_user_password_reset" "jane" {
+  user_id = metabase_user.jane.id
+
+  triggers = {
+    date = "2026-01-15"
+  }
+}
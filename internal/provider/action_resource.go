@@ -0,0 +1,579 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &ActionResource{}
+var _ resource.ResourceWithValidateConfig = &ActionResource{}
+
+// Creates a new action resource.
+func NewActionResource() resource.Resource {
+	return &ActionResource{
+		MetabaseBaseResource{name: "action"},
+	}
+}
+
+// A resource handling a Metabase action, which writes to the database of a model.
+type ActionResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for an action.
+type ActionResourceModel struct {
+	Id                        types.Int64  `tfsdk:"id"`                          // The ID of the action.
+	ModelId                   types.Int64  `tfsdk:"model_id"`                    // The ID of the model on which the action is defined.
+	Name                      types.String `tfsdk:"name"`                        // The name of the action.
+	Description               types.String `tfsdk:"description"`                 // A description for the action.
+	Type                      types.String `tfsdk:"type"`                        // Either `implicit` or `query`.
+	Kind                      types.String `tfsdk:"kind"`                        // For implicit actions, the kind of action.
+	DatabaseId                types.Int64  `tfsdk:"database_id"`                 // For query actions, the database on which the query is run.
+	Query                     types.String `tfsdk:"query"`                       // For query actions, the SQL query.
+	Parameters                types.List   `tfsdk:"parameter"`                   // For query actions, the parameters of the query.
+	VisualizationSettingsJson types.String `tfsdk:"visualization_settings_json"` // The settings of the action form, as a JSON string.
+}
+
+// The Terraform model for a single `parameter` block in an action.
+type ActionParameterModel struct {
+	Name        types.String `tfsdk:"name"`         // The name of the template tag in the query.
+	DisplayName types.String `tfsdk:"display_name"` // The name displayed in the action form.
+	Type        types.String `tfsdk:"type"`         // The type of the template tag.
+	Required    types.Bool   `tfsdk:"required"`     // Whether the parameter is required.
+}
+
+// The object type definition for the `ActionParameterModel` model.
+var actionParameterObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":         types.StringType,
+		"display_name": types.StringType,
+		"type":         types.StringType,
+		"required":     types.BoolType,
+	},
+}
+
+// The kinds of implicit actions supported by Metabase.
+var implicitActionKinds = []string{"row/create", "row/delete", "row/update"}
+
+// The parameter type corresponding to each type of template tag.
+var actionParameterTypes = map[string]string{
+	"date":   "date/single",
+	"number": "number/=",
+	"text":   "string/=",
+}
+
+func (r *ActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A Metabase action, which writes to the database of a model and can be run from a form or a dashboard button.
+
+Actions must be enabled on the database of the model. An action can either be an ` + "`implicit`" + ` action, which creates, updates or deletes a row of the model, or a ` + "`query`" + ` action running custom SQL. Buttons running an action can be placed on a dashboard by setting the ` + "`action_id`" + ` of a dashcard.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the action.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"model_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the model (a `metabase_card` with the `model` type) on which the action is defined.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the action.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description for the action.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of action, either `implicit` or `query`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "The kind of implicit action, one of `row/create`, `row/delete`, or `row/update`. Required for `implicit` actions.",
+				Optional:            true,
+			},
+			"database_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the database on which the query is run. Required for `query` actions.",
+				Optional:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The SQL query run by the action, which can reference parameters using `{{name}}`. Required for `query` actions.",
+				Optional:            true,
+			},
+			"visualization_settings_json": schema.StringAttribute{
+				MarkdownDescription: "The settings of the action form (e.g. the submit button label, the confirmation and success messages, and the settings of each field), as a JSON string. If not set, the form settings are not managed by Terraform.",
+				Optional:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"parameter": schema.ListNestedBlock{
+				MarkdownDescription: "A parameter referenced in the query of a `query` action, which is displayed as a field of the action form.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the parameter, as referenced in the query.",
+							Required:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "The name displayed in the action form. Defaults to the `name`.",
+							Optional:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the parameter, one of `date`, `number`, or `text`.",
+							Required:            true,
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Whether the parameter is required.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ActionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ActionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateActionModel(ctx, data)...)
+}
+
+// Validates that the attributes set in the model are consistent with the type of action.
+func validateActionModel(ctx context.Context, data ActionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Type.IsUnknown() {
+		return diags
+	}
+
+	switch data.Type.ValueString() {
+	case "implicit":
+		if data.Kind.IsNull() {
+			diags.AddAttributeError(path.Root("kind"), "Missing implicit action kind.", "The kind attribute is required for implicit actions.")
+		} else if !data.Kind.IsUnknown() && !slices.Contains(implicitActionKinds, data.Kind.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("kind"),
+				"Unsupported implicit action kind.",
+				fmt.Sprintf("Got %q, expected one of: %s.", data.Kind.ValueString(), strings.Join(implicitActionKinds, ", ")),
+			)
+		}
+
+		for name, value := range map[string]attr.Value{"database_id": data.DatabaseId, "query": data.Query} {
+			if !value.IsNull() {
+				diags.AddAttributeError(path.Root(name), "Unsupported attribute for implicit actions.", fmt.Sprintf("The %s attribute can only be set for query actions.", name))
+			}
+		}
+		if !data.Parameters.IsUnknown() && len(data.Parameters.Elements()) > 0 {
+			diags.AddAttributeError(path.Root("parameter"), "Unsupported attribute for implicit actions.", "Parameters of implicit actions are derived from the columns of the model.")
+		}
+	case "query":
+		if data.DatabaseId.IsNull() {
+			diags.AddAttributeError(path.Root("database_id"), "Missing database ID.", "The database_id attribute is required for query actions.")
+		}
+		if data.Query.IsNull() {
+			diags.AddAttributeError(path.Root("query"), "Missing query.", "The query attribute is required for query actions.")
+		}
+		if !data.Kind.IsNull() {
+			diags.AddAttributeError(path.Root("kind"), "Unsupported attribute for query actions.", "The kind attribute can only be set for implicit actions.")
+		}
+
+		if data.Parameters.IsUnknown() {
+			return diags
+		}
+
+		var parameters []ActionParameterModel
+		diags.Append(data.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for i, p := range parameters {
+			if p.Type.IsUnknown() {
+				continue
+			}
+
+			if _, ok := actionParameterTypes[p.Type.ValueString()]; !ok {
+				diags.AddAttributeError(
+					path.Root("parameter").AtListIndex(i).AtName("type"),
+					"Unsupported parameter type.",
+					fmt.Sprintf("Got %q, expected one of: date, number, text.", p.Type.ValueString()),
+				)
+			}
+		}
+	default:
+		diags.AddAttributeError(path.Root("type"), "Unsupported action type.", fmt.Sprintf("Got %q, expected one of: implicit, query.", data.Type.ValueString()))
+	}
+
+	return diags
+}
+
+// Makes the native dataset query and the parameters of a query action from the Terraform model. The ID of each
+// parameter is its name, which is also used to reference it in the query.
+func makeActionQueryFromModel(ctx context.Context, data ActionResourceModel) (*map[string]any, *[]metabase.ActionParameter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var parameterModels []ActionParameterModel
+	diags.Append(data.Parameters.ElementsAs(ctx, &parameterModels, false)...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	templateTags := make(map[string]any, len(parameterModels))
+	parameters := make([]metabase.ActionParameter, 0, len(parameterModels))
+	for _, p := range parameterModels {
+		name := p.Name.ValueString()
+		displayName := name
+		if !p.DisplayName.IsNull() {
+			displayName = p.DisplayName.ValueString()
+		}
+		required := p.Required.ValueBool()
+
+		templateTags[name] = map[string]any{
+			"id":           name,
+			"name":         name,
+			"display-name": displayName,
+			"type":         p.Type.ValueString(),
+			"required":     required,
+		}
+
+		target := []any{"variable", []any{"template-tag", name}}
+		parameters = append(parameters, metabase.ActionParameter{
+			Id:       name,
+			Name:     &displayName,
+			Slug:     &name,
+			Type:     actionParameterTypes[p.Type.ValueString()],
+			Target:   &target,
+			Required: &required,
+		})
+	}
+
+	datasetQuery := map[string]any{
+		"type":     "native",
+		"database": data.DatabaseId.ValueInt64(),
+		"native": map[string]any{
+			"query":         data.Query.ValueString(),
+			"template-tags": templateTags,
+		},
+	}
+
+	return &datasetQuery, &parameters, diags
+}
+
+// Makes the `parameter` blocks from the template tags of the query returned by the Metabase API. The order of the
+// parameters of the action is used, and the existing blocks are used to avoid spurious diffs in default values.
+func makeActionParametersValue(ctx context.Context, a metabase.Action, existing types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	existingParameters := map[string]ActionParameterModel{}
+	if !existing.IsNull() && !existing.IsUnknown() {
+		var existingList []ActionParameterModel
+		diags.Append(existing.ElementsAs(ctx, &existingList, false)...)
+		if diags.HasError() {
+			return types.ListNull(actionParameterObjectType), diags
+		}
+
+		for _, p := range existingList {
+			existingParameters[p.Name.ValueString()] = p
+		}
+	}
+
+	var templateTags map[string]any
+	if a.DatasetQuery != nil {
+		native, _ := (*a.DatasetQuery)["native"].(map[string]any)
+		templateTags, _ = native["template-tags"].(map[string]any)
+	}
+
+	parameterIds := []string{}
+	if a.Parameters != nil {
+		for _, p := range *a.Parameters {
+			parameterIds = append(parameterIds, p.Id)
+		}
+	}
+
+	parameters := make([]ActionParameterModel, 0, len(templateTags))
+	for _, tag := range templateTags {
+		rawTag, ok := tag.(map[string]any)
+		if !ok {
+			diags.AddError("Could not parse template tag as object.", fmt.Sprint(tag))
+			return types.ListNull(actionParameterObjectType), diags
+		}
+
+		name, _ := rawTag["name"].(string)
+		tagType, _ := rawTag["type"].(string)
+		displayName, _ := rawTag["display-name"].(string)
+		required, _ := rawTag["required"].(bool)
+
+		p := ActionParameterModel{
+			Name:        types.StringValue(name),
+			DisplayName: types.StringValue(displayName),
+			Type:        types.StringValue(tagType),
+			Required:    types.BoolValue(required),
+		}
+
+		// Default values are left null if they were not specified.
+		existingParameter, ok := existingParameters[name]
+		if !ok || existingParameter.DisplayName.IsNull() {
+			if displayName == name {
+				p.DisplayName = types.StringNull()
+			}
+		}
+		if !ok || existingParameter.Required.IsNull() {
+			if !required {
+				p.Required = types.BoolNull()
+			}
+		}
+
+		parameters = append(parameters, p)
+	}
+
+	// Template tags are sorted according to the parameters of the action, which preserve the order of the blocks.
+	slices.SortStableFunc(parameters, func(a ActionParameterModel, b ActionParameterModel) int {
+		indexA := slices.Index(parameterIds, a.Name.ValueString())
+		indexB := slices.Index(parameterIds, b.Name.ValueString())
+		if indexA == indexB {
+			return strings.Compare(a.Name.ValueString(), b.Name.ValueString())
+		}
+
+		return indexA - indexB
+	})
+
+	list, listDiags := types.ListValueFrom(ctx, actionParameterObjectType, parameters)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// Updates the given `ActionResourceModel` from the `Action` returned by the Metabase API.
+func updateModelFromAction(ctx context.Context, a metabase.Action, data *ActionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.Int64Value(int64(a.Id))
+	data.ModelId = types.Int64Value(int64(a.ModelId))
+	data.Name = types.StringValue(a.Name)
+	data.Description = stringValueOrNull(a.Description)
+	data.Type = types.StringValue(string(a.Type))
+	data.Kind = stringValueOrNull(a.Kind)
+	data.DatabaseId = types.Int64Null()
+	data.Query = types.StringNull()
+
+	if a.Type == metabase.Query {
+		data.DatabaseId = int64ValueOrNull(a.DatabaseId)
+
+		if a.DatasetQuery != nil {
+			native, _ := (*a.DatasetQuery)["native"].(map[string]any)
+			if query, ok := native["query"].(string); ok {
+				data.Query = types.StringValue(query)
+			}
+		}
+	}
+
+	parameters, parametersDiags := makeActionParametersValue(ctx, a, data.Parameters)
+	diags.Append(parametersDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Parameters = parameters
+
+	// The form settings are only managed if they are set.
+	if !data.VisualizationSettingsJson.IsNull() {
+		var settings any = map[string]any{}
+		if a.VisualizationSettings != nil {
+			settings = *a.VisualizationSettings
+		}
+
+		if !jsonStringEqualsValue(data.VisualizationSettingsJson.ValueString(), settings) {
+			settingsBytes, err := json.Marshal(settings)
+			if err != nil {
+				diags.AddError("Error serializing action visualization settings.", err.Error())
+				return diags
+			}
+
+			data.VisualizationSettingsJson = types.StringValue(string(settingsBytes))
+		}
+	}
+
+	return diags
+}
+
+// Makes the body of a create or update request from the Terraform model.
+func makeActionBodyFromModel(ctx context.Context, data ActionResourceModel) (*metabase.UpdateActionBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := data.Name.ValueString()
+	actionType := metabase.ActionType(data.Type.ValueString())
+	modelId := int(data.ModelId.ValueInt64())
+	body := metabase.UpdateActionBody{
+		Name:        &name,
+		Description: valueStringOrNull(data.Description),
+		Type:        &actionType,
+		ModelId:     &modelId,
+		Kind:        valueStringOrNull(data.Kind),
+	}
+
+	if actionType == metabase.Query {
+		datasetQuery, parameters, queryDiags := makeActionQueryFromModel(ctx, data)
+		diags.Append(queryDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		body.DatabaseId = valueInt64OrNull(data.DatabaseId)
+		body.DatasetQuery = datasetQuery
+		body.Parameters = parameters
+	}
+
+	if !data.VisualizationSettingsJson.IsNull() {
+		var settings map[string]any
+		err := json.Unmarshal([]byte(data.VisualizationSettingsJson.ValueString()), &settings)
+		if err != nil {
+			diags.AddAttributeError(path.Root("visualization_settings_json"), "Unable to parse action visualization settings JSON.", err.Error())
+			return nil, diags
+		}
+
+		body.VisualizationSettings = &settings
+	}
+
+	return &body, diags
+}
+
+func (r *ActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ActionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := makeActionBodyFromModel(ctx, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, err := r.client.CreateActionWithResponse(ctx, metabase.CreateActionBody{
+		Name:                  *body.Name,
+		Description:           body.Description,
+		Type:                  *body.Type,
+		Kind:                  body.Kind,
+		ModelId:               *body.ModelId,
+		DatabaseId:            body.DatabaseId,
+		DatasetQuery:          body.DatasetQuery,
+		Parameters:            body.Parameters,
+		VisualizationSettings: body.VisualizationSettings,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200}, "create action")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromAction(ctx, *createResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ActionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.GetActionWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get action")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if getResp.StatusCode() == 404 || (getResp.JSON200.Archived != nil && *getResp.JSON200.Archived) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromAction(ctx, *getResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ActionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := makeActionBodyFromModel(ctx, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResp, err := r.client.UpdateActionWithResponse(ctx, int(data.Id.ValueInt64()), *body)
+
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update action")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromAction(ctx, *updateResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ActionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResp, err := r.client.DeleteActionWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(deleteResp, err, []int{204, 404}, "delete action")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ActionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIntegerId(ctx, req, resp)
+}
@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func makeTestActionParameters(t *testing.T, parameters ...ActionParameterModel) types.List {
+	list, diags := types.ListValueFrom(context.Background(), actionParameterObjectType, parameters)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	return list
+}
+
+func TestValidateActionModel(t *testing.T) {
+	ctx := context.Background()
+
+	implicit := ActionResourceModel{
+		Type:       types.StringValue("implicit"),
+		Kind:       types.StringValue("row/update"),
+		DatabaseId: types.Int64Null(),
+		Query:      types.StringNull(),
+		Parameters: makeTestActionParameters(t),
+	}
+	query := ActionResourceModel{
+		Type:       types.StringValue("query"),
+		Kind:       types.StringNull(),
+		DatabaseId: types.Int64Value(2),
+		Query:      types.StringValue("UPDATE orders SET status = 'approved' WHERE id = {{order_id}}"),
+		Parameters: makeTestActionParameters(t, ActionParameterModel{
+			Name:        types.StringValue("order_id"),
+			DisplayName: types.StringNull(),
+			Type:        types.StringValue("number"),
+			Required:    types.BoolValue(true),
+		}),
+	}
+
+	tests := []struct {
+		name     string
+		data     func() ActionResourceModel
+		hasError bool
+	}{
+		{name: "valid implicit", data: func() ActionResourceModel { return implicit }},
+		{name: "valid query", data: func() ActionResourceModel { return query }},
+		{
+			name: "implicit without kind",
+			data: func() ActionResourceModel {
+				d := implicit
+				d.Kind = types.StringNull()
+				return d
+			},
+			hasError: true,
+		},
+		{
+			name: "unsupported kind",
+			data: func() ActionResourceModel {
+				d := implicit
+				d.Kind = types.StringValue("row/upsert")
+				return d
+			},
+			hasError: true,
+		},
+		{
+			name: "implicit with query",
+			data: func() ActionResourceModel {
+				d := implicit
+				d.Query = query.Query
+				return d
+			},
+			hasError: true,
+		},
+		{
+			name: "query without database",
+			data: func() ActionResourceModel {
+				d := query
+				d.DatabaseId = types.Int64Null()
+				return d
+			},
+			hasError: true,
+		},
+		{
+			name: "unsupported parameter type",
+			data: func() ActionResourceModel {
+				d := query
+				d.Parameters = makeTestActionParameters(t, ActionParameterModel{
+					Name:        types.StringValue("order_id"),
+					DisplayName: types.StringNull(),
+					Type:        types.StringValue("boolean"),
+					Required:    types.BoolNull(),
+				})
+				return d
+			},
+			hasError: true,
+		},
+		{
+			name: "unsupported type",
+			data: func() ActionResourceModel {
+				d := query
+				d.Type = types.StringValue("http")
+				return d
+			},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateActionModel(ctx, tt.data())
+			if diags.HasError() != tt.hasError {
+				t.Errorf("validateActionModel() errors = %v, want error: %v", diags, tt.hasError)
+			}
+		})
+	}
+}
+
+func testAccActionResource(name string, actionName string, submitButtonLabel string) string {
+	// Actions are not supported by the H2 sample database, and are defined on a model of the Postgres database.
+	return testAccDatabaseResource(name, "⚡ Actions") + fmt.Sprintf(`
+resource "metabase_card" "%s" {
+  json = jsonencode({
+    name                = "⚡ Orders model"
+    description         = null
+    collection_id       = null
+    collection_position = null
+    cache_ttl           = null
+    type                = "model"
+    query_type          = "native"
+    dataset_query = {
+      database = metabase_database.%s.id
+      type     = "native"
+      native = {
+        query = "SELECT 1 AS id, 'pending' AS status"
+      }
+    }
+    parameter_mappings     = []
+    display                = "table"
+    visualization_settings = {}
+    parameters             = []
+  })
+}
+
+resource "metabase_action" "%s" {
+  model_id    = metabase_card.%s.id
+  name        = "%s"
+  description = "⚡ Approves an order."
+  type        = "query"
+  database_id = metabase_database.%s.id
+  query       = "UPDATE orders SET status = 'approved' WHERE id = {{order_id}}"
+
+  parameter {
+    name         = "order_id"
+    display_name = "Order ID"
+    type         = "number"
+    required     = true
+  }
+
+  visualization_settings_json = jsonencode({
+    submitButtonLabel = "%s"
+  })
+}
+`,
+		name,
+		name,
+		name,
+		name,
+		actionName,
+		name,
+		submitButtonLabel,
+	)
+}
+
+func testAccCheckActionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state.", resourceName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetActionWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 200 {
+			return fmt.Errorf("Received unexpected response from the Metabase API when getting action.")
+		}
+
+		if rs.Primary.Attributes["name"] != response.JSON200.Name {
+			return fmt.Errorf("Terraform resource and API response do not match for action name.")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckActionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "metabase_action" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetActionWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 404 {
+			return fmt.Errorf("Action %s still exists.", rs.Primary.ID)
+		}
+	}
+
+	return testAccCheckDatabaseDestroy(s)
+}
+
+// Enables actions on the database, which is a database setting not managed by `metabase_database`.
+func testAccEnableDatabaseActions(t *testing.T, databaseId *int) {
+	body := strings.NewReader(`{"settings":{"database-enable-actions":true}}`)
+	response, err := testAccMetabaseClient.UpdateDatabaseWithBodyWithResponse(context.Background(), *databaseId, "application/json", body)
+	if diags := checkMetabaseResponse(response, err, []int{200}, "enable database actions"); diags.HasError() {
+		t.Fatalf("Failed to enable database actions: %v.", diags)
+	}
+}
+
+// Changes the form of the action, as done in the Metabase UI.
+func testAccUpdateActionForm(t *testing.T, actionId *int, submitButtonLabel string) {
+	settings := map[string]any{"submitButtonLabel": submitButtonLabel}
+	response, err := testAccMetabaseClient.UpdateActionWithResponse(context.Background(), *actionId, metabase.UpdateActionBody{
+		VisualizationSettings: &settings,
+	})
+	if diags := checkMetabaseResponse(response, err, []int{200}, "update action"); diags.HasError() {
+		t.Fatalf("Failed to update the action form: %v.", diags)
+	}
+}
+
+func TestAccActionResource(t *testing.T) {
+	var databaseId, actionId int
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The database must exist before actions can be enabled on it.
+				Config: providerApiKeyConfig + testAccDatabaseResource("test_action", "⚡ Actions"),
+				Check:  testAccStoreResourceId("metabase_database.test_action", &databaseId),
+			},
+			{
+				PreConfig: func() { testAccEnableDatabaseActions(t, &databaseId) },
+				Config:    providerApiKeyConfig + testAccActionResource("test_action", "⚡ Approve order", "Approve"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActionExists("metabase_action.test_action"),
+					testAccStoreResourceId("metabase_action.test_action", &actionId),
+					resource.TestCheckResourceAttrPair("metabase_action.test_action", "model_id", "metabase_card.test_action", "id"),
+					resource.TestCheckResourceAttrPair("metabase_action.test_action", "database_id", "metabase_database.test_action", "id"),
+					resource.TestCheckResourceAttr("metabase_action.test_action", "type", "query"),
+					resource.TestCheckNoResourceAttr("metabase_action.test_action", "kind"),
+					resource.TestCheckResourceAttr("metabase_action.test_action", "parameter.#", "1"),
+					resource.TestCheckResourceAttr("metabase_action.test_action", "parameter.0.name", "order_id"),
+					resource.TestCheckResourceAttr("metabase_action.test_action", "parameter.0.display_name", "Order ID"),
+					resource.TestCheckResourceAttr("metabase_action.test_action", "parameter.0.required", "true"),
+					resource.TestCheckResourceAttr("metabase_action.test_action", "visualization_settings_json", `{"submitButtonLabel":"Approve"}`),
+				),
+			},
+			{
+				ResourceName:      "metabase_action.test_action",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The form settings are not managed when they are not set, which is the case when importing.
+				ImportStateVerifyIgnore: []string{"visualization_settings_json"},
+			},
+			{
+				Config: providerApiKeyConfig + testAccActionResource("test_action", "⚡ Approve the order", "Approve"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActionExists("metabase_action.test_action"),
+					resource.TestCheckResourceAttr("metabase_action.test_action", "name", "⚡ Approve the order"),
+				),
+			},
+			{
+				// Changes to the form made in the Metabase UI should be detected.
+				PreConfig:          func() { testAccUpdateActionForm(t, &actionId, "Go") },
+				Config:             providerApiKeyConfig + testAccActionResource("test_action", "⚡ Approve the order", "Approve"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: providerApiKeyConfig + testAccActionResource("test_action", "⚡ Approve the order", "Approve"),
+				Check:  resource.TestCheckResourceAttr("metabase_action.test_action", "visualization_settings_json", `{"submitButtonLabel":"Approve"}`),
+			},
+		},
+	})
+}
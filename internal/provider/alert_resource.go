@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &AlertResource{}
+var _ resource.ResourceWithValidateConfig = &AlertResource{}
+
+// Creates a new alert resource.
+func NewAlertResource() resource.Resource {
+	return &AlertResource{
+		MetabaseBaseResource{name: "alert"},
+	}
+}
+
+// A resource handling an alert on a Metabase card (question).
+type AlertResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for an alert.
+type AlertResourceModel struct {
+	Id             types.Int64  `tfsdk:"id"`               // The ID of the alert.
+	CardId         types.Int64  `tfsdk:"card_id"`          // The ID of the card on which the alert is defined.
+	Condition      types.String `tfsdk:"condition"`        // The condition triggering the alert.
+	FirstAlertOnly types.Bool   `tfsdk:"first_alert_only"` // Whether the alert is deleted after being sent once.
+	Schedule       types.Object `tfsdk:"schedule"`         // When the condition is checked.
+	Email          types.Object `tfsdk:"email"`            // The email channel.
+	Slack          types.Object `tfsdk:"slack"`            // The Slack channel.
+}
+
+// The conditions that can trigger an alert. In the Metabase API, goal conditions are represented using the `goal`
+// condition and the `alert_above_goal` flag.
+var alertConditions = []string{"rows", "goal_above", "goal_below"}
+
+func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An alert on a Metabase card (question), which notifies recipients by email and/or Slack when the card returns results, or when its result crosses the goal line.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the alert.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"card_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the card (question) on which the alert is defined.",
+				Required:            true,
+			},
+			"condition": schema.StringAttribute{
+				MarkdownDescription: "The condition triggering the alert. `rows` sends the alert when the card returns results. `goal_above` and `goal_below` send the alert when the result of the card goes above or below its goal line, which requires a goal to be set in the visualization settings of the card.",
+				Required:            true,
+			},
+			"first_alert_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether the alert is only sent the first time the condition is met, after which Metabase deletes it. Terraform will then plan to re-create the alert.",
+				Optional:            true,
+			},
+			"schedule": pulseScheduleAttribute,
+			"email":    pulseEmailAttribute,
+			"slack":    pulseSlackAttribute,
+		},
+	}
+}
+
+func (r *AlertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AlertResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Condition.IsNull() && !data.Condition.IsUnknown() && !slices.Contains(alertConditions, data.Condition.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("condition"),
+			"Unsupported alert condition.",
+			fmt.Sprintf("Got %q, expected one of: %s.", data.Condition.ValueString(), strings.Join(alertConditions, ", ")),
+		)
+	}
+
+	resp.Diagnostics.Append(validatePulseSchedule(ctx, data.Schedule)...)
+	resp.Diagnostics.Append(validatePulseChannels(data.Email, data.Slack)...)
+}
+
+// Returns the `alert_condition` and `alert_above_goal` values expected by the Metabase API for the given condition.
+func makeAlertConditionFromModel(condition types.String) (string, *bool) {
+	switch condition.ValueString() {
+	case "goal_above":
+		aboveGoal := true
+		return "goal", &aboveGoal
+	case "goal_below":
+		aboveGoal := false
+		return "goal", &aboveGoal
+	default:
+		return "rows", nil
+	}
+}
+
+// Returns the condition of an alert returned by the Metabase API, as it is defined in the Terraform model.
+func makeAlertConditionValue(a metabase.Alert) types.String {
+	if a.AlertCondition != metabase.AlertAlertConditionGoal {
+		return types.StringValue(string(a.AlertCondition))
+	}
+
+	if a.AlertAboveGoal != nil && *a.AlertAboveGoal {
+		return types.StringValue("goal_above")
+	}
+
+	return types.StringValue("goal_below")
+}
+
+// Updates the given `AlertResourceModel` from the `Alert` returned by the Metabase API.
+func updateModelFromAlert(ctx context.Context, client *metabase.ClientWithResponses, a metabase.Alert, data *AlertResourceModel) diag.Diagnostics {
+	data.Id = types.Int64Value(int64(a.Id))
+	data.CardId = types.Int64Value(int64(a.Card.Id))
+	data.Condition = makeAlertConditionValue(a)
+
+	if a.AlertFirstOnly || !data.FirstAlertOnly.IsNull() {
+		data.FirstAlertOnly = types.BoolValue(a.AlertFirstOnly)
+	} else {
+		data.FirstAlertOnly = types.BoolNull()
+	}
+
+	return updatePulseChannelsFromApi(ctx, client, a.Channels, &data.Schedule, &data.Email, &data.Slack)
+}
+
+func (r *AlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AlertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channels, diags := makePulseChannels(ctx, r.client, data.Schedule, data.Email, data.Slack)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	condition, aboveGoal := makeAlertConditionFromModel(data.Condition)
+	createResp, err := r.client.CreateAlertWithResponse(ctx, metabase.CreateAlertBody{
+		AlertCondition: metabase.CreateAlertBodyAlertCondition(condition),
+		AlertAboveGoal: aboveGoal,
+		AlertFirstOnly: data.FirstAlertOnly.ValueBool(),
+		Card:           metabase.AlertCard{Id: int(data.CardId.ValueInt64())},
+		Channels:       channels,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200}, "create alert")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromAlert(ctx, r.client, *createResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AlertResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.GetAlertWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get alert")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Alerts sent only once are deleted by Metabase after being triggered.
+	if getResp.StatusCode() == 404 || (getResp.JSON200.Archived != nil && *getResp.JSON200.Archived) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromAlert(ctx, r.client, *getResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AlertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channels, diags := makePulseChannels(ctx, r.client, data.Schedule, data.Email, data.Slack)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawCondition, aboveGoal := makeAlertConditionFromModel(data.Condition)
+	condition := metabase.UpdateAlertBodyAlertCondition(rawCondition)
+	firstOnly := data.FirstAlertOnly.ValueBool()
+
+	updateResp, err := r.client.UpdateAlertWithResponse(ctx, int(data.Id.ValueInt64()), metabase.UpdateAlertBody{
+		AlertCondition: &condition,
+		AlertAboveGoal: aboveGoal,
+		AlertFirstOnly: &firstOnly,
+		Card:           &metabase.AlertCard{Id: int(data.CardId.ValueInt64())},
+		Channels:       &channels,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update alert")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromAlert(ctx, r.client, *updateResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AlertResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	archived := true
+	updateResp, err := r.client.UpdateAlertWithResponse(ctx, int(data.Id.ValueInt64()), metabase.UpdateAlertBody{
+		Archived: &archived,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200, 404}, "delete (archive) alert")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIntegerId(ctx, req, resp)
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAlertConditionRoundTrip(t *testing.T) {
+	for _, condition := range alertConditions {
+		rawCondition, aboveGoal := makeAlertConditionFromModel(types.StringValue(condition))
+
+		got := makeAlertConditionValue(metabase.Alert{
+			AlertCondition: metabase.AlertAlertCondition(rawCondition),
+			AlertAboveGoal: aboveGoal,
+		})
+		if got.ValueString() != condition {
+			t.Errorf("Expected condition %q, got %q.", condition, got.ValueString())
+		}
+	}
+}
+
+func TestAlertStandInDriftDetection(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	alerts := s.serveObjects("/alert")
+	client := s.client()
+
+	schedule, _ := types.ObjectValue(pulseScheduleObjectType.AttrTypes, map[string]attr.Value{
+		"type":  types.StringValue("daily"),
+		"hour":  types.Int64Value(9),
+		"day":   types.StringNull(),
+		"frame": types.StringNull(),
+	})
+	slack, _ := types.ObjectValue(pulseSlackObjectType.AttrTypes, map[string]attr.Value{
+		"channel": types.StringValue("#data-ops"),
+	})
+
+	data := AlertResourceModel{
+		Id:             types.Int64Unknown(),
+		CardId:         types.Int64Value(100),
+		Condition:      types.StringValue("goal_below"),
+		FirstAlertOnly: types.BoolNull(),
+		Schedule:       schedule,
+		Email:          types.ObjectNull(pulseEmailObjectType.AttrTypes),
+		Slack:          slack,
+	}
+
+	channels, diags := makePulseChannels(ctx, client, data.Schedule, data.Email, data.Slack)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	condition, aboveGoal := makeAlertConditionFromModel(data.Condition)
+	createResp, err := client.CreateAlertWithResponse(ctx, metabase.CreateAlertBody{
+		AlertCondition: metabase.CreateAlertBodyAlertCondition(condition),
+		AlertAboveGoal: aboveGoal,
+		Card:           metabase.AlertCard{Id: 100},
+		Channels:       channels,
+	})
+	if err != nil || createResp.JSON200 == nil {
+		t.Fatalf("Failed to create alert: %v.", err)
+	}
+
+	state := data
+	diags = updateModelFromAlert(ctx, client, *createResp.JSON200, &state)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	expected := data
+	expected.Id = types.Int64Value(1)
+	if !reflect.DeepEqual(state, expected) {
+		t.Errorf("Round trip produced a different model.\nExpected: %+v\nGot: %+v", expected, state)
+	}
+
+	// Changes made in the Metabase UI should be detected.
+	alerts[1]["alert_condition"] = "rows"
+	alerts[1]["channels"].([]any)[0].(map[string]any)["schedule_type"] = "hourly"
+
+	getResp, err := client.GetAlertWithResponse(ctx, 1)
+	if err != nil || getResp.JSON200 == nil {
+		t.Fatalf("Failed to get alert: %v.", err)
+	}
+
+	diags = updateModelFromAlert(ctx, client, *getResp.JSON200, &state)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if state.Condition.ValueString() != "rows" {
+		t.Errorf("Expected the condition to be updated, got %q.", state.Condition.ValueString())
+	}
+
+	var gotSchedule PulseScheduleModel
+	state.Schedule.As(ctx, &gotSchedule, basetypes.ObjectAsOptions{})
+	if gotSchedule.Type.ValueString() != "hourly" || !gotSchedule.Hour.IsNull() {
+		t.Errorf("Expected the schedule to be updated, got %+v.", gotSchedule)
+	}
+}
+
+func testAccAlertResource(name string, condition string, scheduleType string) string {
+	hour := "hour = 9"
+	if scheduleType == "hourly" {
+		hour = ""
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "metabase_alert" "%s" {
+  card_id   = metabase_card.%s.id
+  condition = "%s"
+
+  schedule = {
+    type = "%s"
+    %s
+  }
+
+  email = {
+    emails = ["alerts@example.com"]
+  }
+}
+`,
+		testAccCardResource(name, "🚨 Alerted card", ""),
+		name,
+		name,
+		condition,
+		scheduleType,
+		hour,
+	)
+}
+
+func testAccCheckAlertExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state.", resourceName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetAlertWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 200 {
+			return fmt.Errorf("Received unexpected response from the Metabase API when getting alert.")
+		}
+
+		if rs.Primary.Attributes["card_id"] != fmt.Sprint(response.JSON200.Card.Id) {
+			return fmt.Errorf("Terraform resource and API response do not match for alert card.")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAlertDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "metabase_alert" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetAlertWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 404 && (response.JSON200.Archived == nil || !*response.JSON200.Archived) {
+			return fmt.Errorf("Alert %s still exists.", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestAccAlertResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccAlertResource("test", "rows", "daily"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists("metabase_alert.test"),
+					resource.TestCheckResourceAttrSet("metabase_alert.test", "id"),
+					resource.TestCheckResourceAttr("metabase_alert.test", "condition", "rows"),
+					resource.TestCheckResourceAttr("metabase_alert.test", "schedule.hour", "9"),
+				),
+			},
+			{
+				ResourceName:      "metabase_alert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerApiKeyConfig + testAccAlertResource("test", "rows", "hourly"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_alert.test", "schedule.type", "hourly"),
+					resource.TestCheckNoResourceAttr("metabase_alert.test", "schedule.hour"),
+				),
+			},
+		},
+	})
+}
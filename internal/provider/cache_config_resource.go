@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &CacheConfigResource{}
+var _ resource.ResourceWithValidateConfig = &CacheConfigResource{}
+
+// Creates a new cache configuration resource.
+func NewCacheConfigResource() resource.Resource {
+	return &CacheConfigResource{
+		MetabaseBaseResource{name: "cache_config"},
+	}
+}
+
+// A resource handling the caching strategy of the whole instance, a database, a dashboard, or a question.
+// This relies on the cache configuration API, introduced in Metabase 50.
+type CacheConfigResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for a cache configuration.
+type CacheConfigResourceModel struct {
+	Id            types.String `tfsdk:"id"`              // The ID of the configuration, as `<model>/<model_id>`.
+	Model         types.String `tfsdk:"model"`           // The type of model to which the configuration applies.
+	ModelId       types.Int64  `tfsdk:"model_id"`        // The ID of the model.
+	Strategy      types.String `tfsdk:"strategy"`        // The caching strategy.
+	Multiplier    types.Int64  `tfsdk:"multiplier"`      // For the `ttl` strategy, the multiplier of the average query duration.
+	MinDurationMs types.Int64  `tfsdk:"min_duration_ms"` // For the `ttl` strategy, the minimum query duration for caching.
+	Duration      types.Int64  `tfsdk:"duration"`        // For the `duration` strategy, how long results are cached.
+	Unit          types.String `tfsdk:"unit"`            // For the `duration` strategy, the unit of the duration.
+	Schedule      types.String `tfsdk:"schedule"`        // For the `schedule` strategy, the cron expression invalidating the cache.
+}
+
+// The types of models to which a cache configuration can apply.
+var cacheConfigModels = []string{
+	string(metabase.CacheConfigModelRoot),
+	string(metabase.CacheConfigModelDatabase),
+	string(metabase.CacheConfigModelDashboard),
+	string(metabase.CacheConfigModelQuestion),
+}
+
+// The caching strategies, and the attributes which are required by each of them.
+var cacheStrategyAttributes = map[string][]string{
+	"ttl":      {"multiplier", "min_duration_ms"},
+	"duration": {"duration", "unit"},
+	"schedule": {"schedule"},
+	"nocache":  {},
+}
+
+// The units of durations for the `duration` strategy.
+var cacheDurationUnits = []string{"hours", "minutes", "seconds", "days"}
+
+func (r *CacheConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The caching strategy for the whole Metabase instance, a database, a dashboard, or a question.
+
+This uses the cache configuration API introduced in Metabase 50. Configurations for databases, dashboards and questions require a paid plan. On older versions, use the ` + "`cache_ttl`" + ` attribute of dashboards and cards instead.
+
+Destroying the resource removes the configuration, such that the model inherits the strategy of its parent (or the default strategy for the ` + "`root`" + ` model).`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the configuration, as `<model>/<model_id>`, or `root` for the root model.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The type of model to which the configuration applies. One of `root` (the default for the instance), `database`, `dashboard`, or `question`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"model_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the database, dashboard or question. This should not be set for the `root` model.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"strategy": schema.StringAttribute{
+				MarkdownDescription: "The caching strategy. `ttl` caches results for a multiple of the average query duration, `duration` caches results for a fixed duration, `schedule` invalidates the cache on a cron schedule, and `nocache` disables caching.",
+				Required:            true,
+			},
+			"multiplier": schema.Int64Attribute{
+				MarkdownDescription: "For the `ttl` strategy, the multiplier applied to the average query duration to obtain the cache duration.",
+				Optional:            true,
+			},
+			"min_duration_ms": schema.Int64Attribute{
+				MarkdownDescription: "For the `ttl` strategy, the minimum query duration (in milliseconds) for results to be cached.",
+				Optional:            true,
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "For the `duration` strategy, how long results are cached, in `unit`.",
+				Optional:            true,
+			},
+			"unit": schema.StringAttribute{
+				MarkdownDescription: "For the `duration` strategy, the unit of the duration. One of `hours`, `minutes`, `seconds`, or `days`.",
+				Optional:            true,
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "For the `schedule` strategy, the (Quartz) cron expression at which the cache is invalidated, e.g. `0 0 * * * ? *` for every hour.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Returns the attributes specific to strategies, keyed by attribute name.
+func (data CacheConfigResourceModel) strategyAttributes() map[string]attr.Value {
+	return map[string]attr.Value{
+		"multiplier":      data.Multiplier,
+		"min_duration_ms": data.MinDurationMs,
+		"duration":        data.Duration,
+		"unit":            data.Unit,
+		"schedule":        data.Schedule,
+	}
+}
+
+func (r *CacheConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CacheConfigResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Model.IsUnknown() && !data.ModelId.IsUnknown() {
+		model := data.Model.ValueString()
+		switch {
+		case !slices.Contains(cacheConfigModels, model):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("model"),
+				"Unsupported cache configuration model.",
+				fmt.Sprintf("Got %q, expected one of: %s.", model, strings.Join(cacheConfigModels, ", ")),
+			)
+		case model == string(metabase.CacheConfigModelRoot) && !data.ModelId.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("model_id"), "Unexpected model ID.", "The model_id should not be set for the root model.")
+		case model != string(metabase.CacheConfigModelRoot) && data.ModelId.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("model_id"), "Missing model ID.", fmt.Sprintf("The model_id is required for the %s model.", model))
+		}
+	}
+
+	if data.Strategy.IsUnknown() {
+		return
+	}
+
+	strategy := data.Strategy.ValueString()
+	requiredAttributes, ok := cacheStrategyAttributes[strategy]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("strategy"),
+			"Unsupported caching strategy.",
+			fmt.Sprintf("Got %q, expected one of: ttl, duration, schedule, nocache.", strategy),
+		)
+		return
+	}
+
+	for name, value := range data.strategyAttributes() {
+		if slices.Contains(requiredAttributes, name) && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Missing caching strategy attribute.", fmt.Sprintf("The %s attribute is required for the %s strategy.", name, strategy))
+		}
+		if !slices.Contains(requiredAttributes, name) && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Unexpected caching strategy attribute.", fmt.Sprintf("The %s attribute is not supported by the %s strategy.", name, strategy))
+		}
+	}
+
+	if !data.Unit.IsNull() && !data.Unit.IsUnknown() && !slices.Contains(cacheDurationUnits, data.Unit.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("unit"),
+			"Unsupported duration unit.",
+			fmt.Sprintf("Got %q, expected one of: %s.", data.Unit.ValueString(), strings.Join(cacheDurationUnits, ", ")),
+		)
+	}
+}
+
+// Returns the error reported when the cache configuration API is not available, i.e. when it returns a 404.
+func makeCacheConfigUnsupportedDiags(statusCode int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if statusCode == 404 {
+		diags.AddError(
+			"Cache configuration is not supported by this Metabase instance.",
+			"The cache configuration API is only available in Metabase 50 and later. On older versions, use the cache_ttl attribute of dashboards and cards (in their JSON definition) instead.",
+		)
+	}
+
+	return diags
+}
+
+// Returns the ID of the model, which is 0 for the root model.
+func getCacheConfigModelId(data CacheConfigResourceModel) int {
+	if data.ModelId.IsNull() {
+		return 0
+	}
+
+	return int(data.ModelId.ValueInt64())
+}
+
+// Returns the strategy expected by the Metabase API from the model.
+func makeCacheStrategyFromModel(data CacheConfigResourceModel) metabase.CacheStrategy {
+	strategy := metabase.CacheStrategy{
+		Type: metabase.CacheStrategyType(data.Strategy.ValueString()),
+	}
+
+	switch data.Strategy.ValueString() {
+	case "ttl":
+		strategy.Multiplier = valueInt64OrNull(data.Multiplier)
+		strategy.MinDurationMs = valueInt64OrNull(data.MinDurationMs)
+	case "duration":
+		strategy.Duration = valueInt64OrNull(data.Duration)
+		strategy.Unit = valueStringOrNull(data.Unit)
+	case "schedule":
+		strategy.Schedule = valueStringOrNull(data.Schedule)
+	}
+
+	return strategy
+}
+
+// Updates the given `CacheConfigResourceModel` from the `CacheConfig` returned by the Metabase API.
+// Attributes which are irrelevant to the strategy are set to null.
+func updateModelFromCacheConfig(c metabase.CacheConfig, data *CacheConfigResourceModel) {
+	data.Id = types.StringValue(string(c.Model))
+	data.Model = types.StringValue(string(c.Model))
+	data.ModelId = types.Int64Null()
+	if c.Model != metabase.CacheConfigModelRoot {
+		data.Id = types.StringValue(fmt.Sprintf("%s/%d", c.Model, c.ModelId))
+		data.ModelId = types.Int64Value(int64(c.ModelId))
+	}
+
+	data.Strategy = types.StringValue(string(c.Strategy.Type))
+	data.Multiplier = types.Int64Null()
+	data.MinDurationMs = types.Int64Null()
+	data.Duration = types.Int64Null()
+	data.Unit = types.StringNull()
+	data.Schedule = types.StringNull()
+
+	switch c.Strategy.Type {
+	case "ttl":
+		data.Multiplier = int64ValueOrNull(c.Strategy.Multiplier)
+		data.MinDurationMs = int64ValueOrNull(c.Strategy.MinDurationMs)
+	case "duration":
+		data.Duration = int64ValueOrNull(c.Strategy.Duration)
+		data.Unit = stringValueOrNull(c.Strategy.Unit)
+	case "schedule":
+		data.Schedule = stringValueOrNull(c.Strategy.Schedule)
+	}
+}
+
+// Fetches the configuration of the given model from the Metabase API. Returns `nil` if the model does not have its own
+// configuration.
+func getCacheConfig(ctx context.Context, client *metabase.ClientWithResponses, model metabase.CacheConfigModel, modelId int) (*metabase.CacheConfig, diag.Diagnostics) {
+	params := metabase.ListCacheConfigsParams{
+		Model: []metabase.CacheConfigModel{model},
+	}
+	if model != metabase.CacheConfigModelRoot {
+		params.Id = &modelId
+	}
+
+	listResp, err := client.ListCacheConfigsWithResponse(ctx, &params)
+
+	diags := checkMetabaseResponse(listResp, err, []int{200, 404}, "get cache configuration")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	diags.Append(makeCacheConfigUnsupportedDiags(listResp.StatusCode())...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, c := range listResp.JSON200.Data {
+		if c.Model == model && c.ModelId == modelId {
+			return &c, diags
+		}
+	}
+
+	return nil, diags
+}
+
+// Creates or updates the configuration from the model, and updates the model from the resulting configuration.
+func putCacheConfig(ctx context.Context, client *metabase.ClientWithResponses, data *CacheConfigResourceModel) diag.Diagnostics {
+	config := metabase.CacheConfig{
+		Model:    metabase.CacheConfigModel(data.Model.ValueString()),
+		ModelId:  getCacheConfigModelId(*data),
+		Strategy: makeCacheStrategyFromModel(*data),
+	}
+
+	updateResp, err := client.UpdateCacheConfigWithResponse(ctx, config)
+
+	diags := checkMetabaseResponse(updateResp, err, []int{200, 404}, "update cache configuration")
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(makeCacheConfigUnsupportedDiags(updateResp.StatusCode())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The update endpoint does not return the configuration.
+	c, getDiags := getCacheConfig(ctx, client, config.Model, config.ModelId)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if c == nil {
+		diags.AddError("Cache configuration not found after update.", fmt.Sprintf("The cache configuration for %s %d could not be read after being updated.", config.Model, config.ModelId))
+		return diags
+	}
+
+	updateModelFromCacheConfig(*c, data)
+
+	return diags
+}
+
+func (r *CacheConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CacheConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(putCacheConfig(ctx, r.client, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacheConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CacheConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := getCacheConfig(ctx, r.client, metabase.CacheConfigModel(data.Model.ValueString()), getCacheConfigModelId(*data))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration has been removed, and the model inherits the strategy of its parent.
+	if c == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	updateModelFromCacheConfig(*c, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacheConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CacheConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(putCacheConfig(ctx, r.client, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacheConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CacheConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResp, err := r.client.DeleteCacheConfigWithResponse(ctx, metabase.DeleteCacheConfigBody{
+		Model:   metabase.CacheConfigModel(data.Model.ValueString()),
+		ModelId: []int{getCacheConfigModelId(*data)},
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(deleteResp, err, []int{204}, "delete cache configuration")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CacheConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	model, modelIdString, hasModelId := strings.Cut(req.ID, "/")
+
+	modelId := types.Int64Null()
+	if model != string(metabase.CacheConfigModelRoot) {
+		id, err := strconv.ParseInt(modelIdString, 10, 64)
+		if !hasModelId || err != nil {
+			resp.Diagnostics.AddError("Invalid cache configuration ID.", fmt.Sprintf("Expected an ID in the format <model>/<model_id>, got %q.", req.ID))
+			return
+		}
+
+		modelId = types.Int64Value(id)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("model"), model)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("model_id"), modelId)...)
+}
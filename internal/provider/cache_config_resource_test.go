@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Registers the cache configuration endpoints on the stand-in server, storing a single configuration.
+func serveStandInCacheConfig(s *metabaseStandIn) *[]metabase.CacheConfig {
+	configs := []metabase.CacheConfig{}
+
+	s.mux.HandleFunc("GET /api/cache", func(w http.ResponseWriter, r *http.Request) {
+		writeStandInJson(w, http.StatusOK, metabase.CacheConfigList{Data: configs})
+	})
+	s.mux.HandleFunc("PUT /api/cache", func(w http.ResponseWriter, r *http.Request) {
+		var config metabase.CacheConfig
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &config); err != nil {
+			writeStandInJson(w, http.StatusBadRequest, map[string]any{"message": err.Error()})
+			return
+		}
+
+		configs = []metabase.CacheConfig{config}
+		writeStandInJson(w, http.StatusOK, map[string]any{"id": 1})
+	})
+	s.mux.HandleFunc("DELETE /api/cache", func(w http.ResponseWriter, r *http.Request) {
+		configs = []metabase.CacheConfig{}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return &configs
+}
+
+func TestCacheConfigStandInRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	configs := serveStandInCacheConfig(s)
+	client := s.client()
+
+	data := CacheConfigResourceModel{
+		Id:            types.StringUnknown(),
+		Model:         types.StringValue("dashboard"),
+		ModelId:       types.Int64Value(7),
+		Strategy:      types.StringValue("duration"),
+		Multiplier:    types.Int64Null(),
+		MinDurationMs: types.Int64Null(),
+		Duration:      types.Int64Value(24),
+		Unit:          types.StringValue("hours"),
+		Schedule:      types.StringNull(),
+	}
+
+	state := data
+	diags := putCacheConfig(ctx, client, &state)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	expected := data
+	expected.Id = types.StringValue("dashboard/7")
+	if !reflect.DeepEqual(state, expected) {
+		t.Errorf("Round trip produced a different model.\nExpected: %+v\nGot: %+v", expected, state)
+	}
+
+	// Changes made in the Metabase UI should be detected.
+	schedule := "0 0 * * * ? *"
+	(*configs)[0].Strategy = metabase.CacheStrategy{Type: "schedule", Schedule: &schedule}
+
+	c, diags := getCacheConfig(ctx, client, metabase.CacheConfigModelDashboard, 7)
+	if diags.HasError() || c == nil {
+		t.Fatalf("Failed to get cache configuration: %v.", diags)
+	}
+
+	updateModelFromCacheConfig(*c, &state)
+	if state.Strategy.ValueString() != "schedule" || !state.Duration.IsNull() || state.Schedule.ValueString() != "0 0 * * * ? *" {
+		t.Errorf("Expected the strategy to be updated, got %+v.", state)
+	}
+
+	// A configuration which has been removed is not found.
+	*configs = []metabase.CacheConfig{}
+	c, diags = getCacheConfig(ctx, client, metabase.CacheConfigModelDashboard, 7)
+	if diags.HasError() || c != nil {
+		t.Errorf("Expected no cache configuration, got %v (%v).", c, diags)
+	}
+}
+
+func TestCacheConfigUnsupportedVersion(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+
+	// Older versions of Metabase do not have the cache configuration endpoints.
+	_, diags := getCacheConfig(ctx, s.client(), metabase.CacheConfigModelQuestion, 1)
+	if !diags.HasError() {
+		t.Fatal("Expected an error when the cache configuration API is not available.")
+	}
+
+	if !strings.Contains(diags[0].Detail(), "cache_ttl") {
+		t.Errorf("Expected the error to mention cache_ttl, got %q.", diags[0].Detail())
+	}
+}
+
+func testAccRootCacheConfigResource(name string, multiplier int) string {
+	return fmt.Sprintf(`
+resource "metabase_cache_config" "%s" {
+  model           = "root"
+  strategy        = "ttl"
+  multiplier      = %d
+  min_duration_ms = 1000
+}
+`,
+		name,
+		multiplier,
+	)
+}
+
+func testAccCheckRootCacheConfig(expectedType metabase.CacheStrategyType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c, diags := getCacheConfig(context.Background(), testAccMetabaseClient, metabase.CacheConfigModelRoot, 0)
+		if diags.HasError() {
+			return fmt.Errorf("Failed to get the root cache configuration: %v.", diags)
+		}
+		if c == nil || c.Strategy.Type != expectedType {
+			return fmt.Errorf("Expected a root cache configuration with strategy %s, got %v.", expectedType, c)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRootCacheConfigDestroy(s *terraform.State) error {
+	c, diags := getCacheConfig(context.Background(), testAccMetabaseClient, metabase.CacheConfigModelRoot, 0)
+	if diags.HasError() {
+		return fmt.Errorf("Failed to get the root cache configuration: %v.", diags)
+	}
+	if c != nil {
+		return fmt.Errorf("Root cache configuration still exists.")
+	}
+
+	return nil
+}
+
+// Disables caching for the whole instance, as could be done in the Metabase UI.
+func testAccDisableRootCache(t *testing.T) func() {
+	return func() {
+		updateResp, err := testAccMetabaseClient.UpdateCacheConfigWithResponse(context.Background(), metabase.CacheConfig{
+			Model:    metabase.CacheConfigModelRoot,
+			ModelId:  0,
+			Strategy: metabase.CacheStrategy{Type: metabase.Nocache},
+		})
+		if err != nil || updateResp.StatusCode() != 200 {
+			t.Fatalf("Failed to update the root cache configuration: %v.", err)
+		}
+	}
+}
+
+func TestAccRootCacheConfigResource(t *testing.T) {
+	// Only the root model can be configured without a paid plan.
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRootCacheConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccRootCacheConfigResource("test", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRootCacheConfig(metabase.Ttl),
+					resource.TestCheckResourceAttr("metabase_cache_config.test", "id", "root"),
+					resource.TestCheckResourceAttr("metabase_cache_config.test", "multiplier", "10"),
+					resource.TestCheckNoResourceAttr("metabase_cache_config.test", "model_id"),
+				),
+			},
+			{
+				ResourceName:      "metabase_cache_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerApiKeyConfig + testAccRootCacheConfigResource("test", 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRootCacheConfig(metabase.Ttl),
+					resource.TestCheckResourceAttr("metabase_cache_config.test", "multiplier", "20"),
+				),
+			},
+			{
+				// Changes made in the Metabase UI should be detected.
+				PreConfig:          testAccDisableRootCache(t),
+				Config:             providerApiKeyConfig + testAccRootCacheConfigResource("test", 20),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
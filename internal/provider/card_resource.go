@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"maps"
 	"reflect"
 	"strings"
 
@@ -28,11 +29,13 @@ var allowedCardAttributes = map[string]bool{
 	"parameter_mappings":     true,
 	"parameters":             true,
 	"query_type":             true,
+	"type":                   true,
 	"visualization_settings": true,
 }
 
 // Ensures provider defined types fully satisfy framework interfaces.
 var _ resource.ResourceWithImportState = &CardResource{}
+var _ resource.ResourceWithValidateConfig = &CardResource{}
 
 // Creates a new card resource.
 func NewCardResource() resource.Resource {
@@ -49,30 +52,60 @@ type CardResource struct {
 // The Terraform model for a card.
 // Because it is a complex object with many possible attributes, the entire structure is not exposed from Terraform. The
 // card's definition should simply be passed as a JSON string, possibly using a template. Only the ID is exposed, as it
-// is only known once the card is created.
+// is only known once the card is created. Embedding and public sharing settings are exposed as separate attributes.
 type CardResourceModel struct {
-	Id   types.Int64  `tfsdk:"id"`   // The ID of the card.
-	Json types.String `tfsdk:"json"` // The entire definition of the card, as a JSON string.
+	Id              types.Int64  `tfsdk:"id"`               // The ID of the card.
+	Json            types.String `tfsdk:"json"`             // The entire definition of the card, as a JSON string.
+	EnableEmbedding types.Bool   `tfsdk:"enable_embedding"` // Whether signed embedding is enabled.
+	EmbeddingParams types.Map    `tfsdk:"embedding_params"` // The embedding mode of each parameter.
+	PublicSharing   types.Bool   `tfsdk:"public_sharing"`   // Whether a public link is enabled.
+	PublicUuid      types.String `tfsdk:"public_uuid"`      // The UUID of the public link.
+	PublicUrl       types.String `tfsdk:"public_url"`       // The full public link.
+}
+
+// Returns pointers to the embedding and public sharing attributes of the card.
+func (data *CardResourceModel) sharingAttributes() sharingAttributes {
+	return sharingAttributes{
+		EnableEmbedding: &data.EnableEmbedding,
+		EmbeddingParams: &data.EmbeddingParams,
+		PublicSharing:   &data.PublicSharing,
+		PublicUuid:      &data.PublicUuid,
+		PublicUrl:       &data.PublicUrl,
+	}
 }
 
 func (r *CardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the card.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"json": schema.StringAttribute{
+			MarkdownDescription: "The full card definition as a JSON string.",
+			Required:            true,
+		},
+	}
+	maps.Copy(attributes, makeSharingSchemaAttributes("card"))
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `A Metabase card (question).
 
-Because the content of a card is complex and can vary a lot between cards, the full schema is not defined in Terraform, and a JSON string should be used instead. You can use templatefile or jsonencode to make the experience smoother.`,
-
-		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				MarkdownDescription: "The ID of the card.",
-				Computed:            true,
-				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
-			},
-			"json": schema.StringAttribute{
-				MarkdownDescription: "The full card definition as a JSON string.",
-				Required:            true,
-			},
-		},
+Because the content of a card is complex and can vary a lot between cards, the full schema is not defined in Terraform, and a JSON string should be used instead. You can use templatefile or jsonencode to make the experience smoother. Omitting ` + "`collection_position`" + ` from the JSON leaves the position of the card in its collection unmanaged, e.g. such that it can be managed using ` + "`metabase_collection_pins`" + `. Set ` + "`type`" + ` to ` + "`model`" + ` to define a model, e.g. to use it in a ` + "`metabase_action`" + `.`,
+
+		Attributes: attributes,
+	}
+}
+
+func (r *CardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CardResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	resp.Diagnostics.Append(validateEmbeddingParams(ctx, data.EmbeddingParams)...)
 }
 
 // Parses the (integer) ID of the card from a raw Card JSON object returned by the Metabase API.
@@ -160,6 +193,20 @@ func updateModelFromCardBytes(cardBytes []byte, data *CardResourceModel) diag.Di
 
 	cleanCardQuery(card, existingCard)
 
+	// The position in the collection is not managed when it is omitted from the definition, e.g. such that it can be
+	// managed using `metabase_collection_pins`.
+	if existingCard != nil {
+		if _, ok := existingCard["collection_position"]; !ok {
+			delete(card, "collection_position")
+		}
+	}
+
+	// The type of the card (`question`, `model` or `metric`) is only managed when it is part of the definition, such that
+	// existing definitions are not affected. Imported cards only include it when they are not questions.
+	if _, ok := existingCard["type"]; !ok && (existingCard != nil || card["type"] == "question") {
+		delete(card, "type")
+	}
+
 	// If the existing card is different from the response from the API, updates the JSON string by remarshalling the
 	// "cleaned" response to a string. This should only happen:
 	// - When creating the card.
@@ -179,6 +226,40 @@ func updateModelFromCardBytes(cardBytes []byte, data *CardResourceModel) diag.Di
 	return diags
 }
 
+// Updates the embedding settings and the public link of the card according to the model, and updates the embedding and
+// public sharing attributes from the resulting card.
+func updateCardSharing(ctx context.Context, client *metabase.ClientWithResponses, c metabase.Card, data *CardResourceModel) diag.Diagnostics {
+	enableEmbedding, embeddingParams, diags := makeEmbeddingSettings(ctx, data.sharingAttributes())
+	if diags.HasError() {
+		return diags
+	}
+
+	// Embedding settings cannot be part of the card definition, as they are not accepted when creating a card.
+	if enableEmbedding != nil || embeddingParams != nil {
+		updateResp, err := client.UpdateCardWithResponse(ctx, c.Id, metabase.UpdateCardBody{
+			EnableEmbedding: enableEmbedding,
+			EmbeddingParams: (*metabase.EmbeddingParams)(embeddingParams),
+		})
+
+		diags.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update card embedding")...)
+		if diags.HasError() {
+			return diags
+		}
+
+		c = *updateResp.JSON200
+	}
+
+	publicUuid, linkDiags := updatePublicLink(ctx, client, sharedCard, c.Id, data.PublicSharing, c.PublicUuid)
+	diags.Append(linkDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(updateSharingAttributesFromApi(ctx, client, sharedCard, c.EnableEmbedding, c.EmbeddingParams, publicUuid, data.sharingAttributes())...)
+
+	return diags
+}
+
 func (r *CardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *CardResourceModel
 
@@ -200,6 +281,11 @@ func (r *CardResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(updateCardSharing(ctx, r.client, *createResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -228,6 +314,12 @@ func (r *CardResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	c := getResp.JSON200
+	resp.Diagnostics.Append(updateSharingAttributesFromApi(ctx, r.client, sharedCard, c.EnableEmbedding, c.EmbeddingParams, c.PublicUuid, data.sharingAttributes())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -252,6 +344,11 @@ func (r *CardResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(updateCardSharing(ctx, r.client, *updateResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/occam-bci/terraform-provider-metabase/metabase"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -52,7 +53,7 @@ Unlike the resource, this data source only reads the permissions graph and does
 			},
 			"ignored_groups": schema.SetAttribute{
 				ElementType:         types.Int64Type,
-				MarkdownDescription: "The list of group IDs that should be ignored when reading permissions. By default, this contains the Administrators group (`[2]`).",
+				MarkdownDescription: "The list of group IDs that should be ignored when reading permissions. By default, this contains the Administrators group, found using its type.",
 				Optional:            true,
 			},
 			"permissions": schema.SetNestedAttribute{
@@ -64,10 +65,18 @@ Unlike the resource, this data source only reads the permissions graph and does
 							MarkdownDescription: "The ID of the group to which the permission applies.",
 							Computed:            true,
 						},
+						"group_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the group to which the permission applies.",
+							Computed:            true,
+						},
 						"collection": schema.StringAttribute{
 							MarkdownDescription: "The ID of the collection to which the permission applies.",
 							Computed:            true,
 						},
+						"collection_path": schema.StringAttribute{
+							MarkdownDescription: "The path of the collection to which the permission applies, e.g. `Marketing/Reports`. This is null for the root collection.",
+							Computed:            true,
+						},
 						"permission": schema.StringAttribute{
 							MarkdownDescription: "The level of permission (`read` or `write`).",
 							Computed:            true,
@@ -99,12 +108,12 @@ func (d *CollectionGraphDataSource) Configure(ctx context.Context, req datasourc
 }
 
 // Updates the given `CollectionGraphDataSourceModel` from the `CollectionPermissionsGraph` returned by the Metabase API.
-func updateDataSourceModelFromCollectionPermissionsGraph(ctx context.Context, g metabase.CollectionPermissionsGraph, data *CollectionGraphDataSourceModel) diag.Diagnostics {
+func updateDataSourceModelFromCollectionPermissionsGraph(ctx context.Context, names *permissionsGraphNames, g metabase.CollectionPermissionsGraph, data *CollectionGraphDataSourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	data.Revision = types.Int64Value(int64(g.Revision))
 
-	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, data.IgnoredGroups)
+	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, names, data.IgnoredGroups)
 	diags.Append(groupsDiags...)
 	if diags.HasError() {
 		return diags
@@ -117,13 +126,29 @@ func updateDataSourceModelFromCollectionPermissionsGraph(ctx context.Context, g
 			continue
 		}
 
+		var groupName *string
+		if groupIdInt, err := strconv.Atoi(groupId); err == nil {
+			var nameDiags diag.Diagnostics
+			groupName, nameDiags = names.groupName(ctx, groupIdInt)
+			diags.Append(nameDiags...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
 		for colId, permission := range colPermissionsMap {
 			// Skipping `none` permissions for clarity. Only read or write permissions should be specified.
 			if permission == metabase.CollectionPermissionLevelNone {
 				continue
 			}
 
-			permissionObject, objDiags := makePermissionObjectFromPermission(ctx, groupId, colId, permission)
+			collectionPath, pathDiags := names.collectionPath(ctx, colId)
+			diags.Append(pathDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			permissionObject, objDiags := makePermissionObjectFromPermission(ctx, groupId, stringValueOrNull(groupName), colId, stringValueOrNull(collectionPath), permission)
 			diags.Append(objDiags...)
 			if diags.HasError() {
 				return diags
@@ -133,13 +158,7 @@ func updateDataSourceModelFromCollectionPermissionsGraph(ctx context.Context, g
 		}
 	}
 
-	permissionsSet, setDiags := types.SetValue(types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"group":      types.Int64Type,
-			"collection": types.StringType,
-			"permission": types.StringType,
-		},
-	}, permissionsList)
+	permissionsSet, setDiags := types.SetValue(collectionPermissionObjectType, permissionsList)
 	diags.Append(setDiags...)
 	if diags.HasError() {
 		return diags
@@ -165,7 +184,7 @@ func (d *CollectionGraphDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	resp.Diagnostics.Append(updateDataSourceModelFromCollectionPermissionsGraph(ctx, *getResp.JSON200, &data)...)
+	resp.Diagnostics.Append(updateDataSourceModelFromCollectionPermissionsGraph(ctx, newPermissionsGraphNames(d.client), *getResp.JSON200, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
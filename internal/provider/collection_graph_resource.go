@@ -20,6 +20,8 @@ import (
 
 // Ensures provider defined types fully satisfy framework interfaces.
 var _ resource.ResourceWithImportState = &CollectionGraphResource{}
+var _ resource.ResourceWithValidateConfig = &CollectionGraphResource{}
+var _ resource.ResourceWithModifyPlan = &CollectionGraphResource{}
 
 // Creates a new collection graph resource.
 func NewCollectionGraphResource() resource.Resource {
@@ -45,9 +47,22 @@ type CollectionGraphResourceModel struct {
 
 // The model for a single edge in the permissions graph.
 type CollectionPermission struct {
-	Group      types.Int64  `tfsdk:"group"`      // The permissions group to which the permission applies.
-	Collection types.String `tfsdk:"collection"` // The collection to which the permission applies. The collection is a string because it could be the `root` collection.
-	Permission types.String `tfsdk:"permission"` // The permission level (read or write).
+	Group          types.Int64  `tfsdk:"group"`           // The permissions group to which the permission applies.
+	GroupName      types.String `tfsdk:"group_name"`      // The name of the permissions group, which can be set instead of its ID.
+	Collection     types.String `tfsdk:"collection"`      // The collection to which the permission applies. The collection is a string because it could be the `root` collection.
+	CollectionPath types.String `tfsdk:"collection_path"` // The path of the collection, which can be set instead of its ID.
+	Permission     types.String `tfsdk:"permission"`      // The permission level (read or write).
+}
+
+// The object type definition for the `CollectionPermission` model.
+var collectionPermissionObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"group":           types.Int64Type,
+		"group_name":      types.StringType,
+		"collection":      types.StringType,
+		"collection_path": types.StringType,
+		"permission":      types.StringType,
+	},
 }
 
 func (r *CollectionGraphResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -58,6 +73,8 @@ Metabase exposes a single resource to define all permissions related to collecti
 
 The collection graph cannot be created or deleted. Trying to create it will result in an error. It should be imported instead. Trying to delete the resource will succeed with no impact on Metabase (it is a no-op).
 
+Groups and collections can be referenced either by ID or by name, using ` + "`group_name`" + ` and ` + "`collection_path`" + `. Names are resolved to IDs when planning, or when applying if the group or collection does not exist yet.
+
 Permissions for the Administrators group cannot be changed. To avoid issues during the update, all permissions for the Administrators group are ignored by default. This behavior can be changed using the ignored groups attribute.`,
 
 		Attributes: map[string]schema.Attribute{
@@ -67,7 +84,7 @@ Permissions for the Administrators group cannot be changed. To avoid issues duri
 			},
 			"ignored_groups": schema.SetAttribute{
 				ElementType:         types.Int64Type,
-				MarkdownDescription: "The list of group IDs that should be ignored when reading and updating permissions. By default, this contains the Administrators group (`[2]`).",
+				MarkdownDescription: "The list of group IDs that should be ignored when reading and updating permissions. By default, this contains the Administrators group, found using its type.",
 				Optional:            true,
 			},
 			"permissions": schema.SetNestedAttribute{
@@ -76,12 +93,22 @@ Permissions for the Administrators group cannot be changed. To avoid issues duri
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"group": schema.Int64Attribute{
-							MarkdownDescription: "The ID of the group to which the permission applies.",
-							Required:            true,
+							MarkdownDescription: "The ID of the group to which the permission applies. Exactly one of `group` or `group_name` must be set.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"group_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the group to which the permission applies, resolved to its ID.",
+							Optional:            true,
 						},
 						"collection": schema.StringAttribute{
-							MarkdownDescription: "The ID of the collection to which the permission applies.",
-							Required:            true,
+							MarkdownDescription: "The ID of the collection to which the permission applies. Exactly one of `collection` or `collection_path` must be set.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"collection_path": schema.StringAttribute{
+							MarkdownDescription: "The path of the collection to which the permission applies, made of the names of its ancestors and its own name separated by `/`, e.g. `Marketing/Reports`. It is resolved to the collection ID.",
+							Optional:            true,
 						},
 						"permission": schema.StringAttribute{
 							MarkdownDescription: "The level of permission (`read` or `write`).",
@@ -99,7 +126,7 @@ Permissions for the Administrators group cannot be changed. To avoid issues duri
 }
 
 // Makes a single permission (edge) object to be stored in the model.
-func makePermissionObjectFromPermission(ctx context.Context, groupId string, colId string, p metabase.CollectionPermissionLevel) (*types.Object, diag.Diagnostics) {
+func makePermissionObjectFromPermission(ctx context.Context, groupId string, groupName types.String, colId string, collectionPath types.String, p metabase.CollectionPermissionLevel) (*types.Object, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Groups are received as strings because they are keys of a JSON map, but they should all correspond to integers.
@@ -109,14 +136,12 @@ func makePermissionObjectFromPermission(ctx context.Context, groupId string, col
 		return nil, diags
 	}
 
-	permissionObject, objectDiags := types.ObjectValueFrom(ctx, map[string]attr.Type{
-		"group":      types.Int64Type,
-		"collection": types.StringType,
-		"permission": types.StringType,
-	}, CollectionPermission{
-		Group:      types.Int64Value(int64(groupIdInt)),
-		Collection: types.StringValue(colId),
-		Permission: types.StringValue(string(p)),
+	permissionObject, objectDiags := types.ObjectValueFrom(ctx, collectionPermissionObjectType.AttrTypes, CollectionPermission{
+		Group:          types.Int64Value(int64(groupIdInt)),
+		GroupName:      groupName,
+		Collection:     types.StringValue(colId),
+		CollectionPath: collectionPath,
+		Permission:     types.StringValue(string(p)),
 	})
 	diags.Append(objectDiags...)
 	if diags.HasError() {
@@ -127,17 +152,41 @@ func makePermissionObjectFromPermission(ctx context.Context, groupId string, col
 }
 
 // Updates the given `CollectionGraphResourceModel` from the `CollectionPermissionsGraph` returned by the Metabase API.
-func updateModelFromCollectionPermissionsGraph(ctx context.Context, g metabase.CollectionPermissionsGraph, data *CollectionGraphResourceModel) diag.Diagnostics {
+// Groups and collections referenced by name in the existing model keep their name.
+func updateModelFromCollectionPermissionsGraph(ctx context.Context, names *permissionsGraphNames, g metabase.CollectionPermissionsGraph, data *CollectionGraphResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	data.Revision = types.Int64Value(int64(g.Revision))
 
-	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, data.IgnoredGroups)
+	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, names, data.IgnoredGroups)
 	diags.Append(groupsDiags...)
 	if diags.HasError() {
 		return diags
 	}
 
+	existingPermissions := make([]CollectionPermission, 0, len(data.Permissions.Elements()))
+	diags.Append(data.Permissions.ElementsAs(ctx, &existingPermissions, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	groupNames := make(map[string]types.String)
+	collectionPaths := make(map[string]types.String)
+	for _, p := range existingPermissions {
+		if !p.GroupName.IsNull() {
+			groupNames[strconv.FormatInt(p.Group.ValueInt64(), 10)] = p.GroupName
+		}
+		if !p.CollectionPath.IsNull() {
+			collectionPaths[p.Collection.ValueString()] = p.CollectionPath
+		}
+	}
+	nameOrNull := func(names map[string]types.String, id string) types.String {
+		if name, ok := names[id]; ok {
+			return name
+		}
+		return types.StringNull()
+	}
+
 	permissionsList := make([]attr.Value, 0, len(data.Permissions.Elements()))
 	for groupId, colPermissionsMap := range g.Groups {
 		// Permissions for ignored groups are not stored in the state for clarity.
@@ -151,7 +200,7 @@ func updateModelFromCollectionPermissionsGraph(ctx context.Context, g metabase.C
 				continue
 			}
 
-			permissionObject, objDiags := makePermissionObjectFromPermission(ctx, groupId, colId, permission)
+			permissionObject, objDiags := makePermissionObjectFromPermission(ctx, groupId, nameOrNull(groupNames, groupId), colId, nameOrNull(collectionPaths, colId), permission)
 			diags.Append(objDiags...)
 			if diags.HasError() {
 				return diags
@@ -161,13 +210,7 @@ func updateModelFromCollectionPermissionsGraph(ctx context.Context, g metabase.C
 		}
 	}
 
-	permissionsSet, setDiags := types.SetValue(types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"group":      types.Int64Type,
-			"collection": types.StringType,
-			"permission": types.StringType,
-		},
-	}, permissionsList)
+	permissionsSet, setDiags := types.SetValue(collectionPermissionObjectType, permissionsList)
 	diags.Append(setDiags...)
 	if diags.HasError() {
 		return diags
@@ -619,6 +662,113 @@ func makeCollectionPermissionsGraphFromModel(ctx context.Context, data Collectio
 	}, diags
 }
 
+// Resolves the IDs of the groups and collections referenced by name in the permissions of the model.
+// When `required` is false, names which do not match any group or collection leave the ID unknown, as the group or
+// collection may be created during the apply. Otherwise an error is returned.
+func resolveCollectionGraphModelNames(ctx context.Context, names *permissionsGraphNames, data *CollectionGraphResourceModel, required bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Permissions.IsNull() || data.Permissions.IsUnknown() {
+		return diags
+	}
+
+	permissions := make([]CollectionPermission, 0, len(data.Permissions.Elements()))
+	diags.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, p := range permissions {
+		if !p.GroupName.IsNull() && !p.GroupName.IsUnknown() {
+			groupId, groupDiags := names.groupId(ctx, p.GroupName.ValueString())
+			diags.Append(groupDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if groupId != nil {
+				permissions[i].Group = types.Int64Value(int64(*groupId))
+			} else if required {
+				diags.AddError("Permissions group not found.", fmt.Sprintf("No Metabase permissions group is named %q.", p.GroupName.ValueString()))
+				return diags
+			} else {
+				permissions[i].Group = types.Int64Unknown()
+			}
+		}
+
+		if !p.CollectionPath.IsNull() && !p.CollectionPath.IsUnknown() {
+			collectionId, collectionDiags := names.collectionId(ctx, p.CollectionPath.ValueString())
+			diags.Append(collectionDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if collectionId != nil {
+				permissions[i].Collection = types.StringValue(*collectionId)
+			} else if required {
+				diags.AddError("Collection not found.", fmt.Sprintf("No Metabase collection has the path %q.", p.CollectionPath.ValueString()))
+				return diags
+			} else {
+				permissions[i].Collection = types.StringUnknown()
+			}
+		}
+	}
+
+	permissionsSet, setDiags := types.SetValueFrom(ctx, collectionPermissionObjectType, permissions)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Permissions = permissionsSet
+
+	return diags
+}
+
+func (r *CollectionGraphResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CollectionGraphResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Permissions.IsUnknown() {
+		return
+	}
+
+	permissions := make([]CollectionPermission, 0, len(data.Permissions.Elements()))
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range permissions {
+		resp.Diagnostics.Append(validatePermissionsGraphKey(p.Group, p.GroupName, "group", "group_name")...)
+		resp.Diagnostics.Append(validatePermissionsGraphKey(p.Collection, p.CollectionPath, "collection", "collection_path")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+func (r *CollectionGraphResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Names cannot be resolved when the provider is not configured yet, or when the resource is destroyed.
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data CollectionGraphResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resolveCollectionGraphModelNames(ctx, newPermissionsGraphNames(r.client), &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permissions"), data.Permissions)...)
+}
+
 func (r *CollectionGraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *CollectionGraphResourceModel
 
@@ -644,6 +794,12 @@ func (r *CollectionGraphResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Names which could not be resolved when planning reference groups or collections created during the apply.
+	resp.Diagnostics.Append(resolveCollectionGraphModelNames(ctx, newPermissionsGraphNames(r.client), data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set the current revision for the update
 	currentRevision := getResp.JSON200.Revision
 	data.Revision = types.Int64Value(int64(currentRevision))
@@ -740,13 +896,7 @@ func filterRecursivePermissions(ctx context.Context, permissions types.Set, clie
 		}
 	}
 
-	permissionsSet, setDiags := types.SetValue(types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"group":      types.Int64Type,
-			"collection": types.StringType,
-			"permission": types.StringType,
-		},
-	}, permissionsList)
+	permissionsSet, setDiags := types.SetValue(collectionPermissionObjectType, permissionsList)
 	diags.Append(setDiags...)
 	if diags.HasError() {
 		return permissions, diags
@@ -813,6 +963,12 @@ func (r *CollectionGraphResource) Update(ctx context.Context, req resource.Updat
 		data.ApplyChildCollectionsPermissions = types.BoolValue(true)
 	}
 
+	// Names which could not be resolved when planning reference groups or collections created during the apply.
+	resp.Diagnostics.Append(resolveCollectionGraphModelNames(ctx, newPermissionsGraphNames(r.client), data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Check basic changes first
 	permissionsChanged := !data.Permissions.Equal(state.Permissions)
 	flagChanged := !data.ApplyChildCollectionsPermissions.IsNull() && !state.ApplyChildCollectionsPermissions.IsNull() && !data.ApplyChildCollectionsPermissions.Equal(state.ApplyChildCollectionsPermissions)
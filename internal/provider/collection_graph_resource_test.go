@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccCollectionGraphResourceWithNames(collectionName string, permission string) string {
+	return fmt.Sprintf(`
+import {
+  to = metabase_collection_graph.graph
+  id = "1"
+}
+
+resource "metabase_collection" "test" {
+  name = "%s"
+}
+
+resource "metabase_collection_graph" "graph" {
+  apply_child_collections_permissions = false
+
+  permissions = [
+    {
+      group_name      = "All Users"
+      collection_path = metabase_collection.test.name
+      permission      = "%s"
+    },
+  ]
+}
+`,
+		collectionName,
+		permission,
+	)
+}
+
+func TestAccCollectionGraphResourceWithNames(t *testing.T) {
+	collectionName := "🔑 Graph Names"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCollectionDestroy,
+		Steps: []resource.TestStep{
+			// The collection is created during the apply, such that its path can only be resolved then.
+			{
+				Config: providerApiKeyConfig + testAccCollectionGraphResourceWithNames(collectionName, "read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_collection_graph.graph", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("metabase_collection_graph.graph", "permissions.0.group", "1"),
+					resource.TestCheckResourceAttr("metabase_collection_graph.graph", "permissions.0.group_name", "All Users"),
+					resource.TestCheckResourceAttrPair("metabase_collection_graph.graph", "permissions.0.collection", "metabase_collection.test", "id"),
+					resource.TestCheckResourceAttr("metabase_collection_graph.graph", "permissions.0.collection_path", collectionName),
+				),
+			},
+			{
+				Config: providerApiKeyConfig + testAccCollectionGraphResourceWithNames(collectionName, "write"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_collection_graph.graph", "permissions.0.permission", "write"),
+					resource.TestCheckResourceAttr("metabase_collection_graph.graph", "permissions.0.collection_path", collectionName),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &CollectionPermissionsResource{}
+var _ resource.ResourceWithValidateConfig = &CollectionPermissionsResource{}
+var _ resource.ResourceWithModifyPlan = &CollectionPermissionsResource{}
+
+// Creates a new collection permissions resource.
+func NewCollectionPermissionsResource() resource.Resource {
+	return &CollectionPermissionsResource{
+		MetabaseBaseResource{name: "collection_permissions"},
+	}
+}
+
+// A resource handling the permissions of a single group in the Metabase collection permissions graph.
+type CollectionPermissionsResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for the collection permissions of a group.
+type CollectionPermissionsResourceModel struct {
+	Id          types.Int64 `tfsdk:"id"`          // The ID of the permissions group.
+	GroupId     types.Int64 `tfsdk:"group_id"`    // The ID of the permissions group.
+	Permissions types.Set   `tfsdk:"permissions"` // The permissions of the group on each collection.
+}
+
+// The model for the permission of a group on a single collection.
+type GroupCollectionPermission struct {
+	Collection     types.String `tfsdk:"collection"`      // The collection to which the permission applies.
+	CollectionPath types.String `tfsdk:"collection_path"` // The path of the collection, which can be set instead of its ID.
+	Permission     types.String `tfsdk:"permission"`      // The permission level (read or write).
+}
+
+// The object type definition for the `GroupCollectionPermission` model.
+var groupCollectionPermissionObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"collection":      types.StringType,
+		"collection_path": types.StringType,
+		"permission":      types.StringType,
+	},
+}
+
+func (r *CollectionPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The permissions of a single permissions group on collections.
+
+Unlike ` + "`metabase_collection_graph`" + `, this resource only manages the permissions of one group, such that the permissions of different groups can be managed from separate Terraform configurations. Permissions of the group on collections which are not listed are removed. The group should be part of the ` + "`ignored_groups`" + ` if a ` + "`metabase_collection_graph`" + ` resource is also defined.
+
+Changes are applied to the latest revision of the collection graph, and are retried if the graph is modified concurrently. Destroying the resource removes all the permissions of the group on collections.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permissions group.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permissions group.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"permissions": schema.SetNestedAttribute{
+				MarkdownDescription: "The permissions of the group for each collection. A collection should appear only once in the list.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"collection": schema.StringAttribute{
+							MarkdownDescription: "The ID of the collection to which the permission applies. Exactly one of `collection` or `collection_path` must be set.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"collection_path": schema.StringAttribute{
+							MarkdownDescription: "The path of the collection to which the permission applies, made of the names of its ancestors and its own name separated by `/`, e.g. `Marketing/Reports`. It is resolved to the collection ID.",
+							Optional:            true,
+						},
+						"permission": schema.StringAttribute{
+							MarkdownDescription: "The level of permission (`read` or `write`).",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Makes the model of a collection graph containing only the permissions of the group, such that the functions handling
+// the entire graph can be reused.
+func makeCollectionGraphModelFromCollectionPermissions(ctx context.Context, data CollectionPermissionsResourceModel) (*CollectionGraphResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	groupPermissions := make([]GroupCollectionPermission, 0, len(data.Permissions.Elements()))
+	diags.Append(data.Permissions.ElementsAs(ctx, &groupPermissions, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	permissions := make([]CollectionPermission, 0, len(groupPermissions))
+	for _, p := range groupPermissions {
+		permissions = append(permissions, CollectionPermission{
+			Group:          data.GroupId,
+			GroupName:      types.StringNull(),
+			Collection:     p.Collection,
+			CollectionPath: p.CollectionPath,
+			Permission:     p.Permission,
+		})
+	}
+
+	permissionsSet, setDiags := types.SetValueFrom(ctx, collectionPermissionObjectType, permissions)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &CollectionGraphResourceModel{
+		Revision: types.Int64Null(),
+		// No group is ignored, as only the permissions of the group are part of the graph.
+		IgnoredGroups:                    types.SetValueMust(types.Int64Type, []attr.Value{}),
+		Permissions:                      permissionsSet,
+		ApplyChildCollectionsPermissions: types.BoolValue(false),
+	}, diags
+}
+
+// Updates the permissions of the `CollectionPermissionsResourceModel` from the model of the collection graph.
+func updateCollectionPermissionsFromCollectionGraphModel(ctx context.Context, graph CollectionGraphResourceModel, data *CollectionPermissionsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	permissions := make([]CollectionPermission, 0, len(graph.Permissions.Elements()))
+	diags.Append(graph.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	groupPermissions := make([]GroupCollectionPermission, 0, len(permissions))
+	for _, p := range permissions {
+		groupPermissions = append(groupPermissions, GroupCollectionPermission{
+			Collection:     p.Collection,
+			CollectionPath: p.CollectionPath,
+			Permission:     p.Permission,
+		})
+	}
+
+	permissionsSet, setDiags := types.SetValueFrom(ctx, groupCollectionPermissionObjectType, groupPermissions)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Permissions = permissionsSet
+	data.Id = data.GroupId
+
+	return diags
+}
+
+// Returns a copy of the collection graph only containing the permissions of the given group.
+func filterCollectionPermissionsGraphGroup(g metabase.CollectionPermissionsGraph, groupId string) metabase.CollectionPermissionsGraph {
+	filtered := metabase.CollectionPermissionsGraph{
+		Revision: g.Revision,
+		Groups:   map[string]metabase.CollectionPermissionsGraphCollectionPermissionsMap{},
+	}
+
+	if groupPermissions, ok := g.Groups[groupId]; ok {
+		filtered.Groups[groupId] = groupPermissions
+	}
+
+	return filtered
+}
+
+// Makes the body of the request updating the permissions of a single group in the collection graph.
+// Collections on which the group currently has a permission but which are not part of the model are set to `none`.
+func makeGroupCollectionPermissionsGraph(ctx context.Context, graph CollectionGraphResourceModel, groupId string, current metabase.CollectionPermissionsGraph) (*metabase.CollectionPermissionsGraph, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	permissions := make([]CollectionPermission, 0, len(graph.Permissions.Elements()))
+	diags.Append(graph.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	groupPermissions := make(metabase.CollectionPermissionsGraphCollectionPermissionsMap, len(permissions))
+	for colId, permission := range current.Groups[groupId] {
+		if permission != metabase.CollectionPermissionLevelNone {
+			groupPermissions[colId] = metabase.CollectionPermissionLevelNone
+		}
+	}
+
+	seen := make(map[string]bool, len(permissions))
+	for _, p := range permissions {
+		colId := p.Collection.ValueString()
+		if seen[colId] {
+			diags.AddError("Found duplicate permission definition.", fmt.Sprintf("Collection ID: %s.", colId))
+			return nil, diags
+		}
+		seen[colId] = true
+
+		groupPermissions[colId] = metabase.CollectionPermissionLevel(p.Permission.ValueString())
+	}
+
+	return &metabase.CollectionPermissionsGraph{
+		Revision: current.Revision,
+		Groups: map[string]metabase.CollectionPermissionsGraphCollectionPermissionsMap{
+			groupId: groupPermissions,
+		},
+	}, diags
+}
+
+// Replaces the permissions of the group in the collection graph with the ones in the model, and updates the model from
+// the graph returned by Metabase. Permissions of the group on collections which are not in the model are removed, while
+// the permissions of other groups are left untouched. The update is retried if the graph is modified concurrently.
+func updateGroupCollectionPermissions(ctx context.Context, client *metabase.ClientWithResponses, data *CollectionPermissionsResourceModel) diag.Diagnostics {
+	groupId := strconv.FormatInt(data.GroupId.ValueInt64(), 10)
+	names := newPermissionsGraphNames(client)
+
+	graph, diags := makeCollectionGraphModelFromCollectionPermissions(ctx, *data)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Names which could not be resolved when planning reference collections created during the apply.
+	diags.Append(resolveCollectionGraphModelNames(ctx, names, graph, true)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var updateResp *metabase.ReplaceCollectionPermissionsGraphResponse
+	diags.Append(retryOnPermissionsGraphConflict(func() (bool, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		getResp, err := client.GetCollectionPermissionsGraphWithResponse(ctx)
+		diags.Append(checkMetabaseResponse(getResp, err, []int{200}, "get collection graph")...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		body, graphDiags := makeGroupCollectionPermissionsGraph(ctx, *graph, groupId, *getResp.JSON200)
+		diags.Append(graphDiags...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		updateResp, err = client.ReplaceCollectionPermissionsGraphWithResponse(ctx, *body)
+		diags.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update collection graph")...)
+
+		return err == nil && updateResp.StatusCode() == http.StatusConflict, diags
+	})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(updateModelFromCollectionPermissionsGraph(ctx, names, filterCollectionPermissionsGraphGroup(*updateResp.JSON200, groupId), graph)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(updateCollectionPermissionsFromCollectionGraphModel(ctx, *graph, data)...)
+
+	return diags
+}
+
+func (r *CollectionPermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CollectionPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Permissions.IsUnknown() {
+		return
+	}
+
+	permissions := make([]GroupCollectionPermission, 0, len(data.Permissions.Elements()))
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range permissions {
+		resp.Diagnostics.Append(validatePermissionsGraphKey(p.Collection, p.CollectionPath, "collection", "collection_path")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+func (r *CollectionPermissionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Names cannot be resolved when the provider is not configured yet, or when the resource is destroyed.
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data CollectionPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Permissions.IsUnknown() {
+		return
+	}
+
+	graph, diags := makeCollectionGraphModelFromCollectionPermissions(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resolveCollectionGraphModelNames(ctx, newPermissionsGraphNames(r.client), graph, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateCollectionPermissionsFromCollectionGraphModel(ctx, *graph, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permissions"), data.Permissions)...)
+}
+
+func (r *CollectionPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CollectionPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateGroupCollectionPermissions(ctx, r.client, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CollectionPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The group ID and permissions are not set when importing the resource.
+	if data.GroupId.IsNull() {
+		data.GroupId = data.Id
+	}
+	if data.Permissions.IsNull() {
+		data.Permissions = types.SetValueMust(groupCollectionPermissionObjectType, []attr.Value{})
+	}
+
+	getResp, err := r.client.GetCollectionPermissionsGraphWithResponse(ctx)
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200}, "get collection graph")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graph, diags := makeCollectionGraphModelFromCollectionPermissions(ctx, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupId := strconv.FormatInt(data.GroupId.ValueInt64(), 10)
+	resp.Diagnostics.Append(updateModelFromCollectionPermissionsGraph(ctx, newPermissionsGraphNames(r.client), filterCollectionPermissionsGraphGroup(*getResp.JSON200, groupId), graph)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateCollectionPermissionsFromCollectionGraphModel(ctx, *graph, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CollectionPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateGroupCollectionPermissions(ctx, r.client, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CollectionPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Updating the group with no permission removes all its existing permissions.
+	data.Permissions = types.SetValueMust(groupCollectionPermissionObjectType, []attr.Value{})
+
+	resp.Diagnostics.Append(updateGroupCollectionPermissions(ctx, r.client, data)...)
+}
+
+func (r *CollectionPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIntegerId(ctx, req, resp)
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Returns the configuration of a group and a collection along with the collection graph, which ignores the group. The
+// permission of the group on the collection is managed by a `metabase_collection_permissions` resource, unless
+// `permission` is empty.
+func testAccCollectionPermissionsResource(name string, permission string) string {
+	config := fmt.Sprintf(`
+resource "metabase_permissions_group" "%s" {
+  name = "🗂️ Collection permissions"
+}
+
+resource "metabase_collection" "%s" {
+  name = "🗂️ Group collection"
+}
+
+data "metabase_permissions_group" "administrators" {
+  name = "Administrators"
+}
+
+import {
+  to = metabase_collection_graph.graph
+  id = "1"
+}
+
+resource "metabase_collection_graph" "graph" {
+  ignored_groups                      = [data.metabase_permissions_group.administrators.id, metabase_permissions_group.%s.id]
+  apply_child_collections_permissions = false
+
+  permissions = [
+    {
+      group      = 1
+      collection = metabase_collection.%s.id
+      permission = "read"
+    },
+  ]
+}
+`,
+		name,
+		name,
+		name,
+		name,
+	)
+
+	if permission == "" {
+		return config
+	}
+
+	return config + fmt.Sprintf(`
+resource "metabase_collection_permissions" "%s" {
+  group_id = metabase_permissions_group.%s.id
+
+  permissions = [
+    {
+      collection_path = metabase_collection.%s.name
+      permission      = "%s"
+    },
+  ]
+
+  # Both resources update the collection graph, which is simpler to do one after the other.
+  depends_on = [metabase_collection_graph.graph]
+}
+`,
+		name,
+		name,
+		name,
+		permission,
+	)
+}
+
+// Checks the permission of a group on a collection in the collection graph. A group without any permission on the
+// collection has the `none` permission.
+func testAccCheckCollectionPermission(groupId *int, collectionResourceName string, expected metabase.CollectionPermissionLevel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[collectionResourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state.", collectionResourceName)
+		}
+
+		response, err := testAccMetabaseClient.GetCollectionPermissionsGraphWithResponse(context.Background())
+		if diags := checkMetabaseResponse(response, err, []int{200}, "get collection graph"); diags.HasError() {
+			return fmt.Errorf("Failed to get collection graph: %v.", diags)
+		}
+
+		permission, ok := response.JSON200.Groups[strconv.Itoa(*groupId)][rs.Primary.ID]
+		if !ok {
+			permission = metabase.CollectionPermissionLevelNone
+		}
+
+		if permission != expected {
+			return fmt.Errorf("Expected permission %s on collection %s for group %d, got %s.", expected, rs.Primary.ID, *groupId, permission)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCollectionPermissionsResource(t *testing.T) {
+	var groupId int
+	allUsersId := metabase.AllUsersPermissionsGroupId
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckPermissionsGroupDestroy,
+			testAccCheckCollectionDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccCollectionPermissionsResource("analysts", "write"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreResourceId("metabase_permissions_group.analysts", &groupId),
+					resource.TestCheckResourceAttrPair("metabase_collection_permissions.analysts", "id", "metabase_permissions_group.analysts", "id"),
+					resource.TestCheckResourceAttrPair("metabase_collection_permissions.analysts", "permissions.0.collection", "metabase_collection.analysts", "id"),
+					testAccCheckCollectionPermission(&groupId, "metabase_collection.analysts", metabase.CollectionPermissionLevelWrite),
+					testAccCheckCollectionPermission(&allUsersId, "metabase_collection.analysts", metabase.CollectionPermissionLevelRead),
+				),
+			},
+			{
+				Config: providerApiKeyConfig + testAccCollectionPermissionsResource("analysts", "read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCollectionPermission(&groupId, "metabase_collection.analysts", metabase.CollectionPermissionLevelRead),
+					testAccCheckCollectionPermission(&allUsersId, "metabase_collection.analysts", metabase.CollectionPermissionLevelRead),
+				),
+			},
+			// Destroying the resource only revokes the permissions of the group.
+			{
+				Config: providerApiKeyConfig + testAccCollectionPermissionsResource("analysts", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCollectionPermission(&groupId, "metabase_collection.analysts", metabase.CollectionPermissionLevelNone),
+					testAccCheckCollectionPermission(&allUsersId, "metabase_collection.analysts", metabase.CollectionPermissionLevelRead),
+				),
+			},
+		},
+	})
+}
+
+func TestUpdateGroupCollectionPermissionsStandIn(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	serveStandInPermissionsGraphNames(s)
+	updates := serveStandInPermissionsGraph(s, "/collection/graph", map[string]map[string]any{
+		"1": {"root": "read", "5": "write"},
+		"3": {"root": "none", "20": "write"},
+	}, 2)
+
+	permissions, diags := types.SetValueFrom(ctx, groupCollectionPermissionObjectType, []GroupCollectionPermission{
+		{Collection: types.StringUnknown(), CollectionPath: types.StringValue("Marketing/Reports"), Permission: types.StringValue("read")},
+		{Collection: types.StringValue("root"), CollectionPath: types.StringNull(), Permission: types.StringValue("write")},
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	data := CollectionPermissionsResourceModel{
+		Id:          types.Int64Unknown(),
+		GroupId:     types.Int64Value(3),
+		Permissions: permissions,
+	}
+
+	diags = updateGroupCollectionPermissions(ctx, s.client(), &data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if len(*updates) != 1 {
+		t.Fatalf("Expected a single accepted update, got %v.", *updates)
+	}
+	update := (*updates)[0]
+	if update["revision"] != float64(3) {
+		t.Errorf("Expected the update to be retried with the latest revision, got %v.", update["revision"])
+	}
+	updatedGroups := update["groups"].(map[string]any)
+	if len(updatedGroups) != 1 {
+		t.Errorf("Expected only group 3 to be updated, got %v.", updatedGroups)
+	}
+	expected := map[string]any{"root": "write", "16": "read", "20": "none"}
+	for colId, permission := range expected {
+		if updatedGroups["3"].(map[string]any)[colId] != permission {
+			t.Errorf("Expected permission %v on collection %s, got %v.", permission, colId, updatedGroups["3"])
+		}
+	}
+
+	expectedPermissions, _ := types.SetValueFrom(ctx, groupCollectionPermissionObjectType, []GroupCollectionPermission{
+		{Collection: types.StringValue("16"), CollectionPath: types.StringValue("Marketing/Reports"), Permission: types.StringValue("read")},
+		{Collection: types.StringValue("root"), CollectionPath: types.StringNull(), Permission: types.StringValue("write")},
+	})
+	if data.Id != types.Int64Value(3) || !data.Permissions.Equal(expectedPermissions) {
+		t.Errorf("Expected permissions %v, got %v.", expectedPermissions, data.Permissions)
+	}
+}
+
+func TestMakeGroupCollectionPermissionsGraphDuplicate(t *testing.T) {
+	ctx := context.Background()
+
+	permissions, diags := types.SetValueFrom(ctx, groupCollectionPermissionObjectType, []GroupCollectionPermission{
+		{Collection: types.StringValue("5"), CollectionPath: types.StringNull(), Permission: types.StringValue("read")},
+		{Collection: types.StringValue("5"), CollectionPath: types.StringValue("Marketing"), Permission: types.StringValue("write")},
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	graph, diags := makeCollectionGraphModelFromCollectionPermissions(ctx, CollectionPermissionsResourceModel{
+		GroupId:     types.Int64Value(3),
+		Permissions: permissions,
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	_, diags = makeGroupCollectionPermissionsGraph(ctx, *graph, "3", metabase.CollectionPermissionsGraph{Revision: 1})
+	if !diags.HasError() {
+		t.Errorf("Expected an error for a collection listed twice.")
+	}
+}
@@ -0,0 +1,432 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &CollectionPinsResource{}
+var _ resource.ResourceWithValidateConfig = &CollectionPinsResource{}
+
+// Creates a new collection pins resource.
+func NewCollectionPinsResource() resource.Resource {
+	return &CollectionPinsResource{
+		MetabaseBaseResource{name: "collection_pins"},
+	}
+}
+
+// A resource handling the (ordered) list of pinned cards and dashboards in a collection.
+// In the Metabase API, an item is pinned when its `collection_position` is set.
+type CollectionPinsResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for the pins of a collection.
+type CollectionPinsResourceModel struct {
+	Id           types.String `tfsdk:"id"`            // The ID of the collection.
+	CollectionId types.String `tfsdk:"collection_id"` // The ID of the collection.
+	Items        types.List   `tfsdk:"items"`         // The pinned items, in order.
+}
+
+// The Terraform model for a pinned item.
+type CollectionPinModel struct {
+	CardId      types.Int64 `tfsdk:"card_id"`      // The ID of the pinned card.
+	DashboardId types.Int64 `tfsdk:"dashboard_id"` // The ID of the pinned dashboard.
+}
+
+// The object type for a pinned item.
+var collectionPinObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"card_id":      types.Int64Type,
+		"dashboard_id": types.Int64Type,
+	},
+}
+
+// A card or dashboard pinned in a collection.
+type collectionPin struct {
+	model string // Either `card` or `dashboard`.
+	id    int    // The ID of the card or dashboard.
+}
+
+func (r *CollectionPinsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The pinned cards (questions, models, metrics) and dashboards in a Metabase collection, in order.
+
+This resource is authoritative: items of the collection which are not listed are unpinned. Items pinned or re-ordered from the Metabase UI are detected as drift.
+
+The position of pinned items should not also be managed using ` + "`collection_position`" + ` in the ` + "`metabase_dashboard`" + ` and ` + "`metabase_card`" + ` resources. Leave it unset in those resources.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the collection.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"collection_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the collection, or `root` for the root collection.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "The pinned items, in the order in which they are displayed. Exactly one of `card_id` or `dashboard_id` should be set for each item.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"card_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the pinned card (question, model or metric).",
+							Optional:            true,
+						},
+						"dashboard_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the pinned dashboard.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *CollectionPinsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CollectionPinsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Items.IsNull() || data.Items.IsUnknown() {
+		return
+	}
+
+	var items []CollectionPinModel
+	resp.Diagnostics.Append(data.Items.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[collectionPin]bool)
+	for i, item := range items {
+		if item.CardId.IsUnknown() || item.DashboardId.IsUnknown() {
+			continue
+		}
+
+		if item.CardId.IsNull() == item.DashboardId.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("items").AtListIndex(i),
+				"Invalid pinned item.",
+				"Exactly one of card_id or dashboard_id should be set.",
+			)
+			continue
+		}
+
+		pin := makeCollectionPin(item)
+		if seen[pin] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("items").AtListIndex(i),
+				"Duplicate pinned item.",
+				fmt.Sprintf("The %s %d is pinned more than once.", pin.model, pin.id),
+			)
+		}
+		seen[pin] = true
+	}
+}
+
+// Returns the pinned item corresponding to the given model.
+func makeCollectionPin(item CollectionPinModel) collectionPin {
+	if !item.DashboardId.IsNull() {
+		return collectionPin{model: "dashboard", id: int(item.DashboardId.ValueInt64())}
+	}
+
+	return collectionPin{model: "card", id: int(item.CardId.ValueInt64())}
+}
+
+// Returns the ordered list of pinned items from the Terraform model.
+func makeCollectionPinsFromModel(ctx context.Context, items types.List) ([]collectionPin, diag.Diagnostics) {
+	var itemModels []CollectionPinModel
+	diags := items.ElementsAs(ctx, &itemModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	pins := make([]collectionPin, 0, len(itemModels))
+	for _, item := range itemModels {
+		pins = append(pins, makeCollectionPin(item))
+	}
+
+	return pins, diags
+}
+
+// Returns the list of pinned items as a Terraform value.
+func makeCollectionPinsValue(pins []collectionPin) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([]attr.Value, 0, len(pins))
+	for _, pin := range pins {
+		attributes := map[string]attr.Value{
+			"card_id":      types.Int64Null(),
+			"dashboard_id": types.Int64Null(),
+		}
+		attributes[pin.model+"_id"] = types.Int64Value(int64(pin.id))
+
+		value, objDiags := types.ObjectValue(collectionPinObjectType.AttrTypes, attributes)
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return types.ListNull(collectionPinObjectType), diags
+		}
+
+		values = append(values, value)
+	}
+
+	list, listDiags := types.ListValue(collectionPinObjectType, values)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// Fetches the items currently pinned in the collection, sorted by position, along with their positions.
+func getPinnedCollectionItems(ctx context.Context, client *metabase.ClientWithResponses, collectionId string) ([]collectionPin, map[collectionPin]int, bool, diag.Diagnostics) {
+	pinnedState := metabase.ListCollectionItemsParamsPinnedStateIsPinned
+	listResp, err := client.ListCollectionItemsWithResponse(ctx, collectionId, &metabase.ListCollectionItemsParams{
+		PinnedState: &pinnedState,
+	})
+
+	diags := checkMetabaseResponse(listResp, err, []int{200, 404}, "list pinned collection items")
+	if diags.HasError() || listResp.StatusCode() == 404 {
+		return nil, nil, false, diags
+	}
+
+	items := slices.Clone(listResp.JSON200.Data)
+	slices.SortStableFunc(items, func(a, b metabase.CollectionItem) int {
+		return collectionPositionOrZero(a.CollectionPosition) - collectionPositionOrZero(b.CollectionPosition)
+	})
+
+	pins := make([]collectionPin, 0, len(items))
+	positions := make(map[collectionPin]int, len(items))
+	for _, item := range items {
+		var pin collectionPin
+
+		// Questions, models and metrics are all cards.
+		switch item.Model {
+		case metabase.CollectionItemModelDashboard:
+			pin = collectionPin{model: "dashboard", id: item.Id}
+		case metabase.CollectionItemModelCard, metabase.CollectionItemModelDataset, "metric":
+			pin = collectionPin{model: "card", id: item.Id}
+		default:
+			continue
+		}
+
+		pins = append(pins, pin)
+		positions[pin] = collectionPositionOrZero(item.CollectionPosition)
+	}
+
+	return pins, positions, true, diags
+}
+
+// Returns the position, or 0 if the item is not pinned.
+func collectionPositionOrZero(position *int) int {
+	if position == nil {
+		return 0
+	}
+
+	return *position
+}
+
+// Sets the position of a card or dashboard in its collection. A `nil` position unpins the item.
+func setCollectionPosition(ctx context.Context, client *metabase.ClientWithResponses, pin collectionPin, position *int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	body, err := json.Marshal(map[string]any{"collection_position": position})
+	if err != nil {
+		diags.AddError("Error creating the payload for the collection position update.", err.Error())
+		return diags
+	}
+
+	operation := fmt.Sprintf("update %s collection position", pin.model)
+	if pin.model == "dashboard" {
+		updateResp, err := client.UpdateDashboardWithBodyWithResponse(ctx, pin.id, "application/json", bytes.NewReader(body))
+		return checkMetabaseResponse(updateResp, err, []int{200}, operation)
+	}
+
+	updateResp, err := client.UpdateCardWithBodyWithResponse(ctx, pin.id, "application/json", bytes.NewReader(body))
+	return checkMetabaseResponse(updateResp, err, []int{200}, operation)
+}
+
+// Updates the known positions of pinned items after the position of the given item has been set. Metabase shifts the
+// other items of the collection, such that no two items have the same position: items after the previous position of
+// the item move up, and items from its new position move down.
+func shiftCollectionPositions(positions map[collectionPin]int, pin collectionPin, position *int) {
+	if previous, ok := positions[pin]; ok {
+		delete(positions, pin)
+		for p, pos := range positions {
+			if pos > previous {
+				positions[p] = pos - 1
+			}
+		}
+	}
+
+	if position == nil {
+		return
+	}
+
+	for p, pos := range positions {
+		if pos >= *position {
+			positions[p] = pos + 1
+		}
+	}
+	positions[pin] = *position
+}
+
+// Pins the given items in order, and unpins any other item of the collection. Items which are already at their
+// position are left untouched.
+func updateCollectionPins(ctx context.Context, client *metabase.ClientWithResponses, collectionId string, pins []collectionPin) diag.Diagnostics {
+	currentPins, positions, found, diags := getPinnedCollectionItems(ctx, client, collectionId)
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		diags.AddAttributeError(path.Root("collection_id"), "Collection not found.", fmt.Sprintf("The collection %q does not exist.", collectionId))
+		return diags
+	}
+
+	for _, pin := range currentPins {
+		if !slices.Contains(pins, pin) {
+			diags.Append(setCollectionPosition(ctx, client, pin, nil)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			shiftCollectionPositions(positions, pin, nil)
+		}
+	}
+
+	for i, pin := range pins {
+		// Positions can be sparse (e.g. after items are unpinned in the Metabase UI), such that the order of the items
+		// is not enough to know if they are at the expected position.
+		position := i + 1
+		if current, ok := positions[pin]; ok && current == position {
+			continue
+		}
+
+		diags.Append(setCollectionPosition(ctx, client, pin, &position)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		shiftCollectionPositions(positions, pin, &position)
+	}
+
+	return diags
+}
+
+func (r *CollectionPinsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CollectionPinsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pins, diags := makeCollectionPinsFromModel(ctx, data.Items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateCollectionPins(ctx, r.client, data.CollectionId.ValueString(), pins)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.CollectionId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionPinsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CollectionPinsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pins, _, found, diags := getPinnedCollectionItems(ctx, r.client, data.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.CollectionId = data.Id
+	data.Items, diags = makeCollectionPinsValue(pins)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionPinsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CollectionPinsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pins, diags := makeCollectionPinsFromModel(ctx, data.Items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateCollectionPins(ctx, r.client, data.CollectionId.ValueString(), pins)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionPinsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CollectionPinsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentPins, _, found, diags := getPinnedCollectionItems(ctx, r.client, data.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !found {
+		return
+	}
+
+	for _, pin := range currentPins {
+		resp.Diagnostics.Append(setCollectionPosition(ctx, r.client, pin, nil)...)
+	}
+}
+
+func (r *CollectionPinsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
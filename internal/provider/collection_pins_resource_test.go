@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccCollectionPinsResource(name string, items string) string {
+	cardConfig := func(cardName string, displayName string) string {
+		return fmt.Sprintf(`
+resource "metabase_card" "%s" {
+  json = jsonencode({
+    name          = "%s"
+    description   = "📌"
+    collection_id = tonumber(metabase_collection.%s.id)
+    cache_ttl     = null
+    query_type    = "query"
+    dataset_query = {
+      database = 1
+      type     = "query"
+      query = {
+        source-table = 1
+      }
+    }
+    parameter_mappings     = []
+    display                = "table"
+    visualization_settings = {}
+    parameters             = []
+  })
+}
+`,
+			cardName,
+			displayName,
+			name,
+		)
+	}
+
+	return fmt.Sprintf(`
+resource "metabase_collection" "%s" {
+  name = "📌 Pins"
+}
+
+resource "metabase_dashboard" "%s" {
+  name          = "📌 Pinned dashboard"
+  collection_id = tonumber(metabase_collection.%s.id)
+}
+
+resource "metabase_collection_pins" "%s" {
+  collection_id = metabase_collection.%s.id
+  items         = [%s]
+}
+`,
+		name,
+		name,
+		name,
+		name,
+		name,
+		items,
+	) + cardConfig(name+"_a", "📌 First card") + cardConfig(name+"_b", "📌 Second card")
+}
+
+// Moves a pinned item in the Metabase UI, e.g. leaving a gap between positions.
+func testAccSetCollectionPosition(t *testing.T, pin collectionPin, position int) {
+	diags := setCollectionPosition(context.Background(), testAccMetabaseClient, pin, &position)
+	if diags.HasError() {
+		t.Fatalf("Failed to set the collection position: %v.", diags)
+	}
+}
+
+func TestAccCollectionPinsResource(t *testing.T) {
+	var cardBId int
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccCollectionPinsResource("test_pins", `
+    { card_id = metabase_card.test_pins_a.id },
+    { dashboard_id = metabase_dashboard.test_pins.id },
+    { card_id = metabase_card.test_pins_b.id },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreResourceId("metabase_card.test_pins_b", &cardBId),
+					resource.TestCheckResourceAttrPair("metabase_collection_pins.test_pins", "collection_id", "metabase_collection.test_pins", "id"),
+					resource.TestCheckResourceAttr("metabase_collection_pins.test_pins", "items.#", "3"),
+					resource.TestCheckResourceAttrPair("metabase_collection_pins.test_pins", "items.0.card_id", "metabase_card.test_pins_a", "id"),
+					resource.TestCheckResourceAttrPair("metabase_collection_pins.test_pins", "items.1.dashboard_id", "metabase_dashboard.test_pins", "id"),
+					resource.TestCheckResourceAttrPair("metabase_collection_pins.test_pins", "items.2.card_id", "metabase_card.test_pins_b", "id"),
+				),
+			},
+			{
+				ResourceName:      "metabase_collection_pins.test_pins",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerApiKeyConfig + testAccCollectionPinsResource("test_pins", `
+    { dashboard_id = metabase_dashboard.test_pins.id },
+    { card_id = metabase_card.test_pins_b.id },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_collection_pins.test_pins", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("metabase_collection_pins.test_pins", "items.0.dashboard_id", "metabase_dashboard.test_pins", "id"),
+					resource.TestCheckResourceAttrPair("metabase_collection_pins.test_pins", "items.1.card_id", "metabase_card.test_pins_b", "id"),
+				),
+			},
+			{
+				// Moving the second item leaves a gap at position 2, which does not change the order of the items. Pinning
+				// another item at position 3 should not push the second item after it.
+				PreConfig: func() {
+					testAccSetCollectionPosition(t, collectionPin{model: "card", id: cardBId}, 3)
+				},
+				Config: providerApiKeyConfig + testAccCollectionPinsResource("test_pins", `
+    { dashboard_id = metabase_dashboard.test_pins.id },
+    { card_id = metabase_card.test_pins_b.id },
+    { card_id = metabase_card.test_pins_a.id },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_collection_pins.test_pins", "items.#", "3"),
+					resource.TestCheckResourceAttrPair("metabase_collection_pins.test_pins", "items.2.card_id", "metabase_card.test_pins_a", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestMakeCollectionPinsValue(t *testing.T) {
+	ctx := context.Background()
+
+	items, diags := makeCollectionPinsValue([]collectionPin{{model: "card", id: 4}, {model: "dashboard", id: 2}})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	pins, diags := makeCollectionPinsFromModel(ctx, items)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var models []CollectionPinModel
+	diags = items.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if !models[0].DashboardId.IsNull() || !models[0].CardId.Equal(types.Int64Value(4)) {
+		t.Errorf("Expected the first item to be card 4, got %+v.", models[0])
+	}
+	if !reflect.DeepEqual(pins, []collectionPin{{model: "card", id: 4}, {model: "dashboard", id: 2}}) {
+		t.Errorf("Round trip produced different pins: %v.", pins)
+	}
+}
+
+func TestShiftCollectionPositions(t *testing.T) {
+	a := collectionPin{model: "card", id: 1}
+	b := collectionPin{model: "card", id: 2}
+	c := collectionPin{model: "dashboard", id: 1}
+	position := func(p int) *int { return &p }
+
+	tests := []struct {
+		name      string
+		positions map[collectionPin]int
+		pin       collectionPin
+		position  *int
+		expected  map[collectionPin]int
+	}{
+		{
+			name:      "pin at the position of another item",
+			positions: map[collectionPin]int{a: 1, b: 3},
+			pin:       c,
+			position:  position(3),
+			expected:  map[collectionPin]int{a: 1, b: 4, c: 3},
+		},
+		{
+			name:      "move up",
+			positions: map[collectionPin]int{a: 1, b: 2, c: 3},
+			pin:       c,
+			position:  position(1),
+			expected:  map[collectionPin]int{c: 1, a: 2, b: 3},
+		},
+		{
+			name:      "move down",
+			positions: map[collectionPin]int{a: 1, b: 2, c: 3},
+			pin:       a,
+			position:  position(3),
+			expected:  map[collectionPin]int{b: 1, c: 2, a: 3},
+		},
+		{
+			name:      "unpin",
+			positions: map[collectionPin]int{a: 1, b: 2, c: 3},
+			pin:       b,
+			position:  nil,
+			expected:  map[collectionPin]int{a: 1, c: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shiftCollectionPositions(tt.positions, tt.pin, tt.position)
+			if !reflect.DeepEqual(tt.positions, tt.expected) {
+				t.Errorf("Expected positions %v, got %v.", tt.expected, tt.positions)
+			}
+		})
+	}
+}
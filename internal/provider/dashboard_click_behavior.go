@@ -0,0 +1,558 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The Terraform model for the click behavior of a dashcard, or of one of its columns.
+// Click behaviors are stored by Metabase in the visualization settings of the dashcard, and reference raw IDs. This
+// model allows referencing parameters by name, and other dashboards or cards using Terraform references.
+type DashcardClickBehaviorModel struct {
+	Column            types.String `tfsdk:"column"`             // The column to which the behavior applies. Null if it applies to the entire card.
+	Action            types.String `tfsdk:"action"`             // What happens on click: `crossfilter`, `dashboard`, `question`, or `url`.
+	TargetId          types.Int64  `tfsdk:"target_id"`          // The ID of the dashboard or card that is opened.
+	UrlTemplate       types.String `tfsdk:"url_template"`       // The URL that is opened, which can reference column values.
+	ParameterMappings types.List   `tfsdk:"parameter_mappings"` // The parameters that are set from the clicked row.
+}
+
+// The Terraform model for a parameter set when clicking a dashcard.
+type DashcardClickParameterMappingModel struct {
+	SourceColumn types.String `tfsdk:"source_column"` // The column from which the value of the parameter is taken.
+	Parameter    types.String `tfsdk:"parameter"`     // The name of the parameter, when defined using a `parameter` block in the same dashboard.
+	ParameterId  types.String `tfsdk:"parameter_id"`  // The ID of the parameter, in this dashboard or in the target dashboard.
+}
+
+// The object type definition for the `DashcardClickParameterMappingModel` model.
+var dashcardClickParameterMappingObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"source_column": types.StringType,
+		"parameter":     types.StringType,
+		"parameter_id":  types.StringType,
+	},
+}
+
+// The object type definition for the `DashcardClickBehaviorModel` model.
+var dashcardClickBehaviorObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"column":             types.StringType,
+		"action":             types.StringType,
+		"target_id":          types.Int64Type,
+		"url_template":       types.StringType,
+		"parameter_mappings": types.ListType{ElemType: dashcardClickParameterMappingObjectType},
+	},
+}
+
+// The schema for the `DashcardClickBehaviorModel` model.
+var dashcardClickBehaviorAttribute = schema.ListNestedAttribute{
+	MarkdownDescription: "What happens when the card (or one of its columns) is clicked. Click behaviors are merged into the visualization settings of the card, and should not also be defined in `visualization_settings_json`.",
+	Optional:            true,
+	NestedObject: schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"column": schema.StringAttribute{
+				MarkdownDescription: "The name of the column to which the behavior applies. If not set, the behavior applies to the entire card.",
+				Optional:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "What happens on click. One of `crossfilter` (update parameters of this dashboard), `dashboard` (open another dashboard), `question` (open a card), or `url` (open a custom URL).",
+				Required:            true,
+			},
+			"target_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the dashboard or card that is opened. Required for the `dashboard` and `question` actions.",
+				Optional:            true,
+			},
+			"url_template": schema.StringAttribute{
+				MarkdownDescription: "The URL that is opened, which can reference values in the clicked row, e.g. `https://example.com/{{ID}}`. Required for the `url` action.",
+				Optional:            true,
+			},
+			"parameter_mappings": schema.ListNestedAttribute{
+				MarkdownDescription: "The parameters that are set using values in the clicked row. Only supported by the `crossfilter` and `dashboard` actions.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_column": schema.StringAttribute{
+							MarkdownDescription: "The name of the column from which the value of the parameter is taken.",
+							Required:            true,
+						},
+						"parameter": schema.StringAttribute{
+							MarkdownDescription: "The name of the parameter, as defined in a `parameter` block of this dashboard. Only supported by the `crossfilter` action. Either this or `parameter_id` should be set.",
+							Optional:            true,
+						},
+						"parameter_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the parameter, in this dashboard for the `crossfilter` action, or in the target dashboard for the `dashboard` action. Either this or `parameter` should be set.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// The `linkType` used by Metabase for each action opening another entity.
+var clickBehaviorLinkTypes = map[string]string{
+	"dashboard": "dashboard",
+	"question":  "question",
+	"url":       "url",
+}
+
+// Returns the key in the `column_settings` visualization settings for the given column.
+func makeColumnSettingsKey(column string) string {
+	key, _ := json.Marshal([]string{"name", column})
+	return string(key)
+}
+
+// Makes the raw `click_behavior` structure expected by Metabase from the Terraform model.
+func makeRawClickBehavior(ctx context.Context, cb DashcardClickBehaviorModel, parameterIds map[string]string) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var mappings []DashcardClickParameterMappingModel
+	if !cb.ParameterMappings.IsNull() {
+		diags.Append(cb.ParameterMappings.ElementsAs(ctx, &mappings, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	parameterMapping := make(map[string]any, len(mappings))
+	for _, m := range mappings {
+		parameterId := m.ParameterId.ValueString()
+		if m.ParameterId.IsNull() {
+			id, ok := parameterIds[m.Parameter.ValueString()]
+			if !ok {
+				diags.AddError("Unknown parameter referenced by click behavior.", fmt.Sprintf("No parameter named %q is defined in the dashboard.", m.Parameter.ValueString()))
+				return nil, diags
+			}
+			parameterId = id
+		}
+
+		sourceColumn := m.SourceColumn.ValueString()
+		parameterMapping[parameterId] = map[string]any{
+			"id":     parameterId,
+			"source": map[string]any{"type": "column", "id": sourceColumn, "name": sourceColumn},
+			"target": map[string]any{"type": "parameter", "id": parameterId},
+		}
+	}
+
+	action := cb.Action.ValueString()
+	if action == "crossfilter" {
+		return map[string]any{
+			"type":             "crossfilter",
+			"parameterMapping": parameterMapping,
+		}, diags
+	}
+
+	linkType, ok := clickBehaviorLinkTypes[action]
+	if !ok {
+		diags.AddError("Unsupported click behavior action.", fmt.Sprintf("Got %q, expected one of: crossfilter, dashboard, question, url.", action))
+		return nil, diags
+	}
+
+	clickBehavior := map[string]any{
+		"type":     "link",
+		"linkType": linkType,
+	}
+
+	if linkType == "url" {
+		clickBehavior["linkTemplate"] = cb.UrlTemplate.ValueString()
+	} else {
+		clickBehavior["targetId"] = cb.TargetId.ValueInt64()
+		clickBehavior["parameterMapping"] = parameterMapping
+	}
+
+	return clickBehavior, diags
+}
+
+// Merges the click behaviors defined in a `dashcard` block into the raw visualization settings of the card.
+func applyDashcardClickBehaviors(ctx context.Context, list types.List, parameterIds map[string]string, settings map[string]any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if list.IsNull() {
+		return diags
+	}
+
+	var clickBehaviors []DashcardClickBehaviorModel
+	diags.Append(list.ElementsAs(ctx, &clickBehaviors, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, cb := range clickBehaviors {
+		clickBehavior, cbDiags := makeRawClickBehavior(ctx, cb, parameterIds)
+		diags.Append(cbDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if cb.Column.IsNull() {
+			settings["click_behavior"] = clickBehavior
+			continue
+		}
+
+		columnSettings, ok := settings["column_settings"].(map[string]any)
+		if !ok {
+			columnSettings = map[string]any{}
+			settings["column_settings"] = columnSettings
+		}
+
+		key := makeColumnSettingsKey(cb.Column.ValueString())
+		column, ok := columnSettings[key].(map[string]any)
+		if !ok {
+			column = map[string]any{}
+			columnSettings[key] = column
+		}
+		column["click_behavior"] = clickBehavior
+	}
+
+	return diags
+}
+
+// Makes the Terraform model for a click behavior from the raw structure returned by Metabase. Returns nil if the
+// structure cannot be represented by the model, in which case it should be left in the visualization settings.
+// existing is the matching click behavior from the plan or state, used to preserve references by name.
+func makeClickBehaviorModelFromRaw(ctx context.Context, column types.String, raw map[string]any, parameterNames map[string]string, existing *DashcardClickBehaviorModel) (*DashcardClickBehaviorModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	cb := DashcardClickBehaviorModel{
+		Column:            column,
+		TargetId:          types.Int64Null(),
+		UrlTemplate:       types.StringNull(),
+		ParameterMappings: types.ListNull(dashcardClickParameterMappingObjectType),
+	}
+
+	switch raw["type"] {
+	case "crossfilter":
+		cb.Action = types.StringValue("crossfilter")
+	case "link":
+		switch raw["linkType"] {
+		case "dashboard", "question":
+			targetId, ok := raw["targetId"].(float64)
+			if !ok {
+				return nil, diags
+			}
+			cb.Action = types.StringValue(raw["linkType"].(string))
+			cb.TargetId = types.Int64Value(int64(targetId))
+		case "url":
+			linkTemplate, ok := raw["linkTemplate"].(string)
+			if !ok {
+				return nil, diags
+			}
+			cb.Action = types.StringValue("url")
+			cb.UrlTemplate = types.StringValue(linkTemplate)
+			return &cb, diags
+		default:
+			return nil, diags
+		}
+	default:
+		return nil, diags
+	}
+
+	var existingMappings []DashcardClickParameterMappingModel
+	if existing != nil && !existing.ParameterMappings.IsNull() {
+		diags.Append(existing.ParameterMappings.ElementsAs(ctx, &existingMappings, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	rawMappings, _ := raw["parameterMapping"].(map[string]any)
+	if len(rawMappings) == 0 && (existing == nil || existing.ParameterMappings.IsNull()) {
+		return &cb, diags
+	}
+
+	// Mappings are stored as an object by Metabase. They are sorted using the order of the existing mappings, and by ID
+	// for the new ones.
+	existingOrder := make(map[string]int, len(existingMappings))
+	for i, m := range existingMappings {
+		id := m.ParameterId.ValueString()
+		if m.ParameterId.IsNull() {
+			for parameterId, name := range parameterNames {
+				if name == m.Parameter.ValueString() {
+					id = parameterId
+				}
+			}
+		}
+		existingOrder[id] = i
+	}
+
+	parameterIds := make([]string, 0, len(rawMappings))
+	for id := range rawMappings {
+		parameterIds = append(parameterIds, id)
+	}
+	sortKeysByExistingOrder(parameterIds, existingOrder)
+
+	mappings := make([]DashcardClickParameterMappingModel, 0, len(parameterIds))
+	for _, id := range parameterIds {
+		rawMapping, ok := rawMappings[id].(map[string]any)
+		if !ok {
+			return nil, diags
+		}
+
+		source, _ := rawMapping["source"].(map[string]any)
+		target, _ := rawMapping["target"].(map[string]any)
+		sourceColumn, ok := source["id"].(string)
+		if !ok || source["type"] != "column" || target["type"] != "parameter" {
+			return nil, diags
+		}
+
+		mapping := DashcardClickParameterMappingModel{
+			SourceColumn: types.StringValue(sourceColumn),
+			Parameter:    types.StringNull(),
+			ParameterId:  types.StringValue(id),
+		}
+
+		var existingMapping *DashcardClickParameterMappingModel
+		if i, ok := existingOrder[id]; ok {
+			existingMapping = &existingMappings[i]
+		}
+
+		// Parameters of this dashboard defined using blocks are referenced by name, unless the ID was explicitly used.
+		if name, ok := parameterNames[id]; ok && cb.Action.ValueString() == "crossfilter" && (existingMapping == nil || existingMapping.ParameterId.IsNull()) {
+			mapping.Parameter = types.StringValue(name)
+			mapping.ParameterId = types.StringNull()
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, dashcardClickParameterMappingObjectType, mappings)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	cb.ParameterMappings = list
+
+	return &cb, diags
+}
+
+// Sorts keys using their position in the existing (plan or state) list. Keys which were not in the existing list are
+// placed at the end, sorted alphabetically.
+func sortKeysByExistingOrder(keys []string, existingOrder map[string]int) {
+	slices.SortFunc(keys, func(a, b string) int {
+		orderA, okA := existingOrder[a]
+		orderB, okB := existingOrder[b]
+		switch {
+		case okA && okB:
+			return orderA - orderB
+		case okA:
+			return -1
+		case okB:
+			return 1
+		default:
+			return strings.Compare(a, b)
+		}
+	})
+}
+
+// Extracts the click behaviors from the raw visualization settings returned by Metabase, removing them from the
+// settings. Click behaviors that cannot be represented by the model are left in the settings.
+// The existing click behaviors (from the plan or state) are used to preserve the order of the list.
+func extractDashcardClickBehaviors(ctx context.Context, settings map[string]any, parameterNames map[string]string, existing types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var existingClickBehaviors []DashcardClickBehaviorModel
+	if !existing.IsNull() && !existing.IsUnknown() {
+		diags.Append(existing.ElementsAs(ctx, &existingClickBehaviors, false)...)
+		if diags.HasError() {
+			return types.ListNull(dashcardClickBehaviorObjectType), diags
+		}
+	}
+
+	existingByColumn := make(map[string]*DashcardClickBehaviorModel, len(existingClickBehaviors))
+	existingOrder := make(map[string]int, len(existingClickBehaviors))
+	for i, cb := range existingClickBehaviors {
+		key := ""
+		if !cb.Column.IsNull() {
+			key = makeColumnSettingsKey(cb.Column.ValueString())
+		}
+		existingByColumn[key] = &existingClickBehaviors[i]
+		existingOrder[key] = i
+	}
+
+	// Click behaviors are indexed by their column settings key, or an empty key for the card itself.
+	clickBehaviors := make(map[string]DashcardClickBehaviorModel)
+
+	if raw, ok := settings["click_behavior"].(map[string]any); ok {
+		cb, cbDiags := makeClickBehaviorModelFromRaw(ctx, types.StringNull(), raw, parameterNames, existingByColumn[""])
+		diags.Append(cbDiags...)
+		if diags.HasError() {
+			return types.ListNull(dashcardClickBehaviorObjectType), diags
+		}
+
+		if cb != nil {
+			clickBehaviors[""] = *cb
+			delete(settings, "click_behavior")
+		}
+	}
+
+	if columnSettings, ok := settings["column_settings"].(map[string]any); ok {
+		for key, c := range columnSettings {
+			column, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			raw, ok := column["click_behavior"].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			var columnRef []string
+			if err := json.Unmarshal([]byte(key), &columnRef); err != nil || len(columnRef) != 2 || columnRef[0] != "name" {
+				continue
+			}
+
+			cb, cbDiags := makeClickBehaviorModelFromRaw(ctx, types.StringValue(columnRef[1]), raw, parameterNames, existingByColumn[key])
+			diags.Append(cbDiags...)
+			if diags.HasError() {
+				return types.ListNull(dashcardClickBehaviorObjectType), diags
+			}
+
+			if cb == nil {
+				continue
+			}
+
+			clickBehaviors[key] = *cb
+			delete(column, "click_behavior")
+			if len(column) == 0 {
+				delete(columnSettings, key)
+			}
+		}
+
+		if len(columnSettings) == 0 {
+			delete(settings, "column_settings")
+		}
+	}
+
+	if len(clickBehaviors) == 0 && existing.IsNull() {
+		return types.ListNull(dashcardClickBehaviorObjectType), diags
+	}
+
+	keys := make([]string, 0, len(clickBehaviors))
+	for key := range clickBehaviors {
+		keys = append(keys, key)
+	}
+	sortKeysByExistingOrder(keys, existingOrder)
+
+	result := make([]DashcardClickBehaviorModel, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, clickBehaviors[key])
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, dashcardClickBehaviorObjectType, result)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// Validates the click behaviors of `dashcard` blocks, checking that the attributes required by each action are set,
+// and that parameters referenced by name are defined in the dashboard.
+func validateDashcardClickBehaviors(ctx context.Context, dashcardPath path.Path, list types.List, refs dashboardReferences) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if list.IsNull() || list.IsUnknown() {
+		return diags
+	}
+
+	var clickBehaviors []DashcardClickBehaviorModel
+	diags.Append(list.ElementsAs(ctx, &clickBehaviors, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	seenColumns := make(map[string]bool, len(clickBehaviors))
+	for i, cb := range clickBehaviors {
+		cbPath := dashcardPath.AtName("click_behavior").AtListIndex(i)
+
+		if !cb.Column.IsUnknown() {
+			column := ""
+			if !cb.Column.IsNull() {
+				column = makeColumnSettingsKey(cb.Column.ValueString())
+			}
+
+			if seenColumns[column] {
+				diags.AddAttributeError(cbPath.AtName("column"), "Duplicate click behavior.", "Only one click behavior can be defined for the card, and for each column.")
+			}
+			seenColumns[column] = true
+		}
+
+		if cb.Action.IsUnknown() {
+			continue
+		}
+
+		action := cb.Action.ValueString()
+		_, isLink := clickBehaviorLinkTypes[action]
+		if action != "crossfilter" && !isLink {
+			diags.AddAttributeError(cbPath.AtName("action"), "Unsupported click behavior action.", fmt.Sprintf("Got %q, expected one of: crossfilter, dashboard, question, url.", action))
+			continue
+		}
+
+		if (action == "dashboard" || action == "question") && cb.TargetId.IsNull() {
+			diags.AddAttributeError(cbPath.AtName("target_id"), "Missing click behavior target.", fmt.Sprintf("target_id is required for the %s action.", action))
+		}
+
+		if action == "url" && cb.UrlTemplate.IsNull() {
+			diags.AddAttributeError(cbPath.AtName("url_template"), "Missing click behavior URL.", "url_template is required for the url action.")
+		}
+
+		if cb.ParameterMappings.IsNull() || cb.ParameterMappings.IsUnknown() {
+			continue
+		}
+
+		if action == "question" || action == "url" {
+			diags.AddAttributeError(cbPath.AtName("parameter_mappings"), "Unsupported click behavior parameter mappings.", fmt.Sprintf("Parameter mappings are not supported for the %s action.", action))
+			continue
+		}
+
+		var mappings []DashcardClickParameterMappingModel
+		diags.Append(cb.ParameterMappings.ElementsAs(ctx, &mappings, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for j, m := range mappings {
+			mappingPath := cbPath.AtName("parameter_mappings").AtListIndex(j)
+
+			if m.Parameter.IsUnknown() || m.ParameterId.IsUnknown() {
+				continue
+			}
+
+			if m.Parameter.IsNull() == m.ParameterId.IsNull() {
+				diags.AddAttributeError(mappingPath, "Invalid click behavior parameter mapping.", "Exactly one of parameter or parameter_id should be set.")
+				continue
+			}
+
+			if !m.Parameter.IsNull() && action != "crossfilter" {
+				diags.AddAttributeError(mappingPath.AtName("parameter"), "Invalid click behavior parameter mapping.", "Parameters of other dashboards should be referenced using parameter_id.")
+				continue
+			}
+
+			if !m.Parameter.IsNull() && refs.ParameterNames != nil && !refs.ParameterNames[m.Parameter.ValueString()] {
+				diags.AddAttributeError(
+					mappingPath.AtName("parameter"),
+					"Unknown dashboard parameter.",
+					fmt.Sprintf("No parameter block named %q is defined in the dashboard.", m.Parameter.ValueString()),
+				)
+			}
+
+			if !m.ParameterId.IsNull() && action == "crossfilter" && refs.ParameterIds != nil && !refs.ParameterIds[m.ParameterId.ValueString()] {
+				diags.AddAttributeError(
+					mappingPath.AtName("parameter_id"),
+					"Unknown dashboard parameter.",
+					fmt.Sprintf("No parameter with ID %q is defined in the dashboard.", m.ParameterId.ValueString()),
+				)
+			}
+		}
+	}
+
+	return diags
+}
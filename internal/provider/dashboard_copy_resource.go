@@ -0,0 +1,440 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+// Import is not supported, as the source dashboard cannot be determined from the copy.
+var _ resource.Resource = &DashboardCopyResource{}
+
+// Creates a new dashboard copy resource.
+func NewDashboardCopyResource() resource.Resource {
+	return &DashboardCopyResource{
+		MetabaseBaseResource{name: "dashboard_copy"},
+	}
+}
+
+// A resource handling a copy of a Metabase dashboard, created using the copy endpoint of the Metabase API.
+// Only the basic attributes and the parameter defaults of the copy are managed. The cards and their layout are copied
+// once, when the resource is created.
+type DashboardCopyResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for a dashboard copy.
+type DashboardCopyResourceModel struct {
+	Id                 types.Int64  `tfsdk:"id"`                  // The ID of the new dashboard.
+	SourceDashboardId  types.Int64  `tfsdk:"source_dashboard_id"` // The ID of the copied dashboard.
+	IsDeepCopy         types.Bool   `tfsdk:"is_deep_copy"`        // Whether the cards are also copied.
+	Name               types.String `tfsdk:"name"`                // The name of the new dashboard.
+	Description        types.String `tfsdk:"description"`         // A description for the new dashboard.
+	CollectionId       types.Int64  `tfsdk:"collection_id"`       // The ID of the collection in which the new dashboard is placed.
+	CollectionPosition types.Int64  `tfsdk:"collection_position"` // The position of the new dashboard in the collection.
+	ParameterDefaults  types.Map    `tfsdk:"parameter_defaults"`  // Default values of parameters, keyed by slug.
+	CardIds            types.Map    `tfsdk:"card_ids"`            // The IDs of the new cards, keyed by the IDs of the source cards.
+}
+
+func (r *DashboardCopyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A copy of a Metabase dashboard, e.g. to instantiate a template dashboard for several teams or regions.
+
+The dashboard is copied once when the resource is created, using the Metabase copy endpoint. With ` + "`is_deep_copy`" + `, the cards used by the dashboard are also copied into the target collection, and the IDs of the new cards are exposed in ` + "`card_ids`" + `. Afterwards, only the basic attributes and the parameter defaults of the copy are managed by Terraform. Changes to the source dashboard are not propagated.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the new dashboard.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"source_dashboard_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the dashboard to copy. Changing this re-creates the copy.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"is_deep_copy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cards used by the dashboard are also copied. Otherwise, the new dashboard references the same cards as the source dashboard. Changing this re-creates the copy.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "A user-displayable name for the new dashboard.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description for the new dashboard.",
+				Optional:            true,
+			},
+			"collection_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the collection in which the new dashboard (and copied cards) are placed.",
+				Optional:            true,
+			},
+			"collection_position": schema.Int64Attribute{
+				MarkdownDescription: "The position of the new dashboard in the collection. When unset, the position is not managed by this resource, e.g. such that it can be managed using `metabase_collection_pins`.",
+				Optional:            true,
+			},
+			"parameter_defaults": schema.MapAttribute{
+				MarkdownDescription: "Default values for parameters of the new dashboard, as JSON strings keyed by parameter slug. Parameters which are not listed keep the default of the source dashboard.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"card_ids": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the cards used by the new dashboard, keyed by the IDs of the corresponding cards in the source dashboard. Without `is_deep_copy`, the IDs are the same.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Returns a key identifying a dashcard by its tab and position, which are preserved when a dashboard is copied.
+func makeCopiedDashcardKey(d metabase.Dashboard, dc metabase.DashboardCard) string {
+	tabIndex := -1
+	if dc.DashboardTabId != nil {
+		tabIndex = slices.IndexFunc(d.Tabs, func(t metabase.DashboardTab) bool { return t.Id == *dc.DashboardTabId })
+	}
+
+	return fmt.Sprintf("%d/%d/%d/%d/%d", tabIndex, dc.Row, dc.Col, dc.SizeX, dc.SizeY)
+}
+
+// Returns the IDs of the cards in the copied dashboard, keyed by the IDs of the corresponding cards in the source
+// dashboard. Dashcards are matched using their tab and position.
+func makeCopiedCardIds(source metabase.Dashboard, copied metabase.Dashboard) map[string]int64 {
+	copiedCardIds := make(map[string]int)
+	for _, dc := range copied.Dashcards {
+		if dc.CardId != nil {
+			copiedCardIds[makeCopiedDashcardKey(copied, dc)] = *dc.CardId
+		}
+	}
+
+	cardIds := make(map[string]int64)
+	for _, dc := range source.Dashcards {
+		if dc.CardId == nil {
+			continue
+		}
+
+		copiedCardId, ok := copiedCardIds[makeCopiedDashcardKey(source, dc)]
+		if ok {
+			cardIds[strconv.Itoa(*dc.CardId)] = int64(copiedCardId)
+		}
+	}
+
+	return cardIds
+}
+
+// Returns the raw parameters of the dashboard, with the defaults overridden by the given JSON values keyed by slug.
+func makeParametersWithDefaults(ctx context.Context, parameters []metabase.DashboardParameter, parameterDefaults types.Map) ([]any, diag.Diagnostics) {
+	opaqueParameters, _, diags := makeOpaqueParametersFromTyped(parameters)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var defaults map[string]string
+	diags.Append(parameterDefaults.ElementsAs(ctx, &defaults, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for slug, defaultJson := range defaults {
+		index := slices.IndexFunc(parameters, func(p metabase.DashboardParameter) bool { return p.Slug == slug })
+		if index < 0 {
+			diags.AddAttributeError(
+				path.Root("parameter_defaults").AtMapKey(slug),
+				"Unknown dashboard parameter.",
+				fmt.Sprintf("The dashboard does not have a parameter with slug %q.", slug),
+			)
+			continue
+		}
+
+		var defaultValue any
+		err := json.Unmarshal([]byte(defaultJson), &defaultValue)
+		if err != nil {
+			diags.AddAttributeError(path.Root("parameter_defaults").AtMapKey(slug), "Failed to deserialize parameter default.", err.Error())
+			continue
+		}
+
+		opaqueParameters[index].(map[string]any)["default"] = defaultValue
+	}
+
+	return opaqueParameters, diags
+}
+
+// Updates the given `DashboardCopyResourceModel` from the `Dashboard` returned by the Metabase API.
+// Card IDs are not updated, as they are only known when the copy is created.
+func updateModelFromDashboardCopy(ctx context.Context, d metabase.Dashboard, data *DashboardCopyResourceModel) diag.Diagnostics {
+	data.Id = types.Int64Value(int64(d.Id))
+	data.Name = types.StringValue(d.Name)
+	data.Description = stringValueOrNull(d.Description)
+	data.CollectionId = int64ValueOrNull(d.CollectionId)
+	// A null position means it is not managed by this resource.
+	if !data.CollectionPosition.IsNull() {
+		data.CollectionPosition = int64ValueOrNull(d.CollectionPosition)
+	}
+
+	if data.ParameterDefaults.IsNull() {
+		return nil
+	}
+
+	opaqueParameters, _, diags := makeOpaqueParametersFromTyped(d.Parameters)
+	if diags.HasError() {
+		return diags
+	}
+
+	var existingDefaults map[string]string
+	diags.Append(data.ParameterDefaults.ElementsAs(ctx, &existingDefaults, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Only parameters which are listed in the model are considered, and the existing JSON strings are kept when they are
+	// equivalent to the values returned by the API.
+	defaults := make(map[string]string)
+	for _, p := range opaqueParameters {
+		parameter := p.(map[string]any)
+		slug, _ := parameter["slug"].(string)
+
+		existingDefault, ok := existingDefaults[slug]
+		if !ok {
+			continue
+		}
+
+		if jsonStringEqualsValue(existingDefault, parameter["default"]) {
+			defaults[slug] = existingDefault
+			continue
+		}
+
+		defaultBytes, err := json.Marshal(parameter["default"])
+		if err != nil {
+			diags.AddError("Failed to serialize parameter default.", err.Error())
+			return diags
+		}
+
+		defaults[slug] = string(defaultBytes)
+	}
+
+	var mapDiags diag.Diagnostics
+	data.ParameterDefaults, mapDiags = types.MapValueFrom(ctx, types.StringType, defaults)
+	diags.Append(mapDiags...)
+
+	return diags
+}
+
+// Calls the Metabase API to update the copied dashboard from a Terraform model, given its current parameters.
+func updateDashboardCopyFromModel(ctx context.Context, client *metabase.ClientWithResponses, parameters []metabase.DashboardParameter, data DashboardCopyResourceModel, operation string) (*metabase.UpdateDashboardResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	updatePayload := map[string]any{
+		"name":          data.Name.ValueString(),
+		"description":   valueStringOrNull(data.Description),
+		"collection_id": valueInt64OrNull(data.CollectionId),
+	}
+	if !data.CollectionPosition.IsNull() {
+		updatePayload["collection_position"] = valueInt64OrNull(data.CollectionPosition)
+	}
+
+	if !data.ParameterDefaults.IsNull() {
+		opaqueParameters, parametersDiags := makeParametersWithDefaults(ctx, parameters, data.ParameterDefaults)
+		diags.Append(parametersDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		updatePayload["parameters"] = opaqueParameters
+	}
+
+	updateBuffer, err := json.Marshal(updatePayload)
+	if err != nil {
+		diags.AddError("Error creating the payload for dashboard update.", err.Error())
+		return nil, diags
+	}
+
+	updateResp, err := client.UpdateDashboardWithBodyWithResponse(ctx, int(data.Id.ValueInt64()), "application/json", bytes.NewReader(updateBuffer))
+	diags.Append(checkMetabaseResponse(updateResp, err, []int{200}, operation)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return updateResp, diags
+}
+
+func (r *DashboardCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DashboardCopyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceId := int(data.SourceDashboardId.ValueInt64())
+	sourceResp, err := r.client.GetDashboardWithResponse(ctx, sourceId)
+	resp.Diagnostics.Append(checkMetabaseResponse(sourceResp, err, []int{200}, "get source dashboard")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isDeepCopy := data.IsDeepCopy.ValueBool()
+	copyResp, err := r.client.CopyDashboardWithResponse(ctx, sourceId, metabase.CopyDashboardBody{
+		Name:               data.Name.ValueString(),
+		Description:        valueStringOrNull(data.Description),
+		CollectionId:       valueInt64OrNull(data.CollectionId),
+		CollectionPosition: valueInt64OrNull(data.CollectionPosition),
+		IsDeepCopy:         &isDeepCopy,
+	})
+	resp.Diagnostics.Append(checkMetabaseResponse(copyResp, err, []int{200}, "copy dashboard")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var mapDiags diag.Diagnostics
+	data.CardIds, mapDiags = types.MapValueFrom(ctx, types.Int64Type, makeCopiedCardIds(*sourceResp.JSON200, *copyResp.JSON200))
+	resp.Diagnostics.Append(mapDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard := *copyResp.JSON200
+
+	// The copy is saved in the state right away, such that the dashboard and its cards are not orphaned if setting the
+	// parameter defaults fails. In this case, the resource is tainted and replaced by the next apply.
+	copied := *data
+	resp.Diagnostics.Append(updateModelFromDashboardCopy(ctx, dashboard, &copied)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &copied)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = copied.Id
+
+	// Parameter defaults can only be set once the dashboard has been copied.
+	if !data.ParameterDefaults.IsNull() {
+		updateResp, diags := updateDashboardCopyFromModel(ctx, r.client, dashboard.Parameters, *data, "update dashboard during copy")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		dashboard = *updateResp.JSON200
+	}
+
+	resp.Diagnostics.Append(updateModelFromDashboardCopy(ctx, dashboard, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DashboardCopyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.GetDashboardWithResponse(ctx, int(data.Id.ValueInt64()))
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get dashboard")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if getResp.StatusCode() == 404 || getResp.JSON200.Archived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromDashboardCopy(ctx, *getResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DashboardCopyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.GetDashboardWithResponse(ctx, int(data.Id.ValueInt64()))
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200}, "get dashboard")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResp, diags := updateDashboardCopyFromModel(ctx, r.client, getResp.JSON200.Parameters, *data, "update dashboard")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromDashboardCopy(ctx, *updateResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardCopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DashboardCopyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	archived := true
+	updateResp, err := r.client.UpdateDashboardWithResponse(ctx, int(data.Id.ValueInt64()), metabase.UpdateDashboardBody{
+		Archived: &archived,
+	})
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200, 404}, "delete (archive) dashboard")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Cards created by a deep copy are owned by this resource, and are archived along with the dashboard.
+	if !data.IsDeepCopy.ValueBool() || data.CardIds.IsNull() {
+		return
+	}
+
+	var cardIds map[string]int64
+	resp.Diagnostics.Append(data.CardIds.ElementsAs(ctx, &cardIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for sourceId, cardId := range cardIds {
+		if sourceId == strconv.FormatInt(cardId, 10) {
+			continue
+		}
+
+		cardResp, err := r.client.UpdateCardWithResponse(ctx, int(cardId), metabase.UpdateCardBody{
+			Archived: &archived,
+		})
+		resp.Diagnostics.Append(checkMetabaseResponse(cardResp, err, []int{200, 404}, "delete (archive) copied card")...)
+	}
+}
@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestMakeCopiedCardIds(t *testing.T) {
+	cardId := func(id int) *int { return &id }
+
+	source := metabase.Dashboard{
+		Tabs: []metabase.DashboardTab{{Id: 10, Name: "Overview"}, {Id: 11, Name: "Details"}},
+		Dashcards: []metabase.DashboardCard{
+			{CardId: cardId(1), DashboardTabId: cardId(10), Row: 0, Col: 0, SizeX: 6, SizeY: 3},
+			{CardId: cardId(2), DashboardTabId: cardId(11), Row: 0, Col: 0, SizeX: 6, SizeY: 3},
+			{CardId: nil, DashboardTabId: cardId(10), Row: 3, Col: 0, SizeX: 24, SizeY: 1},
+		},
+	}
+
+	// Tabs and dashcards are returned in a different order, with new IDs.
+	copied := metabase.Dashboard{
+		Tabs: []metabase.DashboardTab{{Id: 20, Name: "Overview"}, {Id: 21, Name: "Details"}},
+		Dashcards: []metabase.DashboardCard{
+			{CardId: nil, DashboardTabId: cardId(20), Row: 3, Col: 0, SizeX: 24, SizeY: 1},
+			{CardId: cardId(102), DashboardTabId: cardId(21), Row: 0, Col: 0, SizeX: 6, SizeY: 3},
+			{CardId: cardId(101), DashboardTabId: cardId(20), Row: 0, Col: 0, SizeX: 6, SizeY: 3},
+		},
+	}
+
+	expected := map[string]int64{"1": 101, "2": 102}
+	if got := makeCopiedCardIds(source, copied); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected card IDs %v, got %v.", expected, got)
+	}
+}
+
+func TestDashboardCopyParameterDefaultsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	parameters := []metabase.DashboardParameter{
+		{Id: "fb55bed", Name: "Region", Slug: "region", Type: "string/=", SectionId: "string"},
+		{Id: "dac08e9", Name: "Month", Slug: "month", Type: "date/month-year", SectionId: "date"},
+	}
+
+	defaults, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{
+		"region": `[ "EMEA" ]`,
+	})
+
+	opaqueParameters, diags := makeParametersWithDefaults(ctx, parameters, defaults)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if !reflect.DeepEqual(opaqueParameters[0].(map[string]any)["default"], []any{"EMEA"}) {
+		t.Errorf("Expected the region default to be set, got %v.", opaqueParameters[0])
+	}
+	if _, ok := opaqueParameters[1].(map[string]any)["default"]; ok {
+		t.Errorf("Expected the month default to be left unset, got %v.", opaqueParameters[1])
+	}
+
+	var region metabase.DashboardParameter_Default
+	if err := region.FromDashboardParameterDefault1([]interface{}{"EMEA"}); err != nil {
+		t.Fatal(err)
+	}
+	parameters[0].Default = &region
+
+	data := DashboardCopyResourceModel{ParameterDefaults: defaults}
+	diags = updateModelFromDashboardCopy(ctx, metabase.Dashboard{Id: 3, Name: "EMEA KPIs", Parameters: parameters}, &data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// The JSON string from the configuration is kept, as it is equivalent to the value returned by the API.
+	if !data.ParameterDefaults.Equal(defaults) {
+		t.Errorf("Expected parameter defaults %v, got %v.", defaults, data.ParameterDefaults)
+	}
+
+	unknown, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"country": `"FR"`})
+	if _, diags := makeParametersWithDefaults(ctx, parameters, unknown); !diags.HasError() {
+		t.Errorf("Expected an error for an unknown parameter slug.")
+	}
+}
+
+func testAccDashboardCopyResource(name string, copyName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "metabase_dashboard_copy" "%s" {
+  source_dashboard_id = metabase_dashboard.%s.id
+  name                = "%s"
+
+  parameter_defaults = {
+    month_and_year = jsonencode("2025-01")
+  }
+}
+`,
+		testAccDashboardResource(name, "📈 Template dashboard", "📖 Template"),
+		name,
+		name,
+		copyName,
+	)
+}
+
+func testAccCheckDashboardCopyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state.", resourceName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetDashboardWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 200 {
+			return fmt.Errorf("Received unexpected response from the Metabase API when getting dashboard.")
+		}
+
+		if rs.Primary.Attributes["source_dashboard_id"] == rs.Primary.ID {
+			return fmt.Errorf("Expected the copy to be a new dashboard.")
+		}
+
+		if len(response.JSON200.Dashcards) != 2 {
+			return fmt.Errorf("Expected the dashcards to be copied, got %d.", len(response.JSON200.Dashcards))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDashboardCopyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "metabase_dashboard_copy" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetDashboardWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 404 && !response.JSON200.Archived {
+			return fmt.Errorf("Dashboard copy %s still exists.", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestDashboardCopyUnmanagedCollectionPosition(t *testing.T) {
+	ctx := context.Background()
+	position := 1
+
+	// The copy was pinned outside of this resource, e.g. using `metabase_collection_pins`.
+	data := DashboardCopyResourceModel{CollectionPosition: types.Int64Null(), ParameterDefaults: types.MapNull(types.StringType)}
+	diags := updateModelFromDashboardCopy(ctx, metabase.Dashboard{Id: 3, Name: "EMEA KPIs", CollectionPosition: &position}, &data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !data.CollectionPosition.IsNull() {
+		t.Errorf("Expected the unmanaged position to be kept null, got %v.", data.CollectionPosition)
+	}
+
+	s := newMetabaseStandIn(t)
+	dashboards := s.serveObjects("/dashboard")
+	dashboards[3] = map[string]any{"id": 3, "name": "EMEA KPIs", "collection_position": position}
+
+	_, diags = updateDashboardCopyFromModel(ctx, s.client(), nil, data, "update dashboard")
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if dashboards[3]["collection_position"] != position {
+		t.Errorf("Expected the position to be left untouched, got %v.", dashboards[3]["collection_position"])
+	}
+}
+
+func TestDashboardCopyCreateKeepsCopyOnErrorStandIn(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	dashboard := func(id int, cardId int) map[string]any {
+		return map[string]any{
+			"id": id, "name": "KPIs", "archived": false, "tabs": []any{},
+			"parameters": []any{map[string]any{"id": "fb55bed", "name": "Region", "slug": "region", "type": "string/=", "sectionId": "string"}},
+			"dashcards":  []any{map[string]any{"id": 1, "card_id": cardId, "row": 0, "col": 0, "size_x": 6, "size_y": 3, "parameter_mappings": []any{}}},
+		}
+	}
+	s.serveJson("GET", "/dashboard/1", dashboard(1, 10))
+	s.serveJson("POST", "/dashboard/1/copy", dashboard(5, 20))
+	s.mux.HandleFunc("PUT /api/dashboard/5", func(w http.ResponseWriter, r *http.Request) {
+		writeStandInJson(w, http.StatusInternalServerError, map[string]any{"message": "Unexpected error."})
+	})
+
+	r := &DashboardCopyResource{MetabaseBaseResource{name: "dashboard_copy", client: s.client()}}
+	schemaResp := frameworkresource.SchemaResponse{}
+	r.Schema(ctx, frameworkresource.SchemaRequest{}, &schemaResp)
+
+	defaults, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"region": `["EMEA"]`})
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	diags := plan.Set(ctx, DashboardCopyResourceModel{
+		Id:                 types.Int64Unknown(),
+		SourceDashboardId:  types.Int64Value(1),
+		IsDeepCopy:         types.BoolValue(true),
+		Name:               types.StringValue("KPIs"),
+		Description:        types.StringNull(),
+		CollectionId:       types.Int64Null(),
+		CollectionPosition: types.Int64Null(),
+		ParameterDefaults:  defaults,
+		CardIds:            types.MapUnknown(types.Int64Type),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	resp := frameworkresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, frameworkresource.CreateRequest{Plan: plan}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error when setting the parameter defaults fails.")
+	}
+
+	// The copy is kept in the state, such that it is replaced rather than orphaned.
+	var state DashboardCopyResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if state.Id != types.Int64Value(5) || state.CardIds.Elements()["10"] != types.Int64Value(20) {
+		t.Errorf("Expected the copy and its cards in the state, got %v.", state)
+	}
+}
+
+func TestAccDashboardCopyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccDashboardCopyResource("test", "🇪🇺 EMEA dashboard"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardCopyExists("metabase_dashboard_copy.test"),
+					resource.TestCheckResourceAttr("metabase_dashboard_copy.test", "name", "🇪🇺 EMEA dashboard"),
+					resource.TestCheckResourceAttr("metabase_dashboard_copy.test", "parameter_defaults.month_and_year", "\"2025-01\""),
+				),
+			},
+			{
+				Config: providerApiKeyConfig + testAccDashboardCopyResource("test", "🇺🇸 AMER dashboard"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_dashboard_copy.test", "name", "🇺🇸 AMER dashboard"),
+				),
+			},
+		},
+	})
+}
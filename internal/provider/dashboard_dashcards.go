@@ -0,0 +1,495 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The Terraform model for a single `dashcard` block in a dashboard.
+// This is a typed alternative to the `cards_json` attribute, which only exposes the attributes needed to lay out cards.
+type DashcardModel struct {
+	CardId                    types.Int64  `tfsdk:"card_id"`                     // The ID of the card, or null for virtual cards (e.g. text).
+	ActionId                  types.Int64  `tfsdk:"action_id"`                   // The ID of the action run by an action button.
+	Row                       types.Int64  `tfsdk:"row"`                         // The index of the row at which the card is placed.
+	Col                       types.Int64  `tfsdk:"col"`                         // The index of the column at which the card is placed.
+	SizeX                     types.Int64  `tfsdk:"size_x"`                      // The horizontal size of the card.
+	SizeY                     types.Int64  `tfsdk:"size_y"`                      // The vertical size of the card.
+	Tab                       types.String `tfsdk:"tab"`                         // The name of the tab in which the card is placed.
+	ParameterMappings         types.List   `tfsdk:"parameter_mappings"`          // The mappings between dashboard parameters and the card.
+	VisualizationSettingsJson types.String `tfsdk:"visualization_settings_json"` // The visualization settings for the card, as a JSON string.
+	ClickBehaviors            types.List   `tfsdk:"click_behavior"`              // What happens when the card (or one of its columns) is clicked.
+}
+
+// The Terraform model for a single parameter mapping within a `dashcard` block.
+type DashcardParameterMappingModel struct {
+	ParameterId types.String `tfsdk:"parameter_id"` // The ID of the dashboard parameter.
+	Parameter   types.String `tfsdk:"parameter"`    // The name of the dashboard parameter, when defined using a `parameter` block.
+	CardId      types.Int64  `tfsdk:"card_id"`      // The ID of the card to which the parameter applies. Defaults to the dashcard's card.
+	TargetJson  types.String `tfsdk:"target_json"`  // The target of the parameter within the card, as a JSON string.
+}
+
+// The object type definition for the `DashcardParameterMappingModel` model.
+var dashcardParameterMappingObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"parameter_id": types.StringType,
+		"parameter":    types.StringType,
+		"card_id":      types.Int64Type,
+		"target_json":  types.StringType,
+	},
+}
+
+// The object type definition for the `DashcardModel` model.
+var dashcardObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"card_id":                     types.Int64Type,
+		"action_id":                   types.Int64Type,
+		"row":                         types.Int64Type,
+		"col":                         types.Int64Type,
+		"size_x":                      types.Int64Type,
+		"size_y":                      types.Int64Type,
+		"tab":                         types.StringType,
+		"parameter_mappings":          types.ListType{ElemType: dashcardParameterMappingObjectType},
+		"visualization_settings_json": types.StringType,
+		"click_behavior":              types.ListType{ElemType: dashcardClickBehaviorObjectType},
+	},
+}
+
+// The schema for the `DashcardModel` model.
+var dashcardAttributes = map[string]schema.Attribute{
+	"card_id": schema.Int64Attribute{
+		MarkdownDescription: "The ID of the card. This should be null for virtual cards, e.g. text cards.",
+		Optional:            true,
+	},
+	"action_id": schema.Int64Attribute{
+		MarkdownDescription: "For action buttons, the ID of the `metabase_action` run when the button is clicked. The label of the button can be set using the `metabase_virtual_dashcard` data source.",
+		Optional:            true,
+	},
+	"row": schema.Int64Attribute{
+		MarkdownDescription: "The index of the row at which the card is placed.",
+		Required:            true,
+	},
+	"col": schema.Int64Attribute{
+		MarkdownDescription: "The index of the column at which the card is placed.",
+		Required:            true,
+	},
+	"size_x": schema.Int64Attribute{
+		MarkdownDescription: "The horizontal size of the card.",
+		Required:            true,
+	},
+	"size_y": schema.Int64Attribute{
+		MarkdownDescription: "The vertical size of the card.",
+		Required:            true,
+	},
+	"tab": schema.StringAttribute{
+		MarkdownDescription: "The name of the tab in which the card is placed, as defined in a `tab` block or in `tabs_json`.",
+		Optional:            true,
+	},
+	"parameter_mappings": schema.ListNestedAttribute{
+		MarkdownDescription: "The mappings between dashboard parameters and the card.",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"parameter_id": schema.StringAttribute{
+					MarkdownDescription: "The ID of the dashboard parameter, as defined in `parameters_json`. Either this or `parameter` should be set.",
+					Optional:            true,
+				},
+				"parameter": schema.StringAttribute{
+					MarkdownDescription: "The name of the dashboard parameter, as defined in a `parameter` block. Either this or `parameter_id` should be set.",
+					Optional:            true,
+				},
+				"card_id": schema.Int64Attribute{
+					MarkdownDescription: "The ID of the card to which the parameter applies. Defaults to the `card_id` of the dashcard.",
+					Optional:            true,
+				},
+				"target_json": schema.StringAttribute{
+					MarkdownDescription: "The target of the parameter within the card (e.g. a dimension referencing a field), as a JSON string.",
+					Required:            true,
+				},
+			},
+		},
+	},
+	"visualization_settings_json": schema.StringAttribute{
+		MarkdownDescription: "The visualization settings for the card, as a JSON string. This is where the content of virtual cards (e.g. text) is defined.",
+		Optional:            true,
+	},
+	"click_behavior": dashcardClickBehaviorAttribute,
+}
+
+// Returns whether the cards in the dashboard are defined using `dashcard` blocks rather than `cards_json`.
+// When both are null, e.g. right after an import, `cards_json` is used.
+func usesDashcardBlocks(data DashboardResourceModel) bool {
+	return data.CardsJson.IsNull() && !data.Dashcards.IsNull()
+}
+
+// Returns whether the given JSON string and the unmarshalled JSON value represent the same value.
+func jsonStringEqualsValue(s string, v any) bool {
+	var parsed any
+	if err := json.Unmarshal([]byte(s), &parsed); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(parsed, v)
+}
+
+// Constructs the list of dashboard cards from `dashcard` blocks, as a type-less list of maps that can be serialized to
+// JSON. Similarly to `makeCardsFromModel`, negative IDs are used for cards and tabs. References to tabs and parameters
+// by name are resolved to their IDs.
+func makeCardsFromDashcardBlocks(ctx context.Context, data DashboardResourceModel) ([]map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var dashcards []DashcardModel
+	diags.Append(data.Dashcards.ElementsAs(ctx, &dashcards, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tabIds, tabsDiags := makeTabIdsByName(ctx, data)
+	diags.Append(tabsDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	parameterIds, parametersDiags := makeParameterIdsByName(ctx, data)
+	diags.Append(parametersDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	cards := make([]map[string]any, 0, len(dashcards))
+	for i, dc := range dashcards {
+		card := map[string]any{
+			"id":      -i,
+			"card_id": valueInt64OrNull(dc.CardId),
+			"row":     dc.Row.ValueInt64(),
+			"col":     dc.Col.ValueInt64(),
+			"size_x":  dc.SizeX.ValueInt64(),
+			"size_y":  dc.SizeY.ValueInt64(),
+			"series":  []any{},
+		}
+
+		if !dc.ActionId.IsNull() {
+			card["action_id"] = valueInt64OrNull(dc.ActionId)
+		}
+
+		if !dc.Tab.IsNull() {
+			tabId, ok := tabIds[dc.Tab.ValueString()]
+			if !ok {
+				diags.AddError("Unknown tab referenced by dashcard.", fmt.Sprintf("No tab named %q is defined in the dashboard.", dc.Tab.ValueString()))
+				return nil, diags
+			}
+
+			card["dashboard_tab_id"] = -tabId
+		}
+
+		visualizationSettings := map[string]any{}
+		if !dc.VisualizationSettingsJson.IsNull() {
+			err := json.Unmarshal([]byte(dc.VisualizationSettingsJson.ValueString()), &visualizationSettings)
+			if err != nil {
+				diags.AddError("Unable to parse dashcard visualization settings JSON.", err.Error())
+				return nil, diags
+			}
+		}
+		diags.Append(applyDashcardClickBehaviors(ctx, dc.ClickBehaviors, parameterIds, visualizationSettings)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		card["visualization_settings"] = visualizationSettings
+
+		var mappings []DashcardParameterMappingModel
+		if !dc.ParameterMappings.IsNull() {
+			diags.Append(dc.ParameterMappings.ElementsAs(ctx, &mappings, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		parameterMappings := make([]any, 0, len(mappings))
+		for _, m := range mappings {
+			var target any
+			err := json.Unmarshal([]byte(m.TargetJson.ValueString()), &target)
+			if err != nil {
+				diags.AddError("Unable to parse dashcard parameter mapping target JSON.", err.Error())
+				return nil, diags
+			}
+
+			mappingCardId := valueInt64OrNull(m.CardId)
+			if mappingCardId == nil {
+				mappingCardId = valueInt64OrNull(dc.CardId)
+			}
+
+			parameterId := m.ParameterId.ValueString()
+			if m.ParameterId.IsNull() {
+				id, ok := parameterIds[m.Parameter.ValueString()]
+				if !ok {
+					diags.AddError("Unknown parameter referenced by dashcard.", fmt.Sprintf("No parameter named %q is defined in the dashboard.", m.Parameter.ValueString()))
+					return nil, diags
+				}
+				parameterId = id
+			}
+
+			parameterMappings = append(parameterMappings, map[string]any{
+				"parameter_id": parameterId,
+				"card_id":      mappingCardId,
+				"target":       target,
+			})
+		}
+		card["parameter_mappings"] = parameterMappings
+
+		cards = append(cards, card)
+	}
+
+	return cards, diags
+}
+
+// Returns the key identifying the position of a dashcard, used to match cards returned by the Metabase API with the
+// blocks in the Terraform model.
+func makeDashcardPositionKey(tab types.String, row int64, col int64) string {
+	return fmt.Sprintf("%s/%d/%d", tab.ValueString(), row, col)
+}
+
+// Makes the list of parameter mappings for a `dashcard` block from the raw mappings returned by the Metabase API.
+// The existing mappings (from the plan or state) are used to avoid spurious diffs in JSON strings and default values.
+// parameterNames maps the IDs of parameters defined using `parameter` blocks to their names.
+func makeDashcardParameterMappingsValue(ctx context.Context, rawMappings []any, cardId types.Int64, existing *DashcardModel, parameterNames map[string]string) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var existingMappings []DashcardParameterMappingModel
+	if existing != nil && !existing.ParameterMappings.IsNull() {
+		diags.Append(existing.ParameterMappings.ElementsAs(ctx, &existingMappings, false)...)
+		if diags.HasError() {
+			return types.ListNull(dashcardParameterMappingObjectType), diags
+		}
+	}
+
+	if len(rawMappings) == 0 && (existing == nil || existing.ParameterMappings.IsNull()) {
+		return types.ListNull(dashcardParameterMappingObjectType), diags
+	}
+
+	mappings := make([]DashcardParameterMappingModel, 0, len(rawMappings))
+	for i, m := range rawMappings {
+		rawMapping, ok := m.(map[string]any)
+		if !ok {
+			diags.AddError("Could not parse dashcard parameter mapping as object.", fmt.Sprint(m))
+			return types.ListNull(dashcardParameterMappingObjectType), diags
+		}
+
+		var existingMapping *DashcardParameterMappingModel
+		if i < len(existingMappings) {
+			existingMapping = &existingMappings[i]
+		}
+
+		parameterId, _ := rawMapping["parameter_id"].(string)
+		mapping := DashcardParameterMappingModel{
+			ParameterId: types.StringValue(parameterId),
+			Parameter:   types.StringNull(),
+			CardId:      types.Int64Null(),
+		}
+
+		// Parameters defined using blocks are referenced by name, unless the ID was explicitly used.
+		if parameterName, ok := parameterNames[parameterId]; ok && (existingMapping == nil || existingMapping.ParameterId.IsNull()) {
+			mapping.ParameterId = types.StringNull()
+			mapping.Parameter = types.StringValue(parameterName)
+		}
+
+		// The card ID is left null if it was not specified and simply defaults to the dashcard's card.
+		if mappingCardId, ok := rawMapping["card_id"].(float64); ok {
+			mapping.CardId = types.Int64Value(int64(mappingCardId))
+			if existingMapping != nil && existingMapping.CardId.IsNull() && mapping.CardId.Equal(cardId) {
+				mapping.CardId = types.Int64Null()
+			}
+		}
+
+		target := rawMapping["target"]
+		if existingMapping != nil && jsonStringEqualsValue(existingMapping.TargetJson.ValueString(), target) {
+			mapping.TargetJson = existingMapping.TargetJson
+		} else {
+			targetBytes, err := json.Marshal(target)
+			if err != nil {
+				diags.AddError("Error serializing dashcard parameter mapping target.", err.Error())
+				return types.ListNull(dashcardParameterMappingObjectType), diags
+			}
+
+			mapping.TargetJson = types.StringValue(string(targetBytes))
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, dashcardParameterMappingObjectType, mappings)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// Makes a `DashcardModel` from a raw dashcard returned by the Metabase API, which has already been cleaned and for which
+// tab IDs have been mapped to the IDs used in the Terraform model.
+func makeDashcardModelFromRawCard(ctx context.Context, card map[string]any, tabNames map[int]string, parameterNames map[string]string, existing *DashcardModel) (*DashcardModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	dc := DashcardModel{
+		CardId:                    types.Int64Null(),
+		ActionId:                  types.Int64Null(),
+		Row:                       types.Int64Value(int64(toInt(card["row"]))),
+		Col:                       types.Int64Value(int64(toInt(card["col"]))),
+		SizeX:                     types.Int64Value(int64(toInt(card["size_x"]))),
+		SizeY:                     types.Int64Value(int64(toInt(card["size_y"]))),
+		Tab:                       types.StringNull(),
+		VisualizationSettingsJson: types.StringNull(),
+		ClickBehaviors:            types.ListNull(dashcardClickBehaviorObjectType),
+	}
+
+	if cardId, ok := card["card_id"].(float64); ok {
+		dc.CardId = types.Int64Value(int64(cardId))
+	}
+
+	if actionId, ok := card["action_id"].(float64); ok {
+		dc.ActionId = types.Int64Value(int64(actionId))
+	}
+
+	if tabId, ok := card["dashboard_tab_id"]; ok {
+		if tabName, ok := tabNames[toInt(tabId)]; ok {
+			dc.Tab = types.StringValue(tabName)
+		}
+	}
+
+	visualizationSettings, _ := card["visualization_settings"].(map[string]any)
+
+	// Click behaviors are only extracted from the visualization settings if they were defined using the dedicated
+	// attribute. Otherwise, they are left in the JSON string, which may define them directly.
+	if existing != nil && !existing.ClickBehaviors.IsNull() && visualizationSettings != nil {
+		clickBehaviors, clickDiags := extractDashcardClickBehaviors(ctx, visualizationSettings, parameterNames, existing.ClickBehaviors)
+		diags.Append(clickDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		dc.ClickBehaviors = clickBehaviors
+	}
+	if existing != nil && !existing.VisualizationSettingsJson.IsNull() && jsonStringEqualsValue(existing.VisualizationSettingsJson.ValueString(), visualizationSettings) {
+		dc.VisualizationSettingsJson = existing.VisualizationSettingsJson
+	} else if len(visualizationSettings) > 0 || (existing != nil && !existing.VisualizationSettingsJson.IsNull()) {
+		settingsBytes, err := json.Marshal(visualizationSettings)
+		if err != nil {
+			diags.AddError("Error serializing dashcard visualization settings.", err.Error())
+			return nil, diags
+		}
+
+		dc.VisualizationSettingsJson = types.StringValue(string(settingsBytes))
+	}
+
+	rawMappings, _ := card["parameter_mappings"].([]any)
+	mappings, mappingsDiags := makeDashcardParameterMappingsValue(ctx, rawMappings, dc.CardId, existing, parameterNames)
+	diags.Append(mappingsDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	dc.ParameterMappings = mappings
+
+	return &dc, diags
+}
+
+// Updates the `dashcard` blocks in the `DashboardResourceModel` from the cleaned dashcards returned by the Metabase API.
+// Cards are matched with the existing blocks using their position, such that the order of the blocks defined by the
+// user is preserved. Cards which do not match any existing block are appended at the end of the list.
+func updateDashcardBlocksFromRawCards(ctx context.Context, rawCards []any, data *DashboardResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var existingDashcards []DashcardModel
+	if !data.Dashcards.IsNull() && !data.Dashcards.IsUnknown() {
+		diags.Append(data.Dashcards.ElementsAs(ctx, &existingDashcards, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	tabIds, tabsDiags := makeTabIdsByName(ctx, *data)
+	diags.Append(tabsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	tabNames := make(map[int]string, len(tabIds))
+	for name, id := range tabIds {
+		tabNames[id] = name
+	}
+
+	parameterIds, parametersDiags := makeParameterIdsByName(ctx, *data)
+	diags.Append(parametersDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	parameterNames := make(map[string]string, len(parameterIds))
+	for name, id := range parameterIds {
+		parameterNames[id] = name
+	}
+
+	// Indexing the cards returned by the API by their position.
+	rawCardsByPosition := make(map[string]map[string]any, len(rawCards))
+	positionKeys := make([]string, 0, len(rawCards))
+	for _, c := range rawCards {
+		card, ok := c.(map[string]any)
+		if !ok {
+			diags.AddError("Could not parse dashcard as object.", fmt.Sprint(c))
+			return diags
+		}
+
+		tab := types.StringNull()
+		if tabName, ok := tabNames[toInt(card["dashboard_tab_id"])]; ok && card["dashboard_tab_id"] != nil {
+			tab = types.StringValue(tabName)
+		}
+
+		key := makeDashcardPositionKey(tab, int64(toInt(card["row"])), int64(toInt(card["col"])))
+		rawCardsByPosition[key] = card
+		positionKeys = append(positionKeys, key)
+	}
+
+	dashcards := make([]DashcardModel, 0, len(rawCards))
+	for _, existing := range existingDashcards {
+		key := makeDashcardPositionKey(existing.Tab, existing.Row.ValueInt64(), existing.Col.ValueInt64())
+		card, ok := rawCardsByPosition[key]
+		if !ok {
+			continue
+		}
+		delete(rawCardsByPosition, key)
+
+		dc, dcDiags := makeDashcardModelFromRawCard(ctx, card, tabNames, parameterNames, &existing)
+		diags.Append(dcDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		dashcards = append(dashcards, *dc)
+	}
+
+	// Remaining cards have been added outside of Terraform, or moved. They are appended in the sorted order.
+	for _, key := range positionKeys {
+		card, ok := rawCardsByPosition[key]
+		if !ok {
+			continue
+		}
+		delete(rawCardsByPosition, key)
+
+		dc, dcDiags := makeDashcardModelFromRawCard(ctx, card, tabNames, parameterNames, nil)
+		diags.Append(dcDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		dashcards = append(dashcards, *dc)
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, dashcardObjectType, dashcards)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Dashcards = list
+
+	return diags
+}
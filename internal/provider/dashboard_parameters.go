@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The Terraform model for a single `parameter` block in a dashboard.
+// Only the most common attributes of a parameter are modelled. More complex parameters can still be defined using
+// `parameters_json`.
+type DashboardParameterModel struct {
+	Id          types.String `tfsdk:"id"`           // The ID of the parameter. Defaults to the slug.
+	Name        types.String `tfsdk:"name"`         // The displayed name for the parameter.
+	Slug        types.String `tfsdk:"slug"`         // The slug name for the parameter, used in URLs.
+	Type        types.String `tfsdk:"type"`         // The type of parameter, e.g. `date/all-options`.
+	SectionId   types.String `tfsdk:"section_id"`   // The ID of the section in which the parameter is listed in the UI.
+	DefaultJson types.String `tfsdk:"default_json"` // The default value for the parameter, as a JSON string.
+	Required    types.Bool   `tfsdk:"required"`     // Whether the parameter is required.
+}
+
+// The object type definition for the `DashboardParameterModel` model.
+var dashboardParameterObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":           types.StringType,
+		"name":         types.StringType,
+		"slug":         types.StringType,
+		"type":         types.StringType,
+		"section_id":   types.StringType,
+		"default_json": types.StringType,
+		"required":     types.BoolType,
+	},
+}
+
+// The schema for the `DashboardParameterModel` model.
+var dashboardParameterAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		MarkdownDescription: "The ID of the parameter. Defaults to the `slug`. This only needs to be set to keep the ID of an existing parameter, e.g. when migrating from `parameters_json`.",
+		Optional:            true,
+	},
+	"name": schema.StringAttribute{
+		MarkdownDescription: "The displayed name for the parameter, which should be unique within the dashboard. Cards reference parameters using this name.",
+		Required:            true,
+	},
+	"slug": schema.StringAttribute{
+		MarkdownDescription: "The slug name for the parameter, used in URLs.",
+		Required:            true,
+	},
+	"type": schema.StringAttribute{
+		MarkdownDescription: "The type of parameter, e.g. `date/all-options` or `string/=`.",
+		Required:            true,
+	},
+	"section_id": schema.StringAttribute{
+		MarkdownDescription: "The ID of the section in which the parameter is listed in the UI, e.g. `date` or `string`.",
+		Optional:            true,
+	},
+	"default_json": schema.StringAttribute{
+		MarkdownDescription: "The default value for the parameter, as a JSON string. Depending on the type of parameter, this can be a string or a list.",
+		Optional:            true,
+	},
+	"required": schema.BoolAttribute{
+		MarkdownDescription: "Whether the parameter is required. A required parameter should have a default value.",
+		Optional:            true,
+	},
+}
+
+// Returns whether the parameters of the dashboard are defined using `parameter` blocks rather than `parameters_json`.
+// When both are null, e.g. right after an import, `parameters_json` is used. Like for tabs, an empty list of blocks only
+// counts when cards are not defined using `cards_json` either.
+func usesParameterBlocks(data DashboardResourceModel) bool {
+	if !data.ParametersJson.IsNull() || data.Parameters.IsNull() {
+		return false
+	}
+
+	return data.Parameters.IsUnknown() || len(data.Parameters.Elements()) > 0 || data.CardsJson.IsNull()
+}
+
+// Returns the ID of a parameter defined in a `parameter` block.
+func (p DashboardParameterModel) parameterId() string {
+	if !p.Id.IsNull() {
+		return p.Id.ValueString()
+	}
+
+	return p.Slug.ValueString()
+}
+
+// Returns a map from parameter names to parameter IDs, for parameters defined using `parameter` blocks.
+// An empty map is returned if the dashboard uses `parameters_json`, in which case cards should reference IDs directly.
+func makeParameterIdsByName(ctx context.Context, data DashboardResourceModel) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	parameterIds := make(map[string]string)
+
+	if !usesParameterBlocks(data) || data.Parameters.IsUnknown() {
+		return parameterIds, diags
+	}
+
+	var parameters []DashboardParameterModel
+	diags.Append(data.Parameters.ElementsAs(ctx, &parameters, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, p := range parameters {
+		parameterIds[p.Name.ValueString()] = p.parameterId()
+	}
+
+	return parameterIds, diags
+}
+
+// Makes the list of dashboard parameters that can be sent to the Metabase API from `parameter` blocks.
+func makeParametersFromBlocks(ctx context.Context, list types.List) (*[]metabase.DashboardParameter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var blocks []DashboardParameterModel
+	diags.Append(list.ElementsAs(ctx, &blocks, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	rawParameters := make([]map[string]any, 0, len(blocks))
+	for _, p := range blocks {
+		parameter := map[string]any{
+			"id":        p.parameterId(),
+			"name":      p.Name.ValueString(),
+			"slug":      p.Slug.ValueString(),
+			"type":      p.Type.ValueString(),
+			"sectionId": p.SectionId.ValueString(),
+		}
+
+		if !p.DefaultJson.IsNull() {
+			var defaultValue any
+			err := json.Unmarshal([]byte(p.DefaultJson.ValueString()), &defaultValue)
+			if err != nil {
+				diags.AddError("Unable to parse parameter default value JSON.", err.Error())
+				return nil, diags
+			}
+
+			parameter["default"] = defaultValue
+		}
+
+		if !p.Required.IsNull() {
+			parameter["required"] = p.Required.ValueBool()
+		}
+
+		rawParameters = append(rawParameters, parameter)
+	}
+
+	// Going through JSON ensures the typed parameters are built in the same way as when using `parameters_json`.
+	parametersBytes, err := json.Marshal(rawParameters)
+	if err != nil {
+		diags.AddError("Failed to serialize dashboard parameters.", err.Error())
+		return nil, diags
+	}
+
+	var parameters []metabase.DashboardParameter
+	err = json.Unmarshal(parametersBytes, &parameters)
+	if err != nil {
+		diags.AddError("Failed to deserialize dashboard parameters list.", err.Error())
+		return nil, diags
+	}
+
+	return &parameters, diags
+}
+
+// Makes a `DashboardParameterModel` from a raw parameter returned by the Metabase API.
+// The existing block (from the plan or state) is used to avoid spurious diffs in defaulted attributes and JSON strings.
+func makeDashboardParameterModelFromRaw(rawParameter map[string]any, existing *DashboardParameterModel) (*DashboardParameterModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	id, _ := rawParameter["id"].(string)
+	name, _ := rawParameter["name"].(string)
+	slug, _ := rawParameter["slug"].(string)
+	parameterType, _ := rawParameter["type"].(string)
+	sectionId, _ := rawParameter["sectionId"].(string)
+
+	p := DashboardParameterModel{
+		Id:          types.StringValue(id),
+		Name:        types.StringValue(name),
+		Slug:        types.StringValue(slug),
+		Type:        types.StringValue(parameterType),
+		SectionId:   types.StringNull(),
+		DefaultJson: types.StringNull(),
+		Required:    types.BoolNull(),
+	}
+
+	// The ID is left null if it simply defaults to the slug.
+	if id == slug && (existing == nil || existing.Id.IsNull()) {
+		p.Id = types.StringNull()
+	}
+
+	if len(sectionId) > 0 {
+		p.SectionId = types.StringValue(sectionId)
+	}
+
+	if defaultValue, ok := rawParameter["default"]; ok && defaultValue != nil {
+		if existing != nil && jsonStringEqualsValue(existing.DefaultJson.ValueString(), defaultValue) {
+			p.DefaultJson = existing.DefaultJson
+		} else {
+			defaultBytes, err := json.Marshal(defaultValue)
+			if err != nil {
+				diags.AddError("Error serializing parameter default value.", err.Error())
+				return nil, diags
+			}
+
+			p.DefaultJson = types.StringValue(string(defaultBytes))
+		}
+	}
+
+	if required, ok := rawParameter["required"].(bool); ok {
+		if required || (existing != nil && !existing.Required.IsNull()) {
+			p.Required = types.BoolValue(required)
+		}
+	}
+
+	return &p, diags
+}
+
+// Updates the `parameter` blocks in the `DashboardResourceModel` from the raw parameters returned by the Metabase API.
+// Parameters are matched with the existing blocks using their position.
+func updateParameterBlocksFromRawParameters(ctx context.Context, rawParameters []any, data *DashboardResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var existingParameters []DashboardParameterModel
+	if !data.Parameters.IsNull() && !data.Parameters.IsUnknown() {
+		diags.Append(data.Parameters.ElementsAs(ctx, &existingParameters, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	parameters := make([]DashboardParameterModel, 0, len(rawParameters))
+	for i, rp := range rawParameters {
+		rawParameter, ok := rp.(map[string]any)
+		if !ok {
+			diags.AddError("Could not parse dashboard parameter as object.", fmt.Sprint(rp))
+			return diags
+		}
+
+		var existing *DashboardParameterModel
+		if i < len(existingParameters) {
+			existing = &existingParameters[i]
+		}
+
+		p, pDiags := makeDashboardParameterModelFromRaw(rawParameter, existing)
+		diags.Append(pDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		parameters = append(parameters, *p)
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, dashboardParameterObjectType, parameters)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Parameters = list
+
+	return diags
+}
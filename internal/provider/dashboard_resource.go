@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"maps"
 	"reflect"
 	"sort"
 
@@ -18,6 +19,7 @@ import (
 
 // Ensures provider defined types fully satisfy framework interfaces.
 var _ resource.ResourceWithImportState = &DashboardResource{}
+var _ resource.ResourceWithValidateConfig = &DashboardResource{}
 
 // Creates a new dashboard resource.
 func NewDashboardResource() resource.Resource {
@@ -32,9 +34,10 @@ type DashboardResource struct {
 }
 
 // The Terraform model for a dashboard.
-// Basic attributes are modelled, while the (dash)cards contained in the dashboard are stored as a raw JSON string.
-// Cards contain more attributes that can change depending on their type (e.g. text vs. question), and there's no point
-// to trying modelling all of them.
+// Basic attributes are modelled, while the (dash)cards, parameters and tabs contained in the dashboard are either stored
+// as raw JSON strings, or as lists of blocks exposing the most common attributes. Cards contain more attributes that can
+// change depending on their type (e.g. text vs. question), and the blocks only model the ones needed to lay out a
+// dashboard.
 type DashboardResourceModel struct {
 	Id                 types.Int64  `tfsdk:"id"`                  // The ID of the dashboard.
 	Name               types.String `tfsdk:"name"`                // The name of the dashboard.
@@ -43,13 +46,33 @@ type DashboardResourceModel struct {
 	CollectionPosition types.Int64  `tfsdk:"collection_position"` // The position of the dashboard in the collection.
 	Description        types.String `tfsdk:"description"`         // A description for the dashboard.
 	ParametersJson     types.String `tfsdk:"parameters_json"`     // A list of parameters for the dashboard, that the user can tweak, as a JSON string.
+	Parameters         types.List   `tfsdk:"parameter"`           // The list of parameters for the dashboard, as typed blocks. Mutually exclusive with `parameters_json`.
 	CardsJson          types.String `tfsdk:"cards_json"`          // The list of cards in the dashboard, as a JSON string.
+	Dashcards          types.List   `tfsdk:"dashcard"`            // The list of cards in the dashboard, as typed blocks. Mutually exclusive with `cards_json`.
 	TabsJson           types.String `tfsdk:"tabs_json"`           // The list of tabs in the dashboard, as a JSON string.
+	Tabs               types.List   `tfsdk:"tab"`                 // The list of tabs in the dashboard, as typed blocks. Mutually exclusive with `tabs_json`.
+	EnableEmbedding    types.Bool   `tfsdk:"enable_embedding"`    // Whether signed embedding is enabled.
+	EmbeddingParams    types.Map    `tfsdk:"embedding_params"`    // The embedding mode of each parameter.
+	PublicSharing      types.Bool   `tfsdk:"public_sharing"`      // Whether a public link is enabled.
+	PublicUuid         types.String `tfsdk:"public_uuid"`         // The UUID of the public link.
+	PublicUrl          types.String `tfsdk:"public_url"`          // The full public link.
+}
+
+// Returns pointers to the embedding and public sharing attributes of the dashboard.
+func (data *DashboardResourceModel) sharingAttributes() sharingAttributes {
+	return sharingAttributes{
+		EnableEmbedding: &data.EnableEmbedding,
+		EmbeddingParams: &data.EmbeddingParams,
+		PublicSharing:   &data.PublicSharing,
+		PublicUuid:      &data.PublicUuid,
+		PublicUrl:       &data.PublicUrl,
+	}
 }
 
 // The list of JSON attributes in a dashcard that should be persisted in the state.
 // Those are also the attributes that users should specify in `cards_json`.
 var allowedDashcardAttributes = map[string]bool{
+	"action_id":              true,
 	"card_id":                true,
 	"row":                    true,
 	"col":                    true,
@@ -105,48 +128,76 @@ func sortDashcards(cards []any) {
 }
 
 func (r *DashboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the dashboard.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "A user-displayable name for the dashboard.",
+			Required:            true,
+		},
+		"cache_ttl": schema.Int64Attribute{
+			MarkdownDescription: "The cache TTL.",
+			Optional:            true,
+		},
+		"collection_id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the collection in which the dashboard is placed.",
+			Optional:            true,
+		},
+		"collection_position": schema.Int64Attribute{
+			MarkdownDescription: "The position of the dashboard in the collection. When unset, the position is not managed by this resource, e.g. such that it can be managed using `metabase_collection_pins`.",
+			Optional:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A description for the dashboard.",
+			Optional:            true,
+		},
+		"parameters_json": schema.StringAttribute{
+			MarkdownDescription: "A list of parameters for the dashboard, that the user can tweak, as a JSON string. This cannot be set at the same time as `parameter` blocks.",
+			Optional:            true,
+		},
+		"cards_json": schema.StringAttribute{
+			MarkdownDescription: "The list of cards in the dashboard, as a JSON string. Either this attribute or `dashcard` blocks should be set.",
+			Optional:            true,
+		},
+		"tabs_json": schema.StringAttribute{
+			MarkdownDescription: "The list of tabs in the dashboard, as a JSON string. Each tab should have an `id` (positive integer, unique within the dashboard) and a `name`. Cards can reference tabs using `dashboard_tab_id` with the same ID, or using the tab `name` in `dashcard` blocks. This cannot be set at the same time as `tab` blocks.",
+			Optional:            true,
+		},
+	}
+	maps.Copy(attributes, makeSharingSchemaAttributes("dashboard"))
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `A Metabase dashboard.
 
-Although a dashboard object is even more complex than a card (question), basic properties are exposed as Terraform attributes. The more complex ones, parameters and cards, are exposed a raw JSON strings. Similarly to cards, templatefile and jsonencode can be used to make the definition more readable.`,
+Although a dashboard object is even more complex than a card (question), basic properties are exposed as Terraform attributes. The more complex ones, parameters and cards, are exposed a raw JSON strings. Similarly to cards, templatefile and jsonencode can be used to make the definition more readable.
 
-		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				MarkdownDescription: "The ID of the dashboard.",
-				Computed:            true,
-				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "A user-displayable name for the dashboard.",
-				Required:            true,
-			},
-			"cache_ttl": schema.Int64Attribute{
-				MarkdownDescription: "The cache TTL.",
-				Optional:            true,
-			},
-			"collection_id": schema.Int64Attribute{
-				MarkdownDescription: "The ID of the collection in which the dashboard is placed.",
-				Optional:            true,
-			},
-			"collection_position": schema.Int64Attribute{
-				MarkdownDescription: "The position of the dashboard in the collection.",
-				Optional:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "A description for the dashboard.",
-				Optional:            true,
-			},
-			"parameters_json": schema.StringAttribute{
-				MarkdownDescription: "A list of parameters for the dashboard, that the user can tweak, as a JSON string.",
-				Optional:            true,
+Cards can alternatively be defined using ` + "`dashcard`" + ` blocks, in which case plans show changes for each card rather than for the entire JSON string. A dashboard should use either ` + "`cards_json`" + ` or ` + "`dashcard`" + ` blocks, but not both.
+
+Similarly, parameters and tabs can be defined using ` + "`parameter`" + ` and ` + "`tab`" + ` blocks instead of ` + "`parameters_json`" + ` and ` + "`tabs_json`" + `. The IDs of tabs are then handled by the provider, and ` + "`dashcard`" + ` blocks reference tabs and parameters by name.`,
+
+		Attributes: attributes,
+
+		Blocks: map[string]schema.Block{
+			"dashcard": schema.ListNestedBlock{
+				MarkdownDescription: "A card in the dashboard. This is an alternative to `cards_json`, which cannot be set at the same time.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: dashcardAttributes,
+				},
 			},
-			"cards_json": schema.StringAttribute{
-				MarkdownDescription: "The list of cards in the dashboard, as a JSON string.",
-				Required:            true,
+			"parameter": schema.ListNestedBlock{
+				MarkdownDescription: "A parameter for the dashboard, that the user can tweak. This is an alternative to `parameters_json`, which cannot be set at the same time.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: dashboardParameterAttributes,
+				},
 			},
-			"tabs_json": schema.StringAttribute{
-				MarkdownDescription: "The list of tabs in the dashboard, as a JSON string. Each tab should have an `id` (positive integer, unique within the dashboard) and a `name`. Cards can reference tabs using `dashboard_tab_id` with the same ID.",
-				Optional:            true,
+			"tab": schema.ListNestedBlock{
+				MarkdownDescription: "A tab in the dashboard. This is an alternative to `tabs_json`, which cannot be set at the same time. When using `cards_json`, the ID of a tab is its (1-based) position in the list of blocks.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: dashboardTabAttributes,
+				},
 			},
 		},
 	}
@@ -193,32 +244,41 @@ func makeOpaqueParametersFromTyped(parameters []metabase.DashboardParameter) ([]
 }
 
 // Updates the given `DashboardResourceModel` from the `Dashboard` returned by the Metabase API.
-// This includes the update of the `cards_json` attribute, which requires the raw response from the Metabase API.
-func updateModelFromDashboardAndRawBody(d metabase.Dashboard, body []byte, data *DashboardResourceModel) diag.Diagnostics {
+// This includes the update of the `cards_json` attribute (or `dashcard` blocks), which requires the raw response from
+// the Metabase API.
+func updateModelFromDashboardAndRawBody(ctx context.Context, d metabase.Dashboard, body []byte, data *DashboardResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	data.Id = types.Int64Value(int64(d.Id))
 	data.Name = types.StringValue(d.Name)
 	data.CacheTtl = int64ValueOrNull(d.CacheTtl)
 	data.CollectionId = int64ValueOrNull(d.CollectionId)
-	data.CollectionPosition = int64ValueOrNull(d.CollectionPosition)
+	// A null position means it is not managed by this resource.
+	if !data.CollectionPosition.IsNull() {
+		data.CollectionPosition = int64ValueOrNull(d.CollectionPosition)
+	}
 	data.Description = stringValueOrNull(d.Description)
 
-	// Both the state JSON string and the received typed parameters are converted to untyped parameters lists and compared
-	// using `reflect.`
-	existingParameters, paramDiags := makeOpaqueParametersFromTerraform(data.ParametersJson)
+	newParameters, marshalledNewParameters, paramDiags := makeOpaqueParametersFromTyped(d.Parameters)
 	diags.Append(paramDiags...)
 	if diags.HasError() {
 		return diags
 	}
 
-	newParameters, marshalledNewParameters, paramDiags := makeOpaqueParametersFromTyped(d.Parameters)
+	// Both the state JSON string and the received typed parameters are converted to untyped parameters lists and compared
+	// using `reflect.`
+	existingParameters, paramDiags := makeOpaqueParametersFromTerraform(data.ParametersJson)
 	diags.Append(paramDiags...)
 	if diags.HasError() {
 		return diags
 	}
 
-	if !reflect.DeepEqual(existingParameters, newParameters) {
+	if usesParameterBlocks(*data) {
+		diags.Append(updateParameterBlocksFromRawParameters(ctx, newParameters, data)...)
+		if diags.HasError() {
+			return diags
+		}
+	} else if !reflect.DeepEqual(existingParameters, newParameters) {
 		// The JSON string is only updated if "real" changes are detected, such that a diff is not detected simply because
 		// the Metabase API returns attributes in a different order, or with a different indentation.
 		data.ParametersJson = types.StringValue(*marshalledNewParameters)
@@ -226,13 +286,13 @@ func updateModelFromDashboardAndRawBody(d metabase.Dashboard, body []byte, data
 
 	// Build a mapping from Metabase tab IDs to user-provided tab IDs.
 	// This is needed because we send negative IDs but Metabase returns positive ones.
-	tabIdMapping, tabsDiag := updateTabsFromRawBody(body, data)
+	tabIdMapping, tabsDiag := updateTabsFromRawBody(ctx, body, data)
 	diags.Append(tabsDiag...)
 	if diags.HasError() {
 		return diags
 	}
 
-	cardsDiag := updateCardsFromRawBody(body, data, tabIdMapping)
+	cardsDiag := updateCardsFromRawBody(ctx, body, data, tabIdMapping)
 	diags.Append(cardsDiag...)
 	if diags.HasError() {
 		return diags
@@ -241,9 +301,10 @@ func updateModelFromDashboardAndRawBody(d metabase.Dashboard, body []byte, data
 	return diags
 }
 
-// Updates the `cards_json` attribute in the `DashboardResourceModel` using the raw response from the Metabase API.
+// Updates the `cards_json` attribute (or the `dashcard` blocks) in the `DashboardResourceModel` using the raw response
+// from the Metabase API.
 // tabIdMapping maps Metabase tab IDs to user-provided tab IDs.
-func updateCardsFromRawBody(bytes []byte, data *DashboardResourceModel, tabIdMapping map[int]int) diag.Diagnostics {
+func updateCardsFromRawBody(ctx context.Context, bytes []byte, data *DashboardResourceModel, tabIdMapping map[int]int) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	var jsonResponse map[string]any
@@ -282,6 +343,11 @@ func updateCardsFromRawBody(bytes []byte, data *DashboardResourceModel, tabIdMap
 			}
 		}
 
+		// Only action buttons reference an action.
+		if actionId, ok := card["action_id"]; ok && actionId == nil {
+			delete(card, "action_id")
+		}
+
 		// Map Metabase tab ID back to user-provided tab ID, or remove if null/unmapped.
 		if tabId, ok := card["dashboard_tab_id"]; ok {
 			if tabId == nil {
@@ -294,6 +360,16 @@ func updateCardsFromRawBody(bytes []byte, data *DashboardResourceModel, tabIdMap
 		}
 	}
 
+	// Sort both arrays by position before comparing to avoid spurious diffs due to API returning
+	// cards in a different order than provided.
+	// Sort cards by position for consistent ordering.
+	sortDashcards(dashcards)
+
+	if usesDashcardBlocks(*data) {
+		diags.Append(updateDashcardBlocksFromRawCards(ctx, dashcards, data)...)
+		return diags
+	}
+
 	// Unmarshalling `cards_json` from the Terraform state/plan such that it can be compared to Metabase's response.
 	var existingCards []any
 	if !data.CardsJson.IsNull() {
@@ -304,11 +380,6 @@ func updateCardsFromRawBody(bytes []byte, data *DashboardResourceModel, tabIdMap
 		}
 	}
 
-	// Sort both arrays by position before comparing to avoid spurious diffs due to API returning
-	// cards in a different order than provided.
-	// Sort cards by position for consistent ordering.
-	sortDashcards(dashcards)
-
 	// Always store sorted result so it matches the sorted plan value.
 	cardsJson, err := json.Marshal(dashcards)
 	if err != nil {
@@ -323,7 +394,8 @@ func updateCardsFromRawBody(bytes []byte, data *DashboardResourceModel, tabIdMap
 
 // Updates the `tabs_json` attribute in the `DashboardResourceModel` using the raw response from the Metabase API.
 // Returns a mapping from Metabase tab IDs to user-provided tab IDs (based on array position).
-func updateTabsFromRawBody(bytes []byte, data *DashboardResourceModel) (map[int]int, diag.Diagnostics) {
+// If the dashboard uses `tab` blocks, those are updated instead.
+func updateTabsFromRawBody(ctx context.Context, bytes []byte, data *DashboardResourceModel) (map[int]int, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	tabIdMapping := make(map[int]int)
 
@@ -334,6 +406,12 @@ func updateTabsFromRawBody(bytes []byte, data *DashboardResourceModel) (map[int]
 		return tabIdMapping, diags
 	}
 
+	if usesTabBlocks(*data) {
+		// Tabs may not be present in older Metabase versions, which is equivalent to an empty list of blocks.
+		rawTabs, _ := jsonResponse["tabs"].([]any)
+		return updateTabBlocksFromRawTabs(ctx, rawTabs, data)
+	}
+
 	// Parse existing tabs from state to get user-provided IDs.
 	var existingTabs []map[string]any
 	if !data.TabsJson.IsNull() {
@@ -527,7 +605,12 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// The entire model can then simply be populated from the update response.
-	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(*updateResp.JSON200, updateResp.Body, data)...)
+	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(ctx, *updateResp.JSON200, updateResp.Body, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateDashboardSharing(ctx, r.client, *updateResp.JSON200, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -540,36 +623,70 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 func makeUpdateFromModel(ctx context.Context, client metabase.ClientWithResponsesInterface, dashboardId int, data DashboardResourceModel, operation string) (*metabase.UpdateDashboardResponse, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	parameters, parametersDiags := makeParametersFromModel(context.Background(), data.ParametersJson)
+	var parameters *[]metabase.DashboardParameter
+	var parametersDiags diag.Diagnostics
+	if usesParameterBlocks(data) {
+		parameters, parametersDiags = makeParametersFromBlocks(ctx, data.Parameters)
+	} else {
+		parameters, parametersDiags = makeParametersFromModel(ctx, data.ParametersJson)
+	}
 	diags.Append(parametersDiags...)
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	dashcards, cardsDiags := makeCardsFromModel(data.CardsJson)
+	var dashcards []map[string]any
+	var cardsDiags diag.Diagnostics
+	if usesDashcardBlocks(data) {
+		dashcards, cardsDiags = makeCardsFromDashcardBlocks(ctx, data)
+	} else {
+		dashcards, cardsDiags = makeCardsFromModel(data.CardsJson)
+	}
 	diags.Append(cardsDiags...)
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	tabs, tabsDiags := makeTabsFromModel(data.TabsJson)
+	var tabs []map[string]any
+	var tabsDiags diag.Diagnostics
+	if usesTabBlocks(data) {
+		tabs, tabsDiags = makeTabsFromBlocks(ctx, data.Tabs)
+	} else {
+		tabs, tabsDiags = makeTabsFromModel(data.TabsJson)
+	}
 	diags.Append(tabsDiags...)
 	if diags.HasError() {
 		return nil, diags
 	}
 
 	updatePayload := map[string]any{
-		"name":                valueStringOrNull(data.Name),
-		"description":         valueStringOrNull(data.Description),
-		"cache_ttl":           valueInt64OrNull(data.CacheTtl),
-		"collection_id":       valueInt64OrNull(data.CollectionId),
-		"collection_position": valueInt64OrNull(data.CollectionPosition),
-		"parameters":          parameters,
-		"dashcards":           dashcards,
+		"name":          valueStringOrNull(data.Name),
+		"description":   valueStringOrNull(data.Description),
+		"cache_ttl":     valueInt64OrNull(data.CacheTtl),
+		"collection_id": valueInt64OrNull(data.CollectionId),
+		"parameters":    parameters,
+		"dashcards":     dashcards,
 	}
 	if tabs != nil {
 		updatePayload["tabs"] = tabs
 	}
+	if !data.CollectionPosition.IsNull() {
+		updatePayload["collection_position"] = valueInt64OrNull(data.CollectionPosition)
+	}
+
+	// Embedding settings are only sent when they are managed by Terraform.
+	enableEmbedding, embeddingParams, embeddingDiags := makeEmbeddingSettings(ctx, data.sharingAttributes())
+	diags.Append(embeddingDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if enableEmbedding != nil {
+		updatePayload["enable_embedding"] = enableEmbedding
+	}
+	if embeddingParams != nil {
+		updatePayload["embedding_params"] = embeddingParams
+	}
+
 	updateBuffer, err := json.Marshal(updatePayload)
 	if err != nil {
 		diags.AddError("Error creating the payload for dashboard update.", err.Error())
@@ -586,6 +703,19 @@ func makeUpdateFromModel(ctx context.Context, client metabase.ClientWithResponse
 	return updateResp, diags
 }
 
+// Creates or deletes the public link of the dashboard according to the model, and updates the embedding and public
+// sharing attributes from the (updated) dashboard.
+func updateDashboardSharing(ctx context.Context, client *metabase.ClientWithResponses, d metabase.Dashboard, data *DashboardResourceModel) diag.Diagnostics {
+	publicUuid, diags := updatePublicLink(ctx, client, sharedDashboard, d.Id, data.PublicSharing, d.PublicUuid)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(updateSharingAttributesFromApi(ctx, client, sharedDashboard, d.EnableEmbedding, d.EmbeddingParams, publicUuid, data.sharingAttributes())...)
+
+	return diags
+}
+
 func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data *DashboardResourceModel
 
@@ -605,7 +735,13 @@ func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(*getResp.JSON200, getResp.Body, data)...)
+	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(ctx, *getResp.JSON200, getResp.Body, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d := getResp.JSON200
+	resp.Diagnostics.Append(updateSharingAttributesFromApi(ctx, r.client, sharedDashboard, d.EnableEmbedding, d.EmbeddingParams, d.PublicUuid, data.sharingAttributes())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -633,7 +769,12 @@ func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(*updateResp.JSON200, updateResp.Body, data)...)
+	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(ctx, *updateResp.JSON200, updateResp.Body, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateDashboardSharing(ctx, r.client, *updateResp.JSON200, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2,11 +2,17 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -87,6 +93,520 @@ func TestSortDashcards(t *testing.T) {
 	}
 }
 
+func TestDashcardBlocksRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	mappings, diags := types.ListValueFrom(ctx, dashcardParameterMappingObjectType, []DashcardParameterMappingModel{
+		{
+			ParameterId: types.StringValue("abc"),
+			Parameter:   types.StringNull(),
+			CardId:      types.Int64Null(),
+			TargetJson:  types.StringValue(`["dimension",["field",12,null]]`),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	dashcards, diags := types.ListValueFrom(ctx, dashcardObjectType, []DashcardModel{
+		{
+			CardId:                    types.Int64Null(),
+			Row:                       types.Int64Value(4),
+			Col:                       types.Int64Value(0),
+			SizeX:                     types.Int64Value(24),
+			SizeY:                     types.Int64Value(2),
+			Tab:                       types.StringValue("Second"),
+			ParameterMappings:         types.ListNull(dashcardParameterMappingObjectType),
+			VisualizationSettingsJson: types.StringValue(`{"text": "# Title"}`),
+			ClickBehaviors:            types.ListNull(dashcardClickBehaviorObjectType),
+		},
+		{
+			CardId:                    types.Int64Value(3),
+			Row:                       types.Int64Value(0),
+			Col:                       types.Int64Value(0),
+			SizeX:                     types.Int64Value(12),
+			SizeY:                     types.Int64Value(6),
+			Tab:                       types.StringValue("First"),
+			ParameterMappings:         mappings,
+			VisualizationSettingsJson: types.StringNull(),
+			ClickBehaviors:            types.ListNull(dashcardClickBehaviorObjectType),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	data := DashboardResourceModel{
+		CardsJson:      types.StringNull(),
+		Dashcards:      dashcards,
+		ParametersJson: types.StringNull(),
+		Parameters:     types.ListNull(dashboardParameterObjectType),
+		TabsJson:       types.StringValue(`[{"id":1,"name":"First"},{"id":2,"name":"Second"}]`),
+		Tabs:           types.ListNull(dashboardTabObjectType),
+	}
+
+	cards, diags := makeCardsFromDashcardBlocks(ctx, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if cards[0]["dashboard_tab_id"] != -2 || cards[1]["dashboard_tab_id"] != -1 {
+		t.Errorf("unexpected tab IDs: %v, %v", cards[0]["dashboard_tab_id"], cards[1]["dashboard_tab_id"])
+	}
+
+	mapping := cards[1]["parameter_mappings"].([]any)[0].(map[string]any)
+	if *mapping["card_id"].(*int) != 3 {
+		t.Errorf("parameter mapping card_id should default to the dashcard card_id, got %v", mapping["card_id"])
+	}
+
+	// Simulating the API response, in which cards are sorted and tab IDs have already been mapped back.
+	rawCards := []any{
+		map[string]any{
+			"card_id":                float64(3),
+			"row":                    float64(0),
+			"col":                    float64(0),
+			"size_x":                 float64(12),
+			"size_y":                 float64(6),
+			"dashboard_tab_id":       1,
+			"visualization_settings": map[string]any{},
+			"parameter_mappings": []any{
+				map[string]any{
+					"parameter_id": "abc",
+					"card_id":      float64(3),
+					"target":       []any{"dimension", []any{"field", float64(12), nil}},
+				},
+			},
+		},
+		map[string]any{
+			"card_id":                nil,
+			"row":                    float64(4),
+			"col":                    float64(0),
+			"size_x":                 float64(24),
+			"size_y":                 float64(2),
+			"dashboard_tab_id":       2,
+			"visualization_settings": map[string]any{"text": "# Title"},
+			"parameter_mappings":     []any{},
+		},
+	}
+
+	diags = updateDashcardBlocksFromRawCards(ctx, rawCards, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !data.Dashcards.Equal(dashcards) {
+		t.Errorf("updateDashcardBlocksFromRawCards() = %v, want %v", data.Dashcards, dashcards)
+	}
+}
+
+func TestTabAndParameterBlocksRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	tabs, diags := types.ListValueFrom(ctx, dashboardTabObjectType, []DashboardTabModel{
+		{Name: types.StringValue("First")},
+		{Name: types.StringValue("Second")},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	parameters, diags := types.ListValueFrom(ctx, dashboardParameterObjectType, []DashboardParameterModel{
+		{
+			Id:          types.StringNull(),
+			Name:        types.StringValue("Date range"),
+			Slug:        types.StringValue("date_range"),
+			Type:        types.StringValue("date/all-options"),
+			SectionId:   types.StringValue("date"),
+			DefaultJson: types.StringValue(`"past30days"`),
+			Required:    types.BoolNull(),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	mappings, diags := types.ListValueFrom(ctx, dashcardParameterMappingObjectType, []DashcardParameterMappingModel{
+		{
+			ParameterId: types.StringNull(),
+			Parameter:   types.StringValue("Date range"),
+			CardId:      types.Int64Null(),
+			TargetJson:  types.StringValue(`["dimension",["field",12,null]]`),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	dashcards, diags := types.ListValueFrom(ctx, dashcardObjectType, []DashcardModel{
+		{
+			CardId:                    types.Int64Value(3),
+			Row:                       types.Int64Value(0),
+			Col:                       types.Int64Value(0),
+			SizeX:                     types.Int64Value(12),
+			SizeY:                     types.Int64Value(6),
+			Tab:                       types.StringValue("Second"),
+			ParameterMappings:         mappings,
+			VisualizationSettingsJson: types.StringNull(),
+			ClickBehaviors:            types.ListNull(dashcardClickBehaviorObjectType),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	data := DashboardResourceModel{
+		CardsJson:      types.StringNull(),
+		Dashcards:      dashcards,
+		ParametersJson: types.StringNull(),
+		Parameters:     parameters,
+		TabsJson:       types.StringNull(),
+		Tabs:           tabs,
+	}
+
+	rawTabs, diags := makeTabsFromBlocks(ctx, data.Tabs)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if rawTabs[0]["id"] != -1 || rawTabs[1]["id"] != -2 {
+		t.Errorf("unexpected tab IDs: %v", rawTabs)
+	}
+
+	apiParameters, diags := makeParametersFromBlocks(ctx, data.Parameters)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if (*apiParameters)[0].Id != "date_range" {
+		t.Errorf("parameter ID should default to the slug, got %q", (*apiParameters)[0].Id)
+	}
+
+	cards, diags := makeCardsFromDashcardBlocks(ctx, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if cards[0]["dashboard_tab_id"] != -2 {
+		t.Errorf("unexpected tab ID: %v", cards[0]["dashboard_tab_id"])
+	}
+
+	mapping := cards[0]["parameter_mappings"].([]any)[0].(map[string]any)
+	if mapping["parameter_id"] != "date_range" {
+		t.Errorf("parameter mapping should reference the parameter ID, got %v", mapping["parameter_id"])
+	}
+
+	// Simulating the API response, in which tabs have been assigned new IDs.
+	tabIdMapping, diags := updateTabBlocksFromRawTabs(ctx, []any{
+		map[string]any{"id": float64(41), "name": "First"},
+		map[string]any{"id": float64(42), "name": "Second"},
+	}, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if tabIdMapping[42] != 2 {
+		t.Errorf("unexpected tab ID mapping: %v", tabIdMapping)
+	}
+
+	diags = updateParameterBlocksFromRawParameters(ctx, []any{
+		map[string]any{
+			"id":        "date_range",
+			"name":      "Date range",
+			"slug":      "date_range",
+			"type":      "date/all-options",
+			"sectionId": "date",
+			"default":   "past30days",
+		},
+	}, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	diags = updateDashcardBlocksFromRawCards(ctx, []any{
+		map[string]any{
+			"card_id":                float64(3),
+			"row":                    float64(0),
+			"col":                    float64(0),
+			"size_x":                 float64(12),
+			"size_y":                 float64(6),
+			"dashboard_tab_id":       tabIdMapping[42],
+			"visualization_settings": map[string]any{},
+			"parameter_mappings": []any{
+				map[string]any{
+					"parameter_id": "date_range",
+					"card_id":      float64(3),
+					"target":       []any{"dimension", []any{"field", float64(12), nil}},
+				},
+			},
+		},
+	}, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !data.Tabs.Equal(tabs) {
+		t.Errorf("updateTabBlocksFromRawTabs() = %v, want %v", data.Tabs, tabs)
+	}
+
+	if !data.Parameters.Equal(parameters) {
+		t.Errorf("updateParameterBlocksFromRawParameters() = %v, want %v", data.Parameters, parameters)
+	}
+
+	if !data.Dashcards.Equal(dashcards) {
+		t.Errorf("updateDashcardBlocksFromRawCards() = %v, want %v", data.Dashcards, dashcards)
+	}
+}
+
+func TestUsesTabAndParameterBlocks(t *testing.T) {
+	emptyTabs := types.ListValueMust(dashboardTabObjectType, []attr.Value{})
+	emptyParameters := types.ListValueMust(dashboardParameterObjectType, []attr.Value{})
+	tabs := types.ListValueMust(dashboardTabObjectType, []attr.Value{
+		types.ObjectValueMust(dashboardTabObjectType.AttrTypes, map[string]attr.Value{"name": types.StringValue("First")}),
+	})
+
+	// Blocks are an empty list when none are written, which should not delete tabs of dashboards using `cards_json`.
+	data := DashboardResourceModel{
+		CardsJson:      types.StringValue("[]"),
+		TabsJson:       types.StringNull(),
+		Tabs:           emptyTabs,
+		ParametersJson: types.StringNull(),
+		Parameters:     emptyParameters,
+	}
+	if usesTabBlocks(data) || usesParameterBlocks(data) {
+		t.Errorf("Expected empty blocks not to be used along with cards_json.")
+	}
+
+	data.Tabs = tabs
+	if !usesTabBlocks(data) {
+		t.Errorf("Expected tab blocks to be used when some are written.")
+	}
+
+	data.CardsJson = types.StringNull()
+	data.Tabs = emptyTabs
+	if !usesTabBlocks(data) || !usesParameterBlocks(data) {
+		t.Errorf("Expected empty blocks to be used along with dashcard blocks.")
+	}
+
+	data.Tabs = types.ListNull(dashboardTabObjectType)
+	data.Parameters = types.ListNull(dashboardParameterObjectType)
+	if usesTabBlocks(data) || usesParameterBlocks(data) {
+		t.Errorf("Expected null blocks not to be used.")
+	}
+}
+
+func TestDashcardClickBehaviorsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	crossfilterMappings, diags := types.ListValueFrom(ctx, dashcardClickParameterMappingObjectType, []DashcardClickParameterMappingModel{
+		{SourceColumn: types.StringValue("CATEGORY"), Parameter: types.StringValue("Category"), ParameterId: types.StringNull()},
+		{SourceColumn: types.StringValue("VENDOR"), Parameter: types.StringNull(), ParameterId: types.StringValue("abc")},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	clickBehaviors, diags := types.ListValueFrom(ctx, dashcardClickBehaviorObjectType, []DashcardClickBehaviorModel{
+		{
+			Column:            types.StringValue("CATEGORY"),
+			Action:            types.StringValue("crossfilter"),
+			TargetId:          types.Int64Null(),
+			UrlTemplate:       types.StringNull(),
+			ParameterMappings: crossfilterMappings,
+		},
+		{
+			Column:            types.StringNull(),
+			Action:            types.StringValue("dashboard"),
+			TargetId:          types.Int64Value(12),
+			UrlTemplate:       types.StringNull(),
+			ParameterMappings: types.ListNull(dashcardClickParameterMappingObjectType),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	settings := map[string]any{
+		"column_settings": map[string]any{
+			`["name","PRICE"]`: map[string]any{"prefix": "$"},
+		},
+	}
+
+	diags = applyDashcardClickBehaviors(ctx, clickBehaviors, map[string]string{"Category": "category"}, settings)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	columnSettings := settings["column_settings"].(map[string]any)
+	crossfilter := columnSettings[`["name","CATEGORY"]`].(map[string]any)["click_behavior"].(map[string]any)
+	if _, ok := crossfilter["parameterMapping"].(map[string]any)["category"]; !ok {
+		t.Errorf("crossfilter should reference the parameter ID, got %v", crossfilter)
+	}
+
+	// Simulating the API response, which is deserialized from JSON.
+	settingsBytes, err := json.Marshal(settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var rawSettings map[string]any
+	if err := json.Unmarshal(settingsBytes, &rawSettings); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	extracted, diags := extractDashcardClickBehaviors(ctx, rawSettings, map[string]string{"category": "Category"}, clickBehaviors)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !extracted.Equal(clickBehaviors) {
+		t.Errorf("extractDashcardClickBehaviors() = %v, want %v", extracted, clickBehaviors)
+	}
+
+	expectedSettings := map[string]any{
+		"column_settings": map[string]any{
+			`["name","PRICE"]`: map[string]any{"prefix": "$"},
+		},
+	}
+	if !reflect.DeepEqual(rawSettings, expectedSettings) {
+		t.Errorf("click behaviors should be removed from the settings, got %v", rawSettings)
+	}
+}
+
+func TestValidateDashcardLayouts(t *testing.T) {
+	tests := []struct {
+		name     string
+		layouts  []dashcardLayout
+		hasError bool
+	}{
+		{
+			name: "side by side cards",
+			layouts: []dashcardLayout{
+				{Path: path.Root("dashcard").AtListIndex(0), Row: 0, Col: 0, SizeX: 12, SizeY: 4},
+				{Path: path.Root("dashcard").AtListIndex(1), Row: 0, Col: 12, SizeX: 12, SizeY: 4},
+			},
+			hasError: false,
+		},
+		{
+			name: "overlapping cards",
+			layouts: []dashcardLayout{
+				{Path: path.Root("dashcard").AtListIndex(0), Row: 0, Col: 0, SizeX: 12, SizeY: 4},
+				{Path: path.Root("dashcard").AtListIndex(1), Row: 3, Col: 11, SizeX: 4, SizeY: 4},
+			},
+			hasError: true,
+		},
+		{
+			name: "same position in different tabs",
+			layouts: []dashcardLayout{
+				{Path: path.Root("dashcard").AtListIndex(0), Tab: "First", Row: 0, Col: 0, SizeX: 12, SizeY: 4},
+				{Path: path.Root("dashcard").AtListIndex(1), Tab: "Second", Row: 0, Col: 0, SizeX: 12, SizeY: 4},
+			},
+			hasError: false,
+		},
+		{
+			name: "card wider than the grid",
+			layouts: []dashcardLayout{
+				{Path: path.Root("dashcard").AtListIndex(0), Row: 0, Col: 12, SizeX: 13, SizeY: 4},
+			},
+			hasError: true,
+		},
+		{
+			name: "empty size",
+			layouts: []dashcardLayout{
+				{Path: path.Root("dashcard").AtListIndex(0), Row: 0, Col: 0, SizeX: 0, SizeY: 4},
+			},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateDashcardLayouts(tt.layouts)
+			if diags.HasError() != tt.hasError {
+				t.Errorf("validateDashcardLayouts() errors = %v, want error: %v", diags, tt.hasError)
+			}
+		})
+	}
+}
+
+func TestValidateCardsJson(t *testing.T) {
+	refs := dashboardReferences{
+		TabIds:         map[int]bool{1: true, 2: true},
+		TabNames:       map[string]bool{"First": true, "Second": true},
+		ParameterIds:   map[string]bool{"abc": true},
+		ParameterNames: map[string]bool{},
+	}
+
+	tests := []struct {
+		name      string
+		cardsJson string
+		refs      dashboardReferences
+		hasError  bool
+	}{
+		{
+			name:      "valid cards",
+			cardsJson: `[{"row":0,"col":0,"size_x":12,"size_y":4,"dashboard_tab_id":1,"parameter_mappings":[{"parameter_id":"abc"}]},{"row":0,"col":0,"size_x":24,"size_y":4,"dashboard_tab_id":2}]`,
+			refs:      refs,
+			hasError:  false,
+		},
+		{
+			name:      "invalid JSON",
+			cardsJson: `{"row":0}`,
+			refs:      refs,
+			hasError:  true,
+		},
+		{
+			name:      "overlapping cards in the same tab",
+			cardsJson: `[{"row":0,"col":0,"size_x":12,"size_y":4,"dashboard_tab_id":1},{"row":2,"col":6,"size_x":12,"size_y":4,"dashboard_tab_id":1}]`,
+			refs:      refs,
+			hasError:  true,
+		},
+		{
+			name:      "card wider than the grid",
+			cardsJson: `[{"row":0,"col":18,"size_x":8,"size_y":4}]`,
+			refs:      refs,
+			hasError:  true,
+		},
+		{
+			name:      "unknown tab",
+			cardsJson: `[{"row":0,"col":0,"size_x":12,"size_y":4,"dashboard_tab_id":3}]`,
+			refs:      refs,
+			hasError:  true,
+		},
+		{
+			name:      "unknown parameter",
+			cardsJson: `[{"row":0,"col":0,"size_x":12,"size_y":4,"parameter_mappings":[{"parameter_id":"def"}]}]`,
+			refs:      refs,
+			hasError:  true,
+		},
+		{
+			name:      "references not known yet",
+			cardsJson: `[{"row":0,"col":0,"size_x":12,"size_y":4,"dashboard_tab_id":3,"parameter_mappings":[{"parameter_id":"def"}]}]`,
+			refs:      dashboardReferences{},
+			hasError:  false,
+		},
+		{
+			name:      "missing size",
+			cardsJson: `[{"row":0,"col":0,"size_x":12}]`,
+			refs:      refs,
+			hasError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateCardsJson(tt.cardsJson, tt.refs)
+			if diags.HasError() != tt.hasError {
+				t.Errorf("validateCardsJson() errors = %v, want error: %v", diags, tt.hasError)
+			}
+
+			for _, d := range diags {
+				if withPath, ok := d.(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(path.Root("cards_json")) {
+					t.Errorf("validateCardsJson() diagnostic %v should point to cards_json", d)
+				}
+			}
+		})
+	}
+}
+
 func testAccDashboardResource(name string, dashboardName string, description string) string {
 	return fmt.Sprintf(`
 resource "metabase_dashboard" "%s" {
@@ -381,3 +901,225 @@ func TestAccDashboardResourceWithTabs(t *testing.T) {
 		},
 	})
 }
+
+func testAccDashboardResourceWithDashcardBlocks(name string, dashboardName string, textCol int) string {
+	return fmt.Sprintf(`
+resource "metabase_dashboard" "%s" {
+  name = "%s"
+
+  tabs_json = jsonencode([
+    { id = 1, name = "Overview" },
+    { id = 2, name = "Details" }
+  ])
+
+  dashcard {
+    tab    = "Overview"
+    col    = %d
+    row    = 0
+    size_x = 6
+    size_y = 3
+    visualization_settings_json = jsonencode({
+      virtual_card = {
+        name                   = null
+        display                = "text"
+        visualization_settings = {}
+        dataset_query          = {}
+        archived               = false
+      }
+      text = "Content on the overview tab"
+    })
+  }
+
+  dashcard {
+    tab    = "Details"
+    col    = 0
+    row    = 0
+    size_x = 24
+    size_y = 2
+    visualization_settings_json = jsonencode({
+      virtual_card = {
+        name                   = null
+        display                = "heading"
+        visualization_settings = {}
+        dataset_query          = {}
+        archived               = false
+      }
+      text = "Details"
+    })
+  }
+}
+`,
+		name,
+		dashboardName,
+		textCol,
+	)
+}
+
+func TestAccDashboardResourceWithDashcardBlocks(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccDashboardResourceWithDashcardBlocks("test_blocks", "Dashboard with blocks", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists("metabase_dashboard.test_blocks"),
+					resource.TestCheckResourceAttr("metabase_dashboard.test_blocks", "dashcard.#", "2"),
+					resource.TestCheckResourceAttr("metabase_dashboard.test_blocks", "dashcard.0.tab", "Overview"),
+					resource.TestCheckResourceAttr("metabase_dashboard.test_blocks", "dashcard.1.tab", "Details"),
+					resource.TestCheckNoResourceAttr("metabase_dashboard.test_blocks", "cards_json"),
+				),
+			},
+			// Update: move a single card.
+			{
+				Config: providerApiKeyConfig + testAccDashboardResourceWithDashcardBlocks("test_blocks", "Dashboard with blocks", 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists("metabase_dashboard.test_blocks"),
+					resource.TestCheckResourceAttr("metabase_dashboard.test_blocks", "dashcard.0.col", "6"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDashboardResourceWithPublicSharing(name string, publicSharing bool) string {
+	return fmt.Sprintf(`
+resource "metabase_dashboard" "%s" {
+  name           = "🌍 Public dashboard"
+  public_sharing = %t
+}
+`,
+		name,
+		publicSharing,
+	)
+}
+
+func TestAccDashboardResourceWithPublicSharing(t *testing.T) {
+	// Public sharing is enabled by default in the Metabase admin settings.
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccDashboardResourceWithPublicSharing("test_public", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists("metabase_dashboard.test_public"),
+					resource.TestCheckResourceAttr("metabase_dashboard.test_public", "public_sharing", "true"),
+					resource.TestCheckResourceAttrSet("metabase_dashboard.test_public", "public_uuid"),
+					resource.TestMatchResourceAttr("metabase_dashboard.test_public", "public_url", regexp.MustCompile("/public/dashboard/.+$")),
+				),
+			},
+			{
+				Config: providerApiKeyConfig + testAccDashboardResourceWithPublicSharing("test_public", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_dashboard.test_public", "public_sharing", "false"),
+					resource.TestCheckNoResourceAttr("metabase_dashboard.test_public", "public_uuid"),
+					resource.TestCheckNoResourceAttr("metabase_dashboard.test_public", "public_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDashboardResourceWithTabAndParameterBlocks(name string, dashboardName string, secondTabName string) string {
+	return fmt.Sprintf(`
+resource "metabase_dashboard" "%s" {
+  name = "%s"
+
+  tab {
+    name = "Overview"
+  }
+
+  tab {
+    name = "%s"
+  }
+
+  parameter {
+    name         = "Category"
+    slug         = "category"
+    type         = "string/="
+    section_id   = "string"
+    default_json = jsonencode(["Widget"])
+  }
+
+  dashcard {
+    tab    = "Overview"
+    col    = 0
+    row    = 0
+    size_x = 12
+    size_y = 3
+    visualization_settings_json = jsonencode({
+      virtual_card = {
+        name                   = null
+        display                = "text"
+        visualization_settings = {}
+        dataset_query          = {}
+        archived               = false
+      }
+      text = "Selected category: {{category}}"
+    })
+
+    parameter_mappings = [
+      {
+        parameter   = "Category"
+        target_json = jsonencode(["text-tag", "category"])
+      }
+    ]
+  }
+
+  dashcard {
+    tab    = "%s"
+    col    = 0
+    row    = 0
+    size_x = 24
+    size_y = 2
+    visualization_settings_json = jsonencode({
+      virtual_card = {
+        name                   = null
+        display                = "heading"
+        visualization_settings = {}
+        dataset_query          = {}
+        archived               = false
+      }
+      text = "Details"
+    })
+  }
+}
+`,
+		name,
+		dashboardName,
+		secondTabName,
+		secondTabName,
+	)
+}
+
+func TestAccDashboardResourceWithTabAndParameterBlocks(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccDashboardResourceWithTabAndParameterBlocks("test_tab_blocks", "Dashboard with tab blocks", "Details"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists("metabase_dashboard.test_tab_blocks"),
+					resource.TestCheckResourceAttr("metabase_dashboard.test_tab_blocks", "tab.#", "2"),
+					resource.TestCheckResourceAttr("metabase_dashboard.test_tab_blocks", "parameter.#", "1"),
+					resource.TestCheckResourceAttr("metabase_dashboard.test_tab_blocks", "parameter.0.slug", "category"),
+					resource.TestCheckNoResourceAttr("metabase_dashboard.test_tab_blocks", "parameter.0.id"),
+					resource.TestCheckResourceAttr("metabase_dashboard.test_tab_blocks", "dashcard.0.parameter_mappings.0.parameter", "Category"),
+					resource.TestCheckNoResourceAttr("metabase_dashboard.test_tab_blocks", "tabs_json"),
+					resource.TestCheckNoResourceAttr("metabase_dashboard.test_tab_blocks", "parameters_json"),
+				),
+			},
+			// Update: rename a tab, which is referenced by a card.
+			{
+				Config: providerApiKeyConfig + testAccDashboardResourceWithTabAndParameterBlocks("test_tab_blocks", "Dashboard with tab blocks", "More details"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists("metabase_dashboard.test_tab_blocks"),
+					resource.TestCheckResourceAttr("metabase_dashboard.test_tab_blocks", "tab.1.name", "More details"),
+					resource.TestCheckResourceAttr("metabase_dashboard.test_tab_blocks", "dashcard.1.tab", "More details"),
+				),
+			},
+		},
+	})
+}
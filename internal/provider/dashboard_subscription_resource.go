@@ -0,0 +1,570 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &DashboardSubscriptionResource{}
+var _ resource.ResourceWithValidateConfig = &DashboardSubscriptionResource{}
+
+// Creates a new dashboard subscription resource.
+func NewDashboardSubscriptionResource() resource.Resource {
+	return &DashboardSubscriptionResource{
+		MetabaseBaseResource{name: "dashboard_subscription"},
+	}
+}
+
+// A resource handling a dashboard subscription, which is called a pulse in the Metabase API.
+type DashboardSubscriptionResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for a dashboard subscription.
+type DashboardSubscriptionResourceModel struct {
+	Id              types.Int64  `tfsdk:"id"`               // The ID of the subscription (pulse).
+	DashboardId     types.Int64  `tfsdk:"dashboard_id"`     // The ID of the dashboard sent by the subscription.
+	Name            types.String `tfsdk:"name"`             // The name of the subscription.
+	SkipIfEmpty     types.Bool   `tfsdk:"skip_if_empty"`    // Whether the subscription is not sent when all cards have no results.
+	ParameterValues types.Map    `tfsdk:"parameter_values"` // The values of the dashboard parameters, as JSON strings.
+	Schedule        types.Object `tfsdk:"schedule"`         // When the subscription is sent.
+	Email           types.Object `tfsdk:"email"`            // The email channel.
+	Slack           types.Object `tfsdk:"slack"`            // The Slack channel.
+	Attachments     types.Object `tfsdk:"attachments"`      // The files attached to the subscription.
+}
+
+// The Terraform model for the files attached to a dashboard subscription.
+type DashboardSubscriptionAttachmentsModel struct {
+	Format  types.String `tfsdk:"format"`   // The format of the attached files.
+	CardIds types.Set    `tfsdk:"card_ids"` // The IDs of the cards for which results are attached.
+}
+
+// The object type definition for the `DashboardSubscriptionAttachmentsModel` model.
+var dashboardSubscriptionAttachmentsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"format":   types.StringType,
+		"card_ids": types.SetType{ElemType: types.Int64Type},
+	},
+}
+
+// The formats in which card results can be attached to a dashboard subscription.
+var dashboardSubscriptionAttachmentFormats = []string{"csv", "xlsx"}
+
+func (r *DashboardSubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A subscription to a Metabase dashboard, which sends the dashboard by email and/or to Slack on a schedule.
+
+All the cards (questions) in the dashboard are sent, as they are when the subscription is created or updated. Text cards are not included.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the subscription.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"dashboard_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the dashboard sent by the subscription.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the subscription. Defaults to the name of the dashboard.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"skip_if_empty": schema.BoolAttribute{
+				MarkdownDescription: "Whether the subscription is not sent when none of the cards return results.",
+				Optional:            true,
+			},
+			"parameter_values": schema.MapAttribute{
+				MarkdownDescription: "The values of the dashboard parameters used when sending the subscription, as JSON strings. Keys are the IDs of the parameters, which default to the `slug` when using `parameter` blocks in the dashboard. Parameters which are not listed use their default value.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"schedule": pulseScheduleAttribute,
+			"email":    pulseEmailAttribute,
+			"slack":    pulseSlackAttribute,
+			"attachments": schema.SingleNestedAttribute{
+				MarkdownDescription: "Attaches the results of the cards to the subscription, as files.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"format": schema.StringAttribute{
+						MarkdownDescription: "The format of the attached files. Either `csv` or `xlsx`.",
+						Required:            true,
+					},
+					"card_ids": schema.SetAttribute{
+						MarkdownDescription: "The IDs of the cards (questions) for which results are attached. If not set, the results of all cards are attached.",
+						ElementType:         types.Int64Type,
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DashboardSubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DashboardSubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePulseSchedule(ctx, data.Schedule)...)
+	resp.Diagnostics.Append(validatePulseChannels(data.Email, data.Slack)...)
+
+	if !data.Attachments.IsNull() && !data.Attachments.IsUnknown() {
+		var a DashboardSubscriptionAttachmentsModel
+		resp.Diagnostics.Append(data.Attachments.As(ctx, &a, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !a.Format.IsUnknown() && !slices.Contains(dashboardSubscriptionAttachmentFormats, a.Format.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("attachments").AtName("format"),
+				"Unsupported attachment format.",
+				fmt.Sprintf("Got %q, expected either csv or xlsx.", a.Format.ValueString()),
+			)
+		}
+	}
+
+	if !data.ParameterValues.IsNull() && !data.ParameterValues.IsUnknown() {
+		var values map[string]types.String
+		resp.Diagnostics.Append(data.ParameterValues.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for id, v := range values {
+			if v.IsNull() || v.IsUnknown() {
+				continue
+			}
+
+			if !json.Valid([]byte(v.ValueString())) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("parameter_values").AtMapKey(id),
+					"Invalid parameter value.",
+					"The value of the parameter should be a valid JSON string. `jsonencode` can be used for clarity.",
+				)
+			}
+		}
+	}
+}
+
+// Makes the list of cards sent by the subscription from the cards in the dashboard. Virtual cards (e.g. texts) are
+// not included.
+func makePulseCardsFromDashboard(ctx context.Context, dashboard metabase.Dashboard, attachments types.Object) ([]metabase.PulseCard, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var a *DashboardSubscriptionAttachmentsModel
+	var attachedCardIds []int64
+	if !attachments.IsNull() {
+		a = &DashboardSubscriptionAttachmentsModel{}
+		diags.Append(attachments.As(ctx, a, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if !a.CardIds.IsNull() {
+			diags.Append(a.CardIds.ElementsAs(ctx, &attachedCardIds, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+	}
+
+	cards := make([]metabase.PulseCard, 0, len(dashboard.Dashcards))
+	for _, dc := range dashboard.Dashcards {
+		if dc.CardId == nil {
+			continue
+		}
+
+		includeCsv := false
+		includeXls := false
+		if a != nil && (a.CardIds.IsNull() || slices.Contains(attachedCardIds, int64(*dc.CardId))) {
+			includeCsv = a.Format.ValueString() == "csv"
+			includeXls = a.Format.ValueString() == "xlsx"
+		}
+
+		cards = append(cards, metabase.PulseCard{
+			Id:              *dc.CardId,
+			DashboardCardId: &dc.Id,
+			IncludeCsv:      &includeCsv,
+			IncludeXls:      &includeXls,
+		})
+	}
+
+	return cards, diags
+}
+
+// Makes the list of parameters sent by the subscription from the `parameter_values` attribute. Parameters are
+// completed using their definition in the dashboard, which is what Metabase expects.
+func makePulseParametersFromModel(ctx context.Context, dashboard metabase.Dashboard, parameterValues types.Map) ([]metabase.PulseParameter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parameters := []metabase.PulseParameter{}
+	if parameterValues.IsNull() {
+		return parameters, diags
+	}
+
+	var values map[string]string
+	diags.Append(parameterValues.ElementsAs(ctx, &values, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, dp := range dashboard.Parameters {
+		valueJson, ok := values[dp.Id]
+		if !ok {
+			continue
+		}
+
+		var value any
+		err := json.Unmarshal([]byte(valueJson), &value)
+		if err != nil {
+			diags.AddAttributeError(path.Root("parameter_values").AtMapKey(dp.Id), "Unable to parse parameter value JSON.", err.Error())
+			return nil, diags
+		}
+
+		parameters = append(parameters, metabase.PulseParameter{
+			Id:    dp.Id,
+			Value: value,
+			AdditionalProperties: map[string]any{
+				"name": dp.Name,
+				"slug": dp.Slug,
+				"type": dp.Type,
+			},
+		})
+		delete(values, dp.Id)
+	}
+
+	for id := range values {
+		diags.AddAttributeError(
+			path.Root("parameter_values").AtMapKey(id),
+			"Unknown dashboard parameter.",
+			fmt.Sprintf("The dashboard does not contain a parameter with ID %q.", id),
+		)
+	}
+
+	return parameters, diags
+}
+
+// The content of a dashboard subscription, as sent to the Metabase API when creating or updating the pulse.
+type dashboardSubscriptionPulse struct {
+	Name       string
+	Cards      []metabase.PulseCard
+	Channels   []metabase.PulseChannel
+	Parameters []metabase.PulseParameter
+}
+
+// Makes the content of the pulse from the Terraform model. This fetches the dashboard to list its cards and
+// parameters.
+func makePulseFromDashboardSubscriptionModel(ctx context.Context, client *metabase.ClientWithResponses, data DashboardSubscriptionResourceModel) (*dashboardSubscriptionPulse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	getResp, err := client.GetDashboardWithResponse(ctx, int(data.DashboardId.ValueInt64()))
+	diags.Append(checkMetabaseResponse(getResp, err, []int{200}, "get dashboard")...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	dashboard := *getResp.JSON200
+
+	name := dashboard.Name
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		name = data.Name.ValueString()
+	}
+
+	cards, cardsDiags := makePulseCardsFromDashboard(ctx, dashboard, data.Attachments)
+	diags.Append(cardsDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	parameters, parametersDiags := makePulseParametersFromModel(ctx, dashboard, data.ParameterValues)
+	diags.Append(parametersDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	channels, channelsDiags := makePulseChannels(ctx, client, data.Schedule, data.Email, data.Slack)
+	diags.Append(channelsDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &dashboardSubscriptionPulse{
+		Name:       name,
+		Cards:      cards,
+		Channels:   channels,
+		Parameters: parameters,
+	}, diags
+}
+
+// Makes the `attachments` attribute from the cards returned by the Metabase API.
+func makeDashboardSubscriptionAttachmentsFromCards(ctx context.Context, cards []metabase.PulseCard, existing types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	format := ""
+	attachedCardIds := []int64{}
+	for _, c := range cards {
+		if c.IncludeXls != nil && *c.IncludeXls {
+			format = "xlsx"
+		} else if c.IncludeCsv != nil && *c.IncludeCsv {
+			format = "csv"
+		} else {
+			continue
+		}
+
+		attachedCardIds = append(attachedCardIds, int64(c.Id))
+	}
+
+	if len(attachedCardIds) == 0 {
+		return types.ObjectNull(dashboardSubscriptionAttachmentsObjectType.AttrTypes), diags
+	}
+
+	existingCardIds := types.SetNull(types.Int64Type)
+	if !existing.IsNull() && !existing.IsUnknown() {
+		var a DashboardSubscriptionAttachmentsModel
+		diags.Append(existing.As(ctx, &a, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return types.ObjectNull(dashboardSubscriptionAttachmentsObjectType.AttrTypes), diags
+		}
+
+		existingCardIds = a.CardIds
+	}
+
+	// The list of cards is left null when the results of all cards are attached, unless it was explicitly set.
+	cardIds := types.SetNull(types.Int64Type)
+	if len(attachedCardIds) < len(cards) || !existingCardIds.IsNull() {
+		slices.Sort(attachedCardIds)
+
+		var setDiags diag.Diagnostics
+		cardIds, setDiags = types.SetValueFrom(ctx, types.Int64Type, attachedCardIds)
+		diags.Append(setDiags...)
+		if diags.HasError() {
+			return types.ObjectNull(dashboardSubscriptionAttachmentsObjectType.AttrTypes), diags
+		}
+	}
+
+	return types.ObjectValue(dashboardSubscriptionAttachmentsObjectType.AttrTypes, map[string]attr.Value{
+		"format":   types.StringValue(format),
+		"card_ids": cardIds,
+	})
+}
+
+// Makes the `parameter_values` attribute from the parameters returned by the Metabase API.
+// Existing JSON strings are kept when they are equivalent to the returned values, to avoid spurious diffs.
+func makeDashboardSubscriptionParameterValues(ctx context.Context, parameters []metabase.PulseParameter, existing types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(parameters) == 0 && existing.IsNull() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	existingValues := make(map[string]string)
+	if !existing.IsNull() && !existing.IsUnknown() {
+		diags.Append(existing.ElementsAs(ctx, &existingValues, false)...)
+		if diags.HasError() {
+			return types.MapNull(types.StringType), diags
+		}
+	}
+
+	values := make(map[string]string, len(parameters))
+	for _, p := range parameters {
+		if existingValue, ok := existingValues[p.Id]; ok && jsonStringEqualsValue(existingValue, p.Value) {
+			values[p.Id] = existingValue
+			continue
+		}
+
+		valueBytes, err := json.Marshal(p.Value)
+		if err != nil {
+			diags.AddError("Error serializing subscription parameter value.", err.Error())
+			return types.MapNull(types.StringType), diags
+		}
+
+		values[p.Id] = string(valueBytes)
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
+
+// Updates the given `DashboardSubscriptionResourceModel` from the `Pulse` returned by the Metabase API.
+func updateModelFromPulse(ctx context.Context, client *metabase.ClientWithResponses, p metabase.Pulse, data *DashboardSubscriptionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.Int64Value(int64(p.Id))
+	data.DashboardId = int64ValueOrNull(p.DashboardId)
+	data.Name = stringValueOrNull(p.Name)
+
+	if p.SkipIfEmpty != nil && (*p.SkipIfEmpty || !data.SkipIfEmpty.IsNull()) {
+		data.SkipIfEmpty = types.BoolValue(*p.SkipIfEmpty)
+	} else {
+		data.SkipIfEmpty = types.BoolNull()
+	}
+
+	var parameters []metabase.PulseParameter
+	if p.Parameters != nil {
+		parameters = *p.Parameters
+	}
+
+	var mapDiags diag.Diagnostics
+	data.ParameterValues, mapDiags = makeDashboardSubscriptionParameterValues(ctx, parameters, data.ParameterValues)
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var attachmentsDiags diag.Diagnostics
+	data.Attachments, attachmentsDiags = makeDashboardSubscriptionAttachmentsFromCards(ctx, p.Cards, data.Attachments)
+	diags.Append(attachmentsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(updatePulseChannelsFromApi(ctx, client, p.Channels, &data.Schedule, &data.Email, &data.Slack)...)
+
+	return diags
+}
+
+func (r *DashboardSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DashboardSubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pulse, diags := makePulseFromDashboardSubscriptionModel(ctx, r.client, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboardId := int(data.DashboardId.ValueInt64())
+	createResp, err := r.client.CreatePulseWithResponse(ctx, metabase.CreatePulseBody{
+		Name:        pulse.Name,
+		DashboardId: &dashboardId,
+		SkipIfEmpty: data.SkipIfEmpty.ValueBoolPointer(),
+		Parameters:  &pulse.Parameters,
+		Cards:       pulse.Cards,
+		Channels:    pulse.Channels,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200}, "create dashboard subscription")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromPulse(ctx, r.client, *createResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DashboardSubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.GetPulseWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get dashboard subscription")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if getResp.StatusCode() == 404 || getResp.JSON200.Archived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromPulse(ctx, r.client, *getResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DashboardSubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pulse, diags := makePulseFromDashboardSubscriptionModel(ctx, r.client, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skipIfEmpty := data.SkipIfEmpty.ValueBool()
+	updateResp, err := r.client.UpdatePulseWithResponse(ctx, int(data.Id.ValueInt64()), metabase.UpdatePulseBody{
+		Name:        &pulse.Name,
+		SkipIfEmpty: &skipIfEmpty,
+		Parameters:  &pulse.Parameters,
+		Cards:       &pulse.Cards,
+		Channels:    &pulse.Channels,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update dashboard subscription")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromPulse(ctx, r.client, *updateResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DashboardSubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	archived := true
+	updateResp, err := r.client.UpdatePulseWithResponse(ctx, int(data.Id.ValueInt64()), metabase.UpdatePulseBody{
+		Archived: &archived,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200, 404}, "delete (archive) dashboard subscription")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DashboardSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIntegerId(ctx, req, resp)
+}
@@ -0,0 +1,513 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Sets up the dashboard and permissions group used by dashboard subscription tests in the stand-in server, and
+// serves pulses.
+func serveDashboardSubscriptionStandIn(s *metabaseStandIn) map[int]map[string]any {
+	s.serveJson("GET", "/dashboard/1", map[string]any{
+		"id":       1,
+		"name":     "📈 Exec",
+		"archived": false,
+		"dashcards": []any{
+			map[string]any{"id": 10, "card_id": 100, "row": 0, "col": 0, "size_x": 12, "size_y": 6},
+			map[string]any{"id": 11, "card_id": nil, "row": 0, "col": 12, "size_x": 12, "size_y": 6},
+			map[string]any{"id": 12, "card_id": 101, "row": 6, "col": 0, "size_x": 12, "size_y": 6},
+		},
+		"parameters": []any{
+			map[string]any{"id": "region", "name": "Region", "slug": "region", "type": "string/=", "sectionId": "string"},
+		},
+		"tabs": []any{},
+	})
+	s.serveJson("GET", "/permissions/group/3", map[string]any{
+		"id":   3,
+		"name": "Execs",
+		"members": []any{
+			map[string]any{"user_id": 5, "membership_id": 1},
+			map[string]any{"user_id": 6, "membership_id": 2},
+		},
+	})
+
+	return s.serveObjects("/pulse")
+}
+
+// Makes the model for the subscription used in stand-in tests.
+func makeStandInDashboardSubscriptionModel(t *testing.T) DashboardSubscriptionResourceModel {
+	ctx := context.Background()
+
+	mustObject := func(attrTypes map[string]attr.Type, values map[string]attr.Value) types.Object {
+		o, diags := types.ObjectValue(attrTypes, values)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		return o
+	}
+	mustSet := func(elemType attr.Type, values any) types.Set {
+		s, diags := types.SetValueFrom(ctx, elemType, values)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		return s
+	}
+
+	parameterValues, diags := types.MapValueFrom(ctx, types.StringType, map[string]string{"region": `["EMEA"]`})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	return DashboardSubscriptionResourceModel{
+		Id:              types.Int64Unknown(),
+		DashboardId:     types.Int64Value(1),
+		Name:            types.StringUnknown(),
+		SkipIfEmpty:     types.BoolValue(true),
+		ParameterValues: parameterValues,
+		Schedule: mustObject(pulseScheduleObjectType.AttrTypes, map[string]attr.Value{
+			"type":  types.StringValue("weekly"),
+			"hour":  types.Int64Value(8),
+			"day":   types.StringValue("mon"),
+			"frame": types.StringNull(),
+		}),
+		Email: mustObject(pulseEmailObjectType.AttrTypes, map[string]attr.Value{
+			"user_ids":  mustSet(types.Int64Type, []int64{2}),
+			"group_ids": mustSet(types.Int64Type, []int64{3}),
+			"emails":    mustSet(types.StringType, []string{"board@example.com"}),
+		}),
+		Slack: mustObject(pulseSlackObjectType.AttrTypes, map[string]attr.Value{
+			"channel": types.StringValue("#exec"),
+		}),
+		Attachments: mustObject(dashboardSubscriptionAttachmentsObjectType.AttrTypes, map[string]attr.Value{
+			"format":   types.StringValue("xlsx"),
+			"card_ids": mustSet(types.Int64Type, []int64{101}),
+		}),
+	}
+}
+
+func TestDashboardSubscriptionStandInRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	pulses := serveDashboardSubscriptionStandIn(s)
+	client := s.client()
+
+	data := makeStandInDashboardSubscriptionModel(t)
+
+	pulse, diags := makePulseFromDashboardSubscriptionModel(ctx, client, data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if pulse.Name != "📈 Exec" {
+		t.Errorf("Expected the subscription name to default to the dashboard name, got %q.", pulse.Name)
+	}
+	if len(pulse.Cards) != 2 {
+		t.Fatalf("Expected the virtual card to be skipped, got %d cards.", len(pulse.Cards))
+	}
+	if *pulse.Cards[0].IncludeXls || !*pulse.Cards[1].IncludeXls || *pulse.Cards[1].DashboardCardId != 12 {
+		t.Errorf("Unexpected attachments in cards: %+v.", pulse.Cards)
+	}
+	if len(pulse.Parameters) != 1 || pulse.Parameters[0].AdditionalProperties["slug"] != "region" {
+		t.Errorf("Unexpected parameters: %+v.", pulse.Parameters)
+	}
+
+	recipientIds := []int{}
+	for _, r := range *pulse.Channels[0].Recipients {
+		if r.Id != nil {
+			recipientIds = append(recipientIds, *r.Id)
+		}
+	}
+	if !reflect.DeepEqual(recipientIds, []int{2, 5, 6}) {
+		t.Errorf("Expected the group to be expanded to its members, got recipients %v.", recipientIds)
+	}
+
+	dashboardId := 1
+	createResp, err := client.CreatePulseWithResponse(ctx, metabase.CreatePulseBody{
+		Name:        pulse.Name,
+		DashboardId: &dashboardId,
+		SkipIfEmpty: data.SkipIfEmpty.ValueBoolPointer(),
+		Parameters:  &pulse.Parameters,
+		Cards:       pulse.Cards,
+		Channels:    pulse.Channels,
+	})
+	if err != nil || createResp.JSON200 == nil {
+		t.Fatalf("Failed to create pulse: %v, %s.", err, createResp.Body)
+	}
+
+	state := data
+	diags = updateModelFromPulse(ctx, client, *createResp.JSON200, &state)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	expected := data
+	expected.Id = types.Int64Value(1)
+	expected.Name = types.StringValue("📈 Exec")
+	if !reflect.DeepEqual(state, expected) {
+		t.Errorf("Round trip produced a different model.\nExpected: %+v\nGot: %+v", expected, state)
+	}
+
+	// Removing a group member from the recipients should cause the group to be replaced by its remaining members.
+	channels := pulses[1]["channels"].([]any)
+	email := channels[0].(map[string]any)
+	email["recipients"] = slices.DeleteFunc(email["recipients"].([]any), func(r any) bool {
+		id, ok := r.(map[string]any)["id"].(float64)
+		return ok && id == 6
+	})
+
+	getResp, err := client.GetPulseWithResponse(ctx, 1)
+	if err != nil || getResp.JSON200 == nil {
+		t.Fatalf("Failed to get pulse: %v.", err)
+	}
+
+	diags = updateModelFromPulse(ctx, client, *getResp.JSON200, &state)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var e PulseEmailModel
+	diags = state.Email.As(ctx, &e, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var userIds []int64
+	e.UserIds.ElementsAs(ctx, &userIds, false)
+	slices.Sort(userIds)
+	if len(e.GroupIds.Elements()) != 0 || !reflect.DeepEqual(userIds, []int64{2, 5}) {
+		t.Errorf("Expected drift on the group, got groups %v and users %v.", e.GroupIds, userIds)
+	}
+}
+
+func TestValidatePulseSchedule(t *testing.T) {
+	ctx := context.Background()
+
+	makeSchedule := func(scheduleType string, hour *int64, day string, frame string) types.Object {
+		values := map[string]attr.Value{
+			"type":  types.StringValue(scheduleType),
+			"hour":  types.Int64PointerValue(hour),
+			"day":   types.StringNull(),
+			"frame": types.StringNull(),
+		}
+		if len(day) > 0 {
+			values["day"] = types.StringValue(day)
+		}
+		if len(frame) > 0 {
+			values["frame"] = types.StringValue(frame)
+		}
+
+		o, _ := types.ObjectValue(pulseScheduleObjectType.AttrTypes, values)
+		return o
+	}
+
+	hour := int64(8)
+	invalidHour := int64(24)
+
+	testCases := []struct {
+		name     string
+		schedule types.Object
+		valid    bool
+	}{
+		{"hourly", makeSchedule("hourly", nil, "", ""), true},
+		{"hourly with hour", makeSchedule("hourly", &hour, "", ""), false},
+		{"daily", makeSchedule("daily", &hour, "", ""), true},
+		{"daily without hour", makeSchedule("daily", nil, "", ""), false},
+		{"daily with invalid hour", makeSchedule("daily", &invalidHour, "", ""), false},
+		{"weekly", makeSchedule("weekly", &hour, "mon", ""), true},
+		{"weekly without day", makeSchedule("weekly", &hour, "", ""), false},
+		{"weekly with invalid day", makeSchedule("weekly", &hour, "monday", ""), false},
+		{"monthly", makeSchedule("monthly", &hour, "", "first"), true},
+		{"monthly on first monday", makeSchedule("monthly", &hour, "mon", "first"), true},
+		{"monthly in the middle with day", makeSchedule("monthly", &hour, "mon", "mid"), false},
+		{"monthly without frame", makeSchedule("monthly", &hour, "", ""), false},
+		{"unknown type", makeSchedule("yearly", &hour, "", ""), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validatePulseSchedule(ctx, tc.schedule)
+			if diags.HasError() == tc.valid {
+				t.Errorf("Expected valid = %t, got diagnostics: %v", tc.valid, diags)
+			}
+		})
+	}
+}
+
+func testAccDashboardSubscriptionResource(name string, subscriptionName string, scheduleType string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "metabase_dashboard" "%s" {
+  name = "🔔 Subscribed dashboard"
+
+  dashcard {
+    card_id = metabase_card.%s.id
+    col     = 0
+    row     = 0
+    size_x  = 12
+    size_y  = 6
+  }
+}
+
+resource "metabase_dashboard_subscription" "%s" {
+  dashboard_id = metabase_dashboard.%s.id
+  name         = "%s"
+
+  schedule = {
+    type = "%s"
+    hour = 8
+  }
+
+  email = {
+    emails = ["subscriber@example.com"]
+  }
+
+  attachments = {
+    format = "csv"
+  }
+}
+`,
+		testAccCardResource(name, "🔔 Subscribed card", ""),
+		name,
+		name,
+		name,
+		name,
+		subscriptionName,
+		scheduleType,
+	)
+}
+
+func testAccCheckDashboardSubscriptionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state.", resourceName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetPulseWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 200 {
+			return fmt.Errorf("Received unexpected response from the Metabase API when getting dashboard subscription.")
+		}
+
+		if response.JSON200.Name == nil || rs.Primary.Attributes["name"] != *response.JSON200.Name {
+			return fmt.Errorf("Terraform resource and API response do not match for dashboard subscription name.")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDashboardSubscriptionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "metabase_dashboard_subscription" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetPulseWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 404 && !response.JSON200.Archived {
+			return fmt.Errorf("Dashboard subscription %s still exists.", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestAccDashboardSubscriptionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccDashboardSubscriptionResource("test", "🔔 Daily", "daily"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardSubscriptionExists("metabase_dashboard_subscription.test"),
+					resource.TestCheckResourceAttrSet("metabase_dashboard_subscription.test", "id"),
+					resource.TestCheckResourceAttr("metabase_dashboard_subscription.test", "schedule.type", "daily"),
+					resource.TestCheckResourceAttr("metabase_dashboard_subscription.test", "attachments.format", "csv"),
+				),
+			},
+			{
+				ResourceName:      "metabase_dashboard_subscription.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerApiKeyConfig + testAccDashboardSubscriptionResource("test", "🔔 Every hour", "hourly"),
+				ExpectError: regexp.MustCompile("hour attribute cannot be set"),
+			},
+		},
+	})
+}
+
+func testAccDashboardSubscriptionResourceWithGroup(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "metabase_permissions_group" "%s" {
+  name = "🔔 Subscribers"
+}
+
+resource "metabase_user" "%s_a" {
+  email      = "subscriber-a@example.com"
+  first_name = "Subscriber"
+  last_name  = "A"
+}
+
+resource "metabase_user" "%s_b" {
+  email      = "subscriber-b@example.com"
+  first_name = "Subscriber"
+  last_name  = "B"
+}
+
+resource "metabase_permissions_group_membership" "%s_a" {
+  group_id = metabase_permissions_group.%s.id
+  user_id  = metabase_user.%s_a.id
+}
+
+resource "metabase_permissions_group_membership" "%s_b" {
+  group_id = metabase_permissions_group.%s.id
+  user_id  = metabase_user.%s_b.id
+}
+
+resource "metabase_dashboard" "%s" {
+  name = "🔔 Subscribed dashboard"
+
+  dashcard {
+    card_id = metabase_card.%s.id
+    col     = 0
+    row     = 0
+    size_x  = 12
+    size_y  = 6
+  }
+}
+
+resource "metabase_dashboard_subscription" "%s" {
+  dashboard_id = metabase_dashboard.%s.id
+
+  schedule = {
+    type = "daily"
+    hour = 8
+  }
+
+  email = {
+    group_ids = [metabase_permissions_group.%s.id]
+  }
+
+  # Groups are expanded to their members when the subscription is created.
+  depends_on = [
+    metabase_permissions_group_membership.%s_a,
+    metabase_permissions_group_membership.%s_b,
+  ]
+}
+`,
+		testAccCardResource(name, "🔔 Subscribed card", ""),
+		name,
+		name,
+		name,
+		name, name, name,
+		name, name, name,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+	)
+}
+
+// Returns a function removing the given user from the email recipients of a dashboard subscription, as could be done
+// in the Metabase UI.
+func testAccRemoveDashboardSubscriptionRecipient(t *testing.T, subscriptionId *int, userId *int) func() {
+	return func() {
+		ctx := context.Background()
+
+		getResp, err := testAccMetabaseClient.GetPulseWithResponse(ctx, *subscriptionId)
+		if err != nil || getResp.JSON200 == nil {
+			t.Fatalf("Failed to get dashboard subscription: %v.", err)
+		}
+
+		channels := getResp.JSON200.Channels
+		for i, c := range channels {
+			if c.Recipients == nil {
+				continue
+			}
+
+			recipients := slices.DeleteFunc(*c.Recipients, func(r metabase.PulseChannelRecipient) bool {
+				return r.Id != nil && *r.Id == *userId
+			})
+			channels[i].Recipients = &recipients
+		}
+
+		updateResp, err := testAccMetabaseClient.UpdatePulseWithResponse(ctx, *subscriptionId, metabase.UpdatePulseBody{
+			Channels: &channels,
+		})
+		if err != nil || updateResp.StatusCode() != 200 {
+			t.Fatalf("Failed to update dashboard subscription: %v.", err)
+		}
+	}
+}
+
+func TestAccDashboardSubscriptionResourceWithGroup(t *testing.T) {
+	var subscriptionId, userId int
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccDashboardSubscriptionResourceWithGroup("test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardSubscriptionExists("metabase_dashboard_subscription.test"),
+					testAccStoreResourceId("metabase_dashboard_subscription.test", &subscriptionId),
+					testAccStoreResourceId("metabase_user.test_b", &userId),
+					resource.TestCheckResourceAttr("metabase_dashboard_subscription.test", "name", "🔔 Subscribed dashboard"),
+					resource.TestCheckResourceAttr("metabase_dashboard_subscription.test", "email.group_ids.#", "1"),
+					resource.TestCheckNoResourceAttr("metabase_dashboard_subscription.test", "email.user_ids"),
+				),
+			},
+			{
+				// A member of the group who is no longer a recipient should be detected as drift.
+				PreConfig:          testAccRemoveDashboardSubscriptionRecipient(t, &subscriptionId, &userId),
+				Config:             providerApiKeyConfig + testAccDashboardSubscriptionResourceWithGroup("test"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: providerApiKeyConfig + testAccDashboardSubscriptionResourceWithGroup("test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_dashboard_subscription.test", "email.group_ids.#", "1"),
+					resource.TestCheckNoResourceAttr("metabase_dashboard_subscription.test", "email.user_ids"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The Terraform model for a single `tab` block in a dashboard.
+// The ID of the tab is not exposed. Internally, tabs are identified by their (1-based) position in the list of blocks.
+type DashboardTabModel struct {
+	Name types.String `tfsdk:"name"` // The name of the tab.
+}
+
+// The object type definition for the `DashboardTabModel` model.
+var dashboardTabObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name": types.StringType,
+	},
+}
+
+// The schema for the `DashboardTabModel` model.
+var dashboardTabAttributes = map[string]schema.Attribute{
+	"name": schema.StringAttribute{
+		MarkdownDescription: "The name of the tab, which should be unique within the dashboard. Cards reference tabs using this name.",
+		Required:            true,
+	},
+}
+
+// Returns whether the tabs in the dashboard are defined using `tab` blocks rather than `tabs_json`.
+// When both are null, e.g. right after an import, `tabs_json` is used. As blocks are an empty list rather than null when
+// none are written, an empty list only counts when cards are not defined using `cards_json` either. Otherwise, tabs
+// created outside of Terraform would be deleted for dashboards only setting `cards_json`.
+func usesTabBlocks(data DashboardResourceModel) bool {
+	if !data.TabsJson.IsNull() || data.Tabs.IsNull() {
+		return false
+	}
+
+	return data.Tabs.IsUnknown() || len(data.Tabs.Elements()) > 0 || data.CardsJson.IsNull()
+}
+
+// Returns the names of the tabs defined using `tab` blocks, in order.
+func makeTabNamesFromBlocks(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if list.IsNull() || list.IsUnknown() {
+		return []string{}, diags
+	}
+
+	var tabs []DashboardTabModel
+	diags.Append(list.ElementsAs(ctx, &tabs, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	names := make([]string, 0, len(tabs))
+	for _, t := range tabs {
+		names = append(names, t.Name.ValueString())
+	}
+
+	return names, diags
+}
+
+// Returns the tabs in the dashboard, as a map from tab names to the IDs that can be used in `cards_json`. Those are
+// either the user-provided IDs in `tabs_json`, or the positions of the `tab` blocks.
+func makeTabIdsByName(ctx context.Context, data DashboardResourceModel) (map[string]int, diag.Diagnostics) {
+	if !usesTabBlocks(data) {
+		return makeTabIdsByNameFromModel(data.TabsJson)
+	}
+
+	names, diags := makeTabNamesFromBlocks(ctx, data.Tabs)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tabIds := make(map[string]int, len(names))
+	for i, name := range names {
+		tabIds[name] = i + 1
+	}
+
+	return tabIds, diags
+}
+
+// Returns the tabs defined in `tabs_json`, as a map from tab names to the user-provided IDs.
+func makeTabIdsByNameFromModel(tabsJson types.String) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tabIds := make(map[string]int)
+
+	if tabsJson.IsNull() || tabsJson.IsUnknown() {
+		return tabIds, diags
+	}
+
+	var tabs []map[string]any
+	err := json.Unmarshal([]byte(tabsJson.ValueString()), &tabs)
+	if err != nil {
+		diags.AddError("Unable to parse tabs JSON.", err.Error())
+		return nil, diags
+	}
+
+	for _, t := range tabs {
+		name, ok := t["name"].(string)
+		if !ok {
+			continue
+		}
+
+		tabIds[name] = toInt(t["id"])
+	}
+
+	return tabIds, diags
+}
+
+// Constructs the list of dashboard tabs from `tab` blocks, as a type-less list of maps that can be serialized to JSON.
+// Similarly to `makeTabsFromModel`, negative IDs are used, which will cause the Metabase API to create new tabs.
+func makeTabsFromBlocks(ctx context.Context, list types.List) ([]map[string]any, diag.Diagnostics) {
+	names, diags := makeTabNamesFromBlocks(ctx, list)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tabs := make([]map[string]any, 0, len(names))
+	for i, name := range names {
+		tabs = append(tabs, map[string]any{
+			"id":   -(i + 1),
+			"name": name,
+		})
+	}
+
+	return tabs, diags
+}
+
+// Updates the `tab` blocks in the `DashboardResourceModel` from the raw tabs returned by the Metabase API.
+// Returns a mapping from Metabase tab IDs to the positions of the tabs, which are used as IDs in the Terraform model.
+func updateTabBlocksFromRawTabs(ctx context.Context, rawTabs []any, data *DashboardResourceModel) (map[int]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tabIdMapping := make(map[int]int, len(rawTabs))
+
+	tabs := make([]DashboardTabModel, 0, len(rawTabs))
+	for i, t := range rawTabs {
+		tab, ok := t.(map[string]any)
+		if !ok {
+			diags.AddError("Could not parse tab as object.", fmt.Sprint(t))
+			return tabIdMapping, diags
+		}
+
+		if metabaseId, ok := tab["id"].(float64); ok {
+			tabIdMapping[int(metabaseId)] = i + 1
+		}
+
+		name, _ := tab["name"].(string)
+		tabs = append(tabs, DashboardTabModel{Name: types.StringValue(name)})
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, dashboardTabObjectType, tabs)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return tabIdMapping, diags
+	}
+
+	data.Tabs = list
+
+	return tabIdMapping, diags
+}
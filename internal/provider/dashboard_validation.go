@@ -0,0 +1,513 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The number of columns in the grid on which dashcards are laid out.
+const dashboardGridWidth = 24
+
+// The position and size of a single dashcard, used to validate the layout of a dashboard.
+type dashcardLayout struct {
+	Path  path.Path // The path to the dashcard in the configuration, used to report diagnostics.
+	Label string    // A human-readable reference to the dashcard, used in diagnostic messages.
+	Tab   string    // An identifier for the tab in which the card is placed. Empty if the dashboard has no tabs.
+	Row   int64     // The index of the row at which the card is placed.
+	Col   int64     // The index of the column at which the card is placed.
+	SizeX int64     // The horizontal size of the card.
+	SizeY int64     // The vertical size of the card.
+}
+
+// Returns whether two dashcards placed in the same tab overlap.
+func (l dashcardLayout) overlaps(other dashcardLayout) bool {
+	return l.Tab == other.Tab &&
+		l.Col < other.Col+other.SizeX && other.Col < l.Col+l.SizeX &&
+		l.Row < other.Row+other.SizeY && other.Row < l.Row+l.SizeY
+}
+
+// Validates the positions and sizes of dashcards, and reports cards that overlap each other or do not fit in the grid.
+func validateDashcardLayouts(layouts []dashcardLayout) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, l := range layouts {
+		if l.Row < 0 || l.Col < 0 {
+			diags.AddAttributeError(l.Path, "Invalid dashcard position.", fmt.Sprintf("The row and column of %s should be positive, got row %d and column %d.", l.Label, l.Row, l.Col))
+		}
+
+		if l.SizeX < 1 || l.SizeY < 1 {
+			diags.AddAttributeError(l.Path, "Invalid dashcard size.", fmt.Sprintf("The size of %s should be at least 1x1, got %dx%d.", l.Label, l.SizeX, l.SizeY))
+		}
+
+		if l.Col+l.SizeX > dashboardGridWidth {
+			diags.AddAttributeError(
+				l.Path,
+				"Dashcard exceeds the dashboard width.",
+				fmt.Sprintf("The dashboard grid has %d columns, but %s spans columns %d to %d.", dashboardGridWidth, l.Label, l.Col, l.Col+l.SizeX-1),
+			)
+		}
+
+		for _, other := range layouts[:i] {
+			if l.overlaps(other) {
+				diags.AddAttributeError(
+					l.Path,
+					"Overlapping dashcards.",
+					fmt.Sprintf("%s at row %d and column %d overlaps with %s at row %d and column %d.", l.Label, l.Row, l.Col, other.Label, other.Row, other.Col),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// Makes the layouts of the cards defined using `dashcard` blocks. Cards for which the position or size is not known yet
+// are skipped.
+func makeDashcardLayoutsFromBlocks(ctx context.Context, data DashboardResourceModel) ([]dashcardLayout, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var dashcards []DashcardModel
+	diags.Append(data.Dashcards.ElementsAs(ctx, &dashcards, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	layouts := make([]dashcardLayout, 0, len(dashcards))
+	for i, dc := range dashcards {
+		if dc.Row.IsUnknown() || dc.Col.IsUnknown() || dc.SizeX.IsUnknown() || dc.SizeY.IsUnknown() || dc.Tab.IsUnknown() {
+			continue
+		}
+
+		dashcardPath := path.Root("dashcard").AtListIndex(i)
+		layouts = append(layouts, dashcardLayout{
+			Path:  dashcardPath,
+			Label: dashcardPath.String(),
+			Tab:   dc.Tab.ValueString(),
+			Row:   dc.Row.ValueInt64(),
+			Col:   dc.Col.ValueInt64(),
+			SizeX: dc.SizeX.ValueInt64(),
+			SizeY: dc.SizeY.ValueInt64(),
+		})
+	}
+
+	return layouts, diags
+}
+
+// Returns whether a list of blocks is set in the configuration and contains at least one element.
+func hasBlocks(list types.List) bool {
+	return !list.IsNull() && !list.IsUnknown() && len(list.Elements()) > 0
+}
+
+// Reports `tab` blocks with duplicate names, which would make references from dashcards ambiguous.
+func validateTabBlocks(ctx context.Context, list types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var tabs []DashboardTabModel
+	diags.Append(list.ElementsAs(ctx, &tabs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	seen := make(map[string]bool, len(tabs))
+	for i, t := range tabs {
+		if t.Name.IsUnknown() {
+			continue
+		}
+
+		if seen[t.Name.ValueString()] {
+			diags.AddAttributeError(
+				path.Root("tab").AtListIndex(i).AtName("name"),
+				"Duplicate tab name.",
+				fmt.Sprintf("A tab named %q is already defined. Tab names should be unique within a dashboard.", t.Name.ValueString()),
+			)
+		}
+		seen[t.Name.ValueString()] = true
+	}
+
+	return diags
+}
+
+// Reports `parameter` blocks with duplicate names or IDs.
+func validateParameterBlocks(ctx context.Context, list types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var parameters []DashboardParameterModel
+	diags.Append(list.ElementsAs(ctx, &parameters, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	seenNames := make(map[string]bool, len(parameters))
+	seenIds := make(map[string]bool, len(parameters))
+	for i, p := range parameters {
+		parameterPath := path.Root("parameter").AtListIndex(i)
+
+		if !p.Name.IsUnknown() {
+			if seenNames[p.Name.ValueString()] {
+				diags.AddAttributeError(
+					parameterPath.AtName("name"),
+					"Duplicate parameter name.",
+					fmt.Sprintf("A parameter named %q is already defined. Parameter names should be unique within a dashboard.", p.Name.ValueString()),
+				)
+			}
+			seenNames[p.Name.ValueString()] = true
+		}
+
+		if !p.Id.IsUnknown() && !p.Slug.IsUnknown() {
+			if seenIds[p.parameterId()] {
+				diags.AddAttributeError(
+					parameterPath,
+					"Duplicate parameter ID.",
+					fmt.Sprintf("A parameter with ID %q is already defined. When id is not set, the slug is used as the ID.", p.parameterId()),
+				)
+			}
+			seenIds[p.parameterId()] = true
+		}
+	}
+
+	return diags
+}
+
+// The tabs and parameters defined in a dashboard, used to validate references from dashcards.
+// A nil map means the corresponding values are not known yet, in which case references are not validated.
+type dashboardReferences struct {
+	TabIds         map[int]bool    // The IDs of the tabs, as they can be referenced in `cards_json`.
+	TabNames       map[string]bool // The names of the tabs, as they can be referenced in `dashcard` blocks.
+	ParameterIds   map[string]bool // The IDs of the parameters.
+	ParameterNames map[string]bool // The names of the parameters defined using `parameter` blocks.
+}
+
+// Returns whether a JSON number is a positive integer.
+func isPositiveInteger(v any) bool {
+	n, ok := v.(float64)
+	return ok && n > 0 && n == math.Trunc(n)
+}
+
+// Parses the tabs defined in `tabs_json` or `tab` blocks, reporting invalid tabs in `tabs_json`.
+func makeTabReferences(ctx context.Context, data DashboardResourceModel, refs *dashboardReferences) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.TabsJson.IsUnknown() || data.Tabs.IsUnknown() {
+		return diags
+	}
+
+	tabIds := make(map[int]bool)
+	tabNames := make(map[string]bool)
+
+	if !data.TabsJson.IsNull() {
+		var tabs []map[string]any
+		err := json.Unmarshal([]byte(data.TabsJson.ValueString()), &tabs)
+		if err != nil {
+			diags.AddAttributeError(path.Root("tabs_json"), "Invalid tabs JSON.", fmt.Sprintf("tabs_json should be a list of objects: %s", err.Error()))
+			return diags
+		}
+
+		for i, t := range tabs {
+			if !isPositiveInteger(t["id"]) {
+				diags.AddAttributeError(path.Root("tabs_json"), "Invalid tab ID.", fmt.Sprintf("The id of tab %d in tabs_json should be a positive integer, got %v.", i, t["id"]))
+				continue
+			}
+
+			id := toInt(t["id"])
+			if tabIds[id] {
+				diags.AddAttributeError(path.Root("tabs_json"), "Duplicate tab ID.", fmt.Sprintf("The id %d of tab %d in tabs_json is already used by another tab.", id, i))
+			}
+			tabIds[id] = true
+
+			if name, ok := t["name"].(string); ok {
+				tabNames[name] = true
+			} else {
+				diags.AddAttributeError(path.Root("tabs_json"), "Missing tab name.", fmt.Sprintf("Tab %d in tabs_json should have a name.", i))
+			}
+		}
+	} else {
+		var tabs []DashboardTabModel
+		diags.Append(data.Tabs.ElementsAs(ctx, &tabs, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for i, t := range tabs {
+			if t.Name.IsUnknown() {
+				return diags
+			}
+
+			tabIds[i+1] = true
+			tabNames[t.Name.ValueString()] = true
+		}
+	}
+
+	refs.TabIds = tabIds
+	refs.TabNames = tabNames
+
+	return diags
+}
+
+// Parses the parameters defined in `parameters_json` or `parameter` blocks, reporting invalid parameters in
+// `parameters_json`.
+func makeParameterReferences(ctx context.Context, data DashboardResourceModel, refs *dashboardReferences) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.ParametersJson.IsUnknown() || data.Parameters.IsUnknown() {
+		return diags
+	}
+
+	parameterIds := make(map[string]bool)
+	parameterNames := make(map[string]bool)
+
+	if !data.ParametersJson.IsNull() {
+		var parameters []map[string]any
+		err := json.Unmarshal([]byte(data.ParametersJson.ValueString()), &parameters)
+		if err != nil {
+			diags.AddAttributeError(path.Root("parameters_json"), "Invalid parameters JSON.", fmt.Sprintf("parameters_json should be a list of objects: %s", err.Error()))
+			return diags
+		}
+
+		for i, p := range parameters {
+			id, ok := p["id"].(string)
+			if !ok || len(id) == 0 {
+				diags.AddAttributeError(path.Root("parameters_json"), "Invalid parameter ID.", fmt.Sprintf("Parameter %d in parameters_json should have a non-empty string id.", i))
+				continue
+			}
+
+			if parameterIds[id] {
+				diags.AddAttributeError(path.Root("parameters_json"), "Duplicate parameter ID.", fmt.Sprintf("The id %q of parameter %d in parameters_json is already used by another parameter.", id, i))
+			}
+			parameterIds[id] = true
+		}
+	} else {
+		var parameters []DashboardParameterModel
+		diags.Append(data.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, p := range parameters {
+			if p.Id.IsUnknown() || p.Slug.IsUnknown() || p.Name.IsUnknown() {
+				return diags
+			}
+
+			parameterIds[p.parameterId()] = true
+			parameterNames[p.Name.ValueString()] = true
+		}
+	}
+
+	refs.ParameterIds = parameterIds
+	refs.ParameterNames = parameterNames
+
+	return diags
+}
+
+// Validates the cards defined in `cards_json`: their layout, and the tabs and parameters they reference.
+// As the attribute is a JSON string, diagnostics cannot point to a specific card and mention its index instead.
+func validateCardsJson(cardsJson string, refs dashboardReferences) diag.Diagnostics {
+	var diags diag.Diagnostics
+	cardsPath := path.Root("cards_json")
+
+	var cards []map[string]any
+	err := json.Unmarshal([]byte(cardsJson), &cards)
+	if err != nil {
+		diags.AddAttributeError(cardsPath, "Invalid cards JSON.", fmt.Sprintf("cards_json should be a list of objects: %s", err.Error()))
+		return diags
+	}
+
+	layouts := make([]dashcardLayout, 0, len(cards))
+	for i, card := range cards {
+		label := fmt.Sprintf("card %d in cards_json", i)
+
+		missingAttribute := false
+		for _, key := range []string{"row", "col", "size_x", "size_y"} {
+			if _, ok := card[key].(float64); !ok {
+				diags.AddAttributeError(cardsPath, "Invalid dashcard layout.", fmt.Sprintf("The %s attribute of %s should be a number.", key, label))
+				missingAttribute = true
+			}
+		}
+
+		tab := ""
+		if tabId, ok := card["dashboard_tab_id"]; ok && tabId != nil {
+			tab = fmt.Sprint(tabId)
+
+			if refs.TabIds != nil && (!isPositiveInteger(tabId) || !refs.TabIds[toInt(tabId)]) {
+				diags.AddAttributeError(cardsPath, "Unknown dashboard tab.", fmt.Sprintf("The dashboard_tab_id %v of %s does not match the ID of any tab in the dashboard.", tabId, label))
+			}
+		}
+
+		rawMappings, _ := card["parameter_mappings"].([]any)
+		for j, m := range rawMappings {
+			mapping, _ := m.(map[string]any)
+			parameterId, ok := mapping["parameter_id"].(string)
+			if !ok {
+				diags.AddAttributeError(cardsPath, "Invalid parameter mapping.", fmt.Sprintf("Parameter mapping %d of %s should have a string parameter_id.", j, label))
+				continue
+			}
+
+			if refs.ParameterIds != nil && !refs.ParameterIds[parameterId] {
+				diags.AddAttributeError(cardsPath, "Unknown dashboard parameter.", fmt.Sprintf("Parameter mapping %d of %s references the parameter %q, which is not defined in the dashboard.", j, label, parameterId))
+			}
+		}
+
+		if missingAttribute {
+			continue
+		}
+
+		layouts = append(layouts, dashcardLayout{
+			Path:  cardsPath,
+			Label: label,
+			Tab:   tab,
+			Row:   int64(toInt(card["row"])),
+			Col:   int64(toInt(card["col"])),
+			SizeX: int64(toInt(card["size_x"])),
+			SizeY: int64(toInt(card["size_y"])),
+		})
+	}
+
+	diags.Append(validateDashcardLayouts(layouts)...)
+
+	return diags
+}
+
+// Checks that `dashcard` blocks reference tabs and parameters that are defined in the dashboard, and that each
+// parameter mapping references a parameter either by name or by ID. Click behaviors are also validated.
+func validateDashcardBlockReferences(ctx context.Context, list types.List, refs dashboardReferences) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var dashcards []DashcardModel
+	diags.Append(list.ElementsAs(ctx, &dashcards, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, dc := range dashcards {
+		dashcardPath := path.Root("dashcard").AtListIndex(i)
+
+		if !dc.Tab.IsNull() && !dc.Tab.IsUnknown() && refs.TabNames != nil && !refs.TabNames[dc.Tab.ValueString()] {
+			diags.AddAttributeError(
+				dashcardPath.AtName("tab"),
+				"Unknown dashboard tab.",
+				fmt.Sprintf("No tab named %q is defined in the dashboard.", dc.Tab.ValueString()),
+			)
+		}
+
+		diags.Append(validateDashcardClickBehaviors(ctx, dashcardPath, dc.ClickBehaviors, refs)...)
+
+		if dc.ParameterMappings.IsNull() || dc.ParameterMappings.IsUnknown() {
+			continue
+		}
+
+		var mappings []DashcardParameterMappingModel
+		diags.Append(dc.ParameterMappings.ElementsAs(ctx, &mappings, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for j, m := range mappings {
+			mappingPath := dashcardPath.AtName("parameter_mappings").AtListIndex(j)
+
+			if m.Parameter.IsUnknown() || m.ParameterId.IsUnknown() {
+				continue
+			}
+
+			if m.Parameter.IsNull() == m.ParameterId.IsNull() {
+				diags.AddAttributeError(mappingPath, "Invalid parameter mapping.", "Exactly one of parameter or parameter_id should be set.")
+				continue
+			}
+
+			if !m.Parameter.IsNull() && refs.ParameterNames != nil && !refs.ParameterNames[m.Parameter.ValueString()] {
+				diags.AddAttributeError(
+					mappingPath.AtName("parameter"),
+					"Unknown dashboard parameter.",
+					fmt.Sprintf("No parameter block named %q is defined in the dashboard.", m.Parameter.ValueString()),
+				)
+			}
+
+			if !m.ParameterId.IsNull() && refs.ParameterIds != nil && !refs.ParameterIds[m.ParameterId.ValueString()] {
+				diags.AddAttributeError(
+					mappingPath.AtName("parameter_id"),
+					"Unknown dashboard parameter.",
+					fmt.Sprintf("No parameter with ID %q is defined in the dashboard.", m.ParameterId.ValueString()),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+func (r *DashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DashboardResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.TabsJson.IsNull() && hasBlocks(data.Tabs) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tabs_json"),
+			"Conflicting tabs definitions.",
+			"Only one of tabs_json or tab blocks can be used to define the tabs in the dashboard.",
+		)
+	}
+
+	if !data.ParametersJson.IsNull() && hasBlocks(data.Parameters) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parameters_json"),
+			"Conflicting parameters definitions.",
+			"Only one of parameters_json or parameter blocks can be used to define the parameters of the dashboard.",
+		)
+	}
+
+	resp.Diagnostics.Append(validateEmbeddingParams(ctx, data.EmbeddingParams)...)
+
+	if hasBlocks(data.Tabs) {
+		resp.Diagnostics.Append(validateTabBlocks(ctx, data.Tabs)...)
+	}
+
+	if hasBlocks(data.Parameters) {
+		resp.Diagnostics.Append(validateParameterBlocks(ctx, data.Parameters)...)
+	}
+
+	var refs dashboardReferences
+	resp.Diagnostics.Append(makeTabReferences(ctx, data, &refs)...)
+	resp.Diagnostics.Append(makeParameterReferences(ctx, data, &refs)...)
+
+	hasDashcardBlocks := hasBlocks(data.Dashcards)
+
+	if !data.CardsJson.IsNull() && hasDashcardBlocks {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cards_json"),
+			"Conflicting dashcards definitions.",
+			"Only one of cards_json or dashcard blocks can be used to define the cards in the dashboard.",
+		)
+		return
+	}
+
+	if data.CardsJson.IsNull() && !hasDashcardBlocks && !data.Dashcards.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cards_json"),
+			"Missing dashcards definition.",
+			"Either cards_json or at least one dashcard block should be set. Use jsonencode([]) in cards_json for an empty dashboard.",
+		)
+		return
+	}
+
+	if hasDashcardBlocks {
+		layouts, diags := makeDashcardLayoutsFromBlocks(ctx, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(validateDashcardLayouts(layouts)...)
+		resp.Diagnostics.Append(validateDashcardBlockReferences(ctx, data.Dashcards, refs)...)
+	} else if !data.CardsJson.IsUnknown() {
+		resp.Diagnostics.Append(validateCardsJson(data.CardsJson.ValueString(), refs)...)
+	}
+}
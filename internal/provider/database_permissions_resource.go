@@ -0,0 +1,459 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &DatabasePermissionsResource{}
+var _ resource.ResourceWithValidateConfig = &DatabasePermissionsResource{}
+var _ resource.ResourceWithModifyPlan = &DatabasePermissionsResource{}
+
+// Creates a new database permissions resource.
+func NewDatabasePermissionsResource() resource.Resource {
+	return &DatabasePermissionsResource{
+		MetabaseBaseResource{name: "database_permissions"},
+	}
+}
+
+// A resource handling the permissions of a single group in the Metabase permissions graph.
+type DatabasePermissionsResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for the database permissions of a group.
+type DatabasePermissionsResourceModel struct {
+	Id                  types.Int64 `tfsdk:"id"`                   // The ID of the permissions group.
+	GroupId             types.Int64 `tfsdk:"group_id"`             // The ID of the permissions group.
+	AdvancedPermissions types.Bool  `tfsdk:"advanced_permissions"` // Whether advanced permissions should be set.
+	Permissions         types.Set   `tfsdk:"permissions"`          // The permissions of the group on each database.
+}
+
+// The model for the permissions of a group on a single database.
+type GroupDatabasePermissions struct {
+	Database      types.Int64  `tfsdk:"database"`       // The ID of the database to which the permission applies.
+	DatabaseName  types.String `tfsdk:"database_name"`  // The name of the database, which can be set instead of its ID.
+	ViewData      types.String `tfsdk:"view_data"`      // View data access permission.
+	CreateQueries types.String `tfsdk:"create_queries"` // Create queries access permission.
+	Download      types.Object `tfsdk:"download"`       // Download-related permission (only available with advanced permissions).
+	DataModel     types.Object `tfsdk:"data_model"`     // Data-model-related permission (only available with advanced permissions).
+	Details       types.String `tfsdk:"details"`        // Details permission (only available with advanced permissions).
+	Schemas       types.Set    `tfsdk:"schema"`         // Granular permissions for each schema and table.
+}
+
+// The object type definition for the `GroupDatabasePermissions` model.
+var groupDatabasePermissionsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"database":       types.Int64Type,
+		"database_name":  types.StringType,
+		"view_data":      types.StringType,
+		"create_queries": types.StringType,
+		"download":       accessPermissionsObjectType,
+		"data_model":     accessPermissionsObjectType,
+		"details":        types.StringType,
+		"schema":         types.SetType{ElemType: schemaPermissionsObjectType},
+	},
+}
+
+// The number of attempts made to update a permissions graph when its revision conflicts with concurrent changes.
+const permissionsGraphUpdateAttempts = 5
+
+// Calls `update` until it does not report a revision conflict, or until `permissionsGraphUpdateAttempts` is reached.
+// `update` should fetch the latest revision of the graph each time it is called, and returns whether Metabase rejected
+// the update because the graph was modified concurrently.
+func retryOnPermissionsGraphConflict(update func() (bool, diag.Diagnostics)) diag.Diagnostics {
+	for attempt := 1; ; attempt++ {
+		conflict, diags := update()
+		if !conflict || attempt >= permissionsGraphUpdateAttempts {
+			return diags
+		}
+	}
+}
+
+func (r *DatabasePermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The permissions of a single permissions group on databases.
+
+Unlike ` + "`metabase_permissions_graph`" + `, this resource only manages the permissions of one group, such that the permissions of different groups can be managed from separate Terraform configurations. Permissions of the group on databases which are not listed are removed, except impersonated ones with an existing impersonation, which are managed by the ` + "`metabase_impersonation`" + ` resource. The group should be part of the ` + "`ignored_groups`" + ` if a ` + "`metabase_permissions_graph`" + ` resource is also defined.
+
+Changes are applied to the latest revision of the permissions graph, and are retried if the graph is modified concurrently. Destroying the resource removes all the permissions of the group on databases.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permissions group.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permissions group.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"advanced_permissions": schema.BoolAttribute{
+				MarkdownDescription: "Whether advanced permissions should be set even when not explicitly specified.",
+				Optional:            true,
+			},
+			"permissions": schema.SetNestedAttribute{
+				MarkdownDescription: "The permissions of the group for each database. A database should appear only once in the list.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"database": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the database to which the permission applies. Exactly one of `database` or `database_name` must be set.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"database_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the database to which the permission applies, resolved to its ID.",
+							Optional:            true,
+						},
+						"view_data": schema.StringAttribute{
+							MarkdownDescription: "The permission definition for data access on the entire database. Either this or the `view_data` of schemas must be set.",
+							Optional:            true,
+						},
+						"create_queries": schema.StringAttribute{
+							MarkdownDescription: "The permission definition for creating queries on the entire database. Either this or the `create_queries` of schemas must be set.",
+							Optional:            true,
+						},
+						"download": schema.SingleNestedAttribute{
+							MarkdownDescription: "The permission definition for downloading data.",
+							Optional:            true,
+							Attributes:          accessPermissionAttributes,
+						},
+						"data_model": schema.SingleNestedAttribute{
+							MarkdownDescription: "The permission definition for accessing the data model.",
+							Optional:            true,
+							Attributes:          accessPermissionAttributes,
+						},
+						"details": schema.StringAttribute{
+							MarkdownDescription: "The permission definition for accessing details.",
+							Optional:            true,
+						},
+						"schema": schemaPermissionsAttribute,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Makes the model of a permissions graph containing only the permissions of the group, such that the functions handling
+// the entire graph can be reused.
+func makePermissionsGraphModelFromDatabasePermissions(ctx context.Context, data DatabasePermissionsResourceModel) (*PermissionsGraphResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	groupPermissions := make([]GroupDatabasePermissions, 0, len(data.Permissions.Elements()))
+	diags.Append(data.Permissions.ElementsAs(ctx, &groupPermissions, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	permissions := make([]DatabasePermissions, 0, len(groupPermissions))
+	for _, p := range groupPermissions {
+		permissions = append(permissions, DatabasePermissions{
+			Group:         data.GroupId,
+			GroupName:     types.StringNull(),
+			Database:      p.Database,
+			DatabaseName:  p.DatabaseName,
+			ViewData:      p.ViewData,
+			CreateQueries: p.CreateQueries,
+			Download:      p.Download,
+			DataModel:     p.DataModel,
+			Details:       p.Details,
+			Schemas:       p.Schemas,
+		})
+	}
+
+	permissionsSet, setDiags := types.SetValueFrom(ctx, databasePermissionsObjectType, permissions)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &PermissionsGraphResourceModel{
+		Revision:            types.Int64Null(),
+		AdvancedPermissions: data.AdvancedPermissions,
+		// No group is ignored, as only the permissions of the group are part of the graph.
+		IgnoredGroups: types.SetValueMust(types.Int64Type, []attr.Value{}),
+		Permissions:   permissionsSet,
+	}, diags
+}
+
+// Updates the permissions of the `DatabasePermissionsResourceModel` from the model of the permissions graph.
+func updateDatabasePermissionsFromPermissionsGraphModel(ctx context.Context, graph PermissionsGraphResourceModel, data *DatabasePermissionsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	permissions := make([]DatabasePermissions, 0, len(graph.Permissions.Elements()))
+	diags.Append(graph.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	groupPermissions := make([]GroupDatabasePermissions, 0, len(permissions))
+	for _, p := range permissions {
+		groupPermissions = append(groupPermissions, GroupDatabasePermissions{
+			Database:      p.Database,
+			DatabaseName:  p.DatabaseName,
+			ViewData:      p.ViewData,
+			CreateQueries: p.CreateQueries,
+			Download:      p.Download,
+			DataModel:     p.DataModel,
+			Details:       p.Details,
+			Schemas:       p.Schemas,
+		})
+	}
+
+	permissionsSet, setDiags := types.SetValueFrom(ctx, groupDatabasePermissionsObjectType, groupPermissions)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Permissions = permissionsSet
+	data.Id = data.GroupId
+
+	return diags
+}
+
+// Returns a copy of the permissions graph only containing the permissions of the given group.
+func filterPermissionsGraphGroup(g metabase.PermissionsGraph, groupId string) metabase.PermissionsGraph {
+	filtered := metabase.PermissionsGraph{
+		Revision: g.Revision,
+		Groups:   map[string]metabase.PermissionsGraphDatabasePermissionsMap{},
+	}
+
+	if groupPermissions, ok := g.Groups[groupId]; ok {
+		filtered.Groups[groupId] = groupPermissions
+	}
+
+	return filtered
+}
+
+// Replaces the permissions of the group in the permissions graph with the ones in the model, and updates the model from
+// the graph returned by Metabase. Permissions of the group on databases which are not in the model are removed, while
+// the permissions of other groups are left untouched. The update is retried if the graph is modified concurrently.
+func updateGroupDatabasePermissions(ctx context.Context, client *metabase.ClientWithResponses, data *DatabasePermissionsResourceModel) diag.Diagnostics {
+	groupId := strconv.FormatInt(data.GroupId.ValueInt64(), 10)
+	names := newPermissionsGraphNames(client)
+
+	graph, diags := makePermissionsGraphModelFromDatabasePermissions(ctx, *data)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Names which could not be resolved when planning reference databases created during the apply.
+	diags.Append(resolvePermissionsGraphModelNames(ctx, names, graph, true)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var updateResp *metabase.ReplacePermissionsGraphResponse
+	diags.Append(retryOnPermissionsGraphConflict(func() (bool, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		getResp, err := client.GetPermissionsGraphWithResponse(ctx)
+		diags.Append(checkMetabaseResponse(getResp, err, []int{200}, "get permissions graph")...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		// The current permissions of the group are used to remove the ones which are no longer in the model.
+		current := PermissionsGraphResourceModel{
+			IgnoredGroups: types.SetValueMust(types.Int64Type, []attr.Value{}),
+			Permissions:   types.SetValueMust(databasePermissionsObjectType, []attr.Value{}),
+		}
+		diags.Append(updateModelFromPermissionsGraph(ctx, names, filterPermissionsGraphGroup(*getResp.JSON200, groupId), &current)...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		body, graphDiags := makePermissionsGraphFromModel(ctx, *graph, &current)
+		diags.Append(graphDiags...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		updateResp, err = client.ReplacePermissionsGraphWithResponse(ctx, *body)
+		diags.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update permissions graph")...)
+
+		return err == nil && updateResp.StatusCode() == http.StatusConflict, diags
+	})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(updateModelFromPermissionsGraph(ctx, names, filterPermissionsGraphGroup(*updateResp.JSON200, groupId), graph)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(updateDatabasePermissionsFromPermissionsGraphModel(ctx, *graph, data)...)
+
+	return diags
+}
+
+func (r *DatabasePermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DatabasePermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Permissions.IsUnknown() {
+		return
+	}
+
+	permissions := make([]GroupDatabasePermissions, 0, len(data.Permissions.Elements()))
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range permissions {
+		resp.Diagnostics.Append(validatePermissionsGraphKey(p.Database, p.DatabaseName, "database", "database_name")...)
+		resp.Diagnostics.Append(validateGranularPermissions(ctx, DatabasePermissions{
+			ViewData:      p.ViewData,
+			CreateQueries: p.CreateQueries,
+			Download:      p.Download,
+			DataModel:     p.DataModel,
+			Schemas:       p.Schemas,
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+func (r *DatabasePermissionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Names cannot be resolved when the provider is not configured yet, or when the resource is destroyed.
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data DatabasePermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Permissions.IsUnknown() {
+		return
+	}
+
+	graph, diags := makePermissionsGraphModelFromDatabasePermissions(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resolvePermissionsGraphModelNames(ctx, newPermissionsGraphNames(r.client), graph, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateDatabasePermissionsFromPermissionsGraphModel(ctx, *graph, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permissions"), data.Permissions)...)
+}
+
+func (r *DatabasePermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DatabasePermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateGroupDatabasePermissions(ctx, r.client, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabasePermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DatabasePermissionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The group ID and permissions are not set when importing the resource.
+	if data.GroupId.IsNull() {
+		data.GroupId = data.Id
+	}
+	if data.Permissions.IsNull() {
+		data.Permissions = types.SetValueMust(groupDatabasePermissionsObjectType, []attr.Value{})
+	}
+
+	getResp, err := r.client.GetPermissionsGraphWithResponse(ctx)
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200}, "get permissions graph")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graph, diags := makePermissionsGraphModelFromDatabasePermissions(ctx, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupId := strconv.FormatInt(data.GroupId.ValueInt64(), 10)
+	resp.Diagnostics.Append(updateModelFromPermissionsGraph(ctx, newPermissionsGraphNames(r.client), filterPermissionsGraphGroup(*getResp.JSON200, groupId), graph)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateDatabasePermissionsFromPermissionsGraphModel(ctx, *graph, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabasePermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DatabasePermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateGroupDatabasePermissions(ctx, r.client, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabasePermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DatabasePermissionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Updating the group with no permission removes all its existing permissions.
+	data.Permissions = types.SetValueMust(groupDatabasePermissionsObjectType, []attr.Value{})
+
+	resp.Diagnostics.Append(updateGroupDatabasePermissions(ctx, r.client, data)...)
+}
+
+func (r *DatabasePermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIntegerId(ctx, req, resp)
+}
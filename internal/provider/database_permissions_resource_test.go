@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Returns the configuration of a group along with the permissions graph, which ignores the group. The permissions of
+// the group on the sample database are managed by a `metabase_database_permissions` resource, unless `createQueries`
+// is empty.
+func testAccDatabasePermissionsResource(name string, createQueries string) string {
+	config := fmt.Sprintf(`
+resource "metabase_permissions_group" "%s" {
+  name = "🗄️ Database permissions"
+}
+
+data "metabase_permissions_group" "administrators" {
+  name = "Administrators"
+}
+
+import {
+  to = metabase_permissions_graph.graph
+  id = "1"
+}
+
+resource "metabase_permissions_graph" "graph" {
+  advanced_permissions = false
+  ignored_groups       = [data.metabase_permissions_group.administrators.id, metabase_permissions_group.%s.id]
+
+  permissions = [
+    {
+      group    = 1
+      database = 1
+      download = {
+        schemas = "full"
+      }
+      view_data      = "unrestricted"
+      create_queries = "query-builder"
+    },
+  ]
+}
+`,
+		name,
+		name,
+	)
+
+	if createQueries == "" {
+		return config
+	}
+
+	return config + fmt.Sprintf(`
+resource "metabase_database_permissions" "%s" {
+  group_id = metabase_permissions_group.%s.id
+
+  permissions = [
+    {
+      database_name  = "Sample Database"
+      view_data      = "unrestricted"
+      create_queries = "%s"
+    },
+  ]
+
+  # Both resources update the permissions graph, which is simpler to do one after the other.
+  depends_on = [metabase_permissions_graph.graph]
+}
+`,
+		name,
+		name,
+		createQueries,
+	)
+}
+
+// Checks the permission of a group to create queries on a database in the permissions graph. A group without any
+// permission on the database cannot create queries.
+func testAccCheckDatabaseCreateQueries(groupId *int, databaseId int, expected metabase.PermissionsGraphDatabasePermissionsCreateQueries) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		response, err := testAccMetabaseClient.GetPermissionsGraphWithResponse(context.Background())
+		if diags := checkMetabaseResponse(response, err, []int{200}, "get permissions graph"); diags.HasError() {
+			return fmt.Errorf("Failed to get permissions graph: %v.", diags)
+		}
+
+		createQueries := metabase.PermissionsGraphDatabasePermissionsCreateQueriesNo
+		if p, ok := response.JSON200.Groups[strconv.Itoa(*groupId)][strconv.Itoa(databaseId)]; ok && p.CreateQueries != nil {
+			createQueries, err = p.CreateQueries.AsPermissionsGraphDatabasePermissionsCreateQueries()
+			if err != nil {
+				return fmt.Errorf("Expected a permission on the entire database %d for group %d: %v.", databaseId, *groupId, err)
+			}
+		}
+
+		if createQueries != expected {
+			return fmt.Errorf("Expected create queries permission %s on database %d for group %d, got %s.", expected, databaseId, *groupId, createQueries)
+		}
+
+		return nil
+	}
+}
+
+// Registers the permissions graph endpoints on the stand-in server, starting from the given groups. The first
+// `conflicts` updates are rejected as if the graph had been modified concurrently. Updates are merged into the graph
+// like Metabase does, and the bodies of the accepted updates are returned.
+func serveStandInPermissionsGraph(s *metabaseStandIn, apiPath string, groups map[string]map[string]any, conflicts int) *[]map[string]any {
+	revision := 1
+	updates := []map[string]any{}
+
+	s.mux.HandleFunc("GET /api"+apiPath, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		writeStandInJson(w, http.StatusOK, map[string]any{"revision": revision, "groups": groups})
+	})
+
+	s.mux.HandleFunc("PUT /api"+apiPath, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var update map[string]any
+		if err := json.Unmarshal(body, &update); err != nil {
+			writeStandInJson(w, http.StatusBadRequest, map[string]any{"message": err.Error()})
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		// Simulates another client updating the graph between the read and the update.
+		if conflicts > 0 {
+			conflicts--
+			revision++
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte("Looks like someone else edited the permissions and your data is out of date."))
+			return
+		}
+
+		if int(update["revision"].(float64)) != revision {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		for groupId, groupPermissions := range update["groups"].(map[string]any) {
+			if _, ok := groups[groupId]; !ok {
+				groups[groupId] = map[string]any{}
+			}
+			for id, permissions := range groupPermissions.(map[string]any) {
+				// Revoked database permissions are removed from the graph.
+				if p, ok := permissions.(map[string]any); ok && p["view-data"] == nil {
+					delete(groups[groupId], id)
+					continue
+				}
+				groups[groupId][id] = permissions
+			}
+		}
+		revision++
+		updates = append(updates, update)
+
+		writeStandInJson(w, http.StatusOK, map[string]any{"revision": revision, "groups": groups})
+	})
+
+	return &updates
+}
+
+func TestUpdateGroupDatabasePermissionsStandIn(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	serveStandInPermissionsGraphNames(s)
+	updates := serveStandInPermissionsGraph(s, "/permissions/graph", map[string]map[string]any{
+		"1": {"1": map[string]any{"view-data": "unrestricted", "create-queries": "query-builder-and-native"}},
+		"3": {"2": map[string]any{"view-data": "unrestricted", "create-queries": "query-builder"}},
+	}, 1)
+
+	permissions, diags := types.SetValueFrom(ctx, groupDatabasePermissionsObjectType, []GroupDatabasePermissions{{
+		Database:      types.Int64Unknown(),
+		DatabaseName:  types.StringValue("Warehouse"),
+		ViewData:      types.StringValue("unrestricted"),
+		CreateQueries: types.StringValue("query-builder"),
+		Download:      types.ObjectNull(accessPermissionsObjectType.AttrTypes),
+		DataModel:     types.ObjectNull(accessPermissionsObjectType.AttrTypes),
+		Details:       types.StringNull(),
+		Schemas:       types.SetNull(schemaPermissionsObjectType),
+	}})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	data := DatabasePermissionsResourceModel{
+		Id:                  types.Int64Unknown(),
+		GroupId:             types.Int64Value(3),
+		AdvancedPermissions: types.BoolNull(),
+		Permissions:         permissions,
+	}
+
+	diags = updateGroupDatabasePermissions(ctx, s.client(), &data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if len(*updates) != 1 {
+		t.Fatalf("Expected a single accepted update, got %v.", *updates)
+	}
+	update := (*updates)[0]
+	if update["revision"] != float64(2) {
+		t.Errorf("Expected the update to be retried with the latest revision, got %v.", update["revision"])
+	}
+	updatedGroups := update["groups"].(map[string]any)
+	if _, ok := updatedGroups["1"]; ok || len(updatedGroups) != 1 {
+		t.Errorf("Expected only group 3 to be updated, got %v.", updatedGroups)
+	}
+	removed := updatedGroups["3"].(map[string]any)["2"].(map[string]any)
+	if removed["create-queries"] != "no" {
+		t.Errorf("Expected the permissions on database 2 to be removed, got %v.", removed)
+	}
+
+	if data.Id != types.Int64Value(3) || len(data.Permissions.Elements()) != 1 {
+		t.Errorf("Unexpected model after the update: %v.", data)
+	}
+	var updatedPermissions []GroupDatabasePermissions
+	data.Permissions.ElementsAs(ctx, &updatedPermissions, false)
+	if updatedPermissions[0].Database != types.Int64Value(1) || updatedPermissions[0].DatabaseName != types.StringValue("Warehouse") {
+		t.Errorf("Expected the database name to be resolved and kept, got %v.", updatedPermissions[0])
+	}
+
+	// Deleting the resource removes all the permissions of the group.
+	data.Permissions = types.SetValueMust(groupDatabasePermissionsObjectType, []attr.Value{})
+	diags = updateGroupDatabasePermissions(ctx, s.client(), &data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(data.Permissions.Elements()) != 0 {
+		t.Errorf("Expected no permission left for the group, got %v.", data.Permissions)
+	}
+}
+
+func TestRetryOnPermissionsGraphConflict(t *testing.T) {
+	attempts := 0
+	diags := retryOnPermissionsGraphConflict(func() (bool, diag.Diagnostics) {
+		attempts++
+		var diags diag.Diagnostics
+		diags.AddError("Conflict.", "")
+		return true, diags
+	})
+
+	if attempts != permissionsGraphUpdateAttempts || !diags.HasError() {
+		t.Errorf("Expected %d attempts ending with an error, got %d and %v.", permissionsGraphUpdateAttempts, attempts, diags)
+	}
+}
+
+func TestAccDatabasePermissionsResource(t *testing.T) {
+	var groupId int
+	allUsersId := metabase.AllUsersPermissionsGroupId
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Destroying the graph and the group permissions leaves the permissions of other groups intact.
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckPermissionsGroupDestroy,
+			testAccCheckDatabaseCreateQueries(&allUsersId, 1, metabase.PermissionsGraphDatabasePermissionsCreateQueriesQueryBuilder),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccDatabasePermissionsResource("analysts", string(metabase.PermissionsGraphDatabasePermissionsCreateQueriesQueryBuilderAndNative)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreResourceId("metabase_permissions_group.analysts", &groupId),
+					resource.TestCheckResourceAttrPair("metabase_database_permissions.analysts", "id", "metabase_permissions_group.analysts", "id"),
+					resource.TestCheckResourceAttr("metabase_database_permissions.analysts", "permissions.0.database", "1"),
+					testAccCheckDatabaseCreateQueries(&groupId, 1, metabase.PermissionsGraphDatabasePermissionsCreateQueriesQueryBuilderAndNative),
+					testAccCheckDatabaseCreateQueries(&allUsersId, 1, metabase.PermissionsGraphDatabasePermissionsCreateQueriesQueryBuilder),
+				),
+			},
+			{
+				Config: providerApiKeyConfig + testAccDatabasePermissionsResource("analysts", string(metabase.PermissionsGraphDatabasePermissionsCreateQueriesQueryBuilder)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseCreateQueries(&groupId, 1, metabase.PermissionsGraphDatabasePermissionsCreateQueriesQueryBuilder),
+					testAccCheckDatabaseCreateQueries(&allUsersId, 1, metabase.PermissionsGraphDatabasePermissionsCreateQueriesQueryBuilder),
+				),
+			},
+			// Destroying the resource only revokes the permissions of the group.
+			{
+				Config: providerApiKeyConfig + testAccDatabasePermissionsResource("analysts", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseCreateQueries(&groupId, 1, metabase.PermissionsGraphDatabasePermissionsCreateQueriesNo),
+					testAccCheckDatabaseCreateQueries(&allUsersId, 1, metabase.PermissionsGraphDatabasePermissionsCreateQueriesQueryBuilder),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EmbedUrlDataSource{}
+var _ datasource.DataSourceWithConfigure = &EmbedUrlDataSource{}
+
+// Creates a new embed URL data source.
+func NewEmbedUrlDataSource() datasource.DataSource {
+	return &EmbedUrlDataSource{}
+}
+
+// A data source signing an embedding token for a card or dashboard, and returning the corresponding iframe URL.
+// The token is computed locally, the Metabase API is not called. The client is only used to determine the default site
+// URL.
+type EmbedUrlDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for an embed URL.
+type EmbedUrlDataSourceModel struct {
+	DashboardId types.Int64  `tfsdk:"dashboard_id"` // The ID of the embedded dashboard.
+	CardId      types.Int64  `tfsdk:"card_id"`      // The ID of the embedded card.
+	ParamsJson  types.String `tfsdk:"params_json"`  // The values of locked parameters, as a JSON object.
+	ExpiresAt   types.String `tfsdk:"expires_at"`   // The expiry of the token, as an RFC 3339 timestamp.
+	SecretKey   types.String `tfsdk:"secret_key"`   // The embedding secret key.
+	SiteUrl     types.String `tfsdk:"site_url"`     // The base URL of the Metabase instance.
+	Bordered    types.Bool   `tfsdk:"bordered"`     // Whether the embedded object has a border.
+	Titled      types.Bool   `tfsdk:"titled"`       // Whether the title of the embedded object is displayed.
+	Token       types.String `tfsdk:"token"`        // The signed token.
+	Url         types.String `tfsdk:"url"`          // The URL that can be used in an iframe.
+}
+
+func (d *EmbedUrlDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embed_url"
+}
+
+func (d *EmbedUrlDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A signed URL embedding a Metabase card (question) or dashboard in another application.
+
+The JSON Web Token (JWT) is signed locally using the embedding secret key, in the same way an application backend would sign it. The Metabase API is not called. The card or dashboard should have ` + "`enable_embedding`" + ` set, and parameters set in ` + "`params_json`" + ` should be ` + "`locked`" + ` in its ` + "`embedding_params`" + `.`,
+
+		Attributes: map[string]schema.Attribute{
+			"dashboard_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the embedded dashboard. Exactly one of `dashboard_id` or `card_id` should be set.",
+				Optional:            true,
+			},
+			"card_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the embedded card (question). Exactly one of `dashboard_id` or `card_id` should be set.",
+				Optional:            true,
+			},
+			"params_json": schema.StringAttribute{
+				MarkdownDescription: "The values of the locked parameters, as a JSON object keyed by parameter slug. Defaults to an empty object.",
+				Optional:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The time at which the token expires, as an RFC 3339 timestamp (e.g. using `timeadd(plantimestamp(), \"10m\")`). If not set, the token does not expire.",
+				Optional:            true,
+			},
+			"secret_key": schema.StringAttribute{
+				MarkdownDescription: "The embedding secret key, as found in the Metabase admin settings.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"site_url": schema.StringAttribute{
+				MarkdownDescription: "The base URL of the Metabase instance used in the returned URL. Defaults to the provider `endpoint` without the `/api` suffix.",
+				Optional:            true,
+			},
+			"bordered": schema.BoolAttribute{
+				MarkdownDescription: "Whether the embedded card or dashboard is displayed with a border. If not set, the Metabase default is used.",
+				Optional:            true,
+			},
+			"titled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the title of the embedded card or dashboard is displayed. If not set, the Metabase default is used.",
+				Optional:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The signed JSON Web Token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL that can be used as the source of an iframe.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (d *EmbedUrlDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase resource.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Signs the given claims as an HS256 JSON Web Token.
+func signEmbeddingToken(claims map[string]any, secretKey string) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	encoding := base64.RawURLEncoding
+	signingInput := encoding.EncodeToString(header) + "." + encoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write([]byte(signingInput))
+
+	return signingInput + "." + encoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Returns the kind and ID of the embedded object, and the claims of the token.
+func makeEmbeddingClaims(data EmbedUrlDataSourceModel) (sharedObjectKind, map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.DashboardId.IsNull() == data.CardId.IsNull() {
+		diags.AddAttributeError(
+			path.Root("dashboard_id"),
+			"Invalid embedded object.",
+			"Exactly one of dashboard_id or card_id should be set.",
+		)
+		return "", nil, diags
+	}
+
+	kind := sharedDashboard
+	id := data.DashboardId.ValueInt64()
+	if !data.CardId.IsNull() {
+		kind = sharedCard
+		id = data.CardId.ValueInt64()
+	}
+
+	// Metabase expects the params claim to be present, even when no parameter is locked.
+	params := map[string]any{}
+	if !data.ParamsJson.IsNull() {
+		err := json.Unmarshal([]byte(data.ParamsJson.ValueString()), &params)
+		if err != nil {
+			diags.AddAttributeError(path.Root("params_json"), "Failed to deserialize locked parameters.", err.Error())
+			return "", nil, diags
+		}
+	}
+
+	claims := map[string]any{
+		"resource": map[string]any{sharingPathSegments[kind]: id},
+		"params":   params,
+	}
+
+	if !data.ExpiresAt.IsNull() {
+		expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("expires_at"), "Failed to parse token expiry.", err.Error())
+			return "", nil, diags
+		}
+
+		claims["exp"] = expiresAt.Unix()
+	}
+
+	return kind, claims, diags
+}
+
+// Returns the fragment of the embed URL setting the appearance of the embedded object, or an empty string.
+func makeEmbedUrlFragment(data EmbedUrlDataSourceModel) string {
+	options := url.Values{}
+	if !data.Bordered.IsNull() {
+		options.Set("bordered", fmt.Sprint(data.Bordered.ValueBool()))
+	}
+	if !data.Titled.IsNull() {
+		options.Set("titled", fmt.Sprint(data.Titled.ValueBool()))
+	}
+
+	if len(options) == 0 {
+		return ""
+	}
+
+	return "#" + options.Encode()
+}
+
+func (d *EmbedUrlDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EmbedUrlDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kind, claims, diags := makeEmbeddingClaims(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := signEmbeddingToken(claims, data.SecretKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to sign embedding token.", err.Error())
+		return
+	}
+
+	siteUrl := strings.TrimSuffix(data.SiteUrl.ValueString(), "/")
+	if data.SiteUrl.IsNull() {
+		if d.client == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("site_url"), "Missing site URL.", "The site_url should be set when the provider is not configured.")
+			return
+		}
+
+		siteUrl = d.client.SiteUrl()
+	}
+
+	data.Token = types.StringValue(token)
+	data.Url = types.StringValue(fmt.Sprintf("%s/embed/%s/%s%s", siteUrl, sharingPathSegments[kind], token, makeEmbedUrlFragment(data)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSignEmbeddingToken(t *testing.T) {
+	data := EmbedUrlDataSourceModel{
+		DashboardId: types.Int64Value(12),
+		CardId:      types.Int64Null(),
+		ParamsJson:  types.StringValue(`{"customer_id":[42]}`),
+		ExpiresAt:   types.StringValue("2026-01-01T10:00:00Z"),
+		Bordered:    types.BoolValue(false),
+		Titled:      types.BoolNull(),
+	}
+
+	kind, claims, diags := makeEmbeddingClaims(data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if kind != sharedDashboard {
+		t.Errorf("Expected a dashboard to be embedded, got %q.", kind)
+	}
+
+	token, err := signEmbeddingToken(claims, "s3cr3t")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("Expected a token with 3 parts, got %q.", token)
+	}
+
+	mac := hmac.New(sha256.New, []byte("s3cr3t"))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if base64.RawURLEncoding.EncodeToString(mac.Sum(nil)) != parts[2] {
+		t.Errorf("Token signature does not match the secret key.")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotClaims map[string]any
+	if err := json.Unmarshal(payload, &gotClaims); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedClaims := map[string]any{
+		"resource": map[string]any{"dashboard": float64(12)},
+		"params":   map[string]any{"customer_id": []any{float64(42)}},
+		"exp":      float64(1767261600),
+	}
+	if !reflect.DeepEqual(gotClaims, expectedClaims) {
+		t.Errorf("Expected claims %v, got %v.", expectedClaims, gotClaims)
+	}
+
+	if fragment := makeEmbedUrlFragment(data); fragment != "#bordered=false" {
+		t.Errorf("Expected fragment #bordered=false, got %q.", fragment)
+	}
+}
+
+func TestMakeEmbeddingClaimsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data EmbedUrlDataSourceModel
+	}{
+		{
+			name: "no object",
+			data: EmbedUrlDataSourceModel{DashboardId: types.Int64Null(), CardId: types.Int64Null()},
+		},
+		{
+			name: "both objects",
+			data: EmbedUrlDataSourceModel{DashboardId: types.Int64Value(1), CardId: types.Int64Value(2)},
+		},
+		{
+			name: "invalid params",
+			data: EmbedUrlDataSourceModel{DashboardId: types.Int64Null(), CardId: types.Int64Value(2), ParamsJson: types.StringValue("[]")},
+		},
+		{
+			name: "invalid expiry",
+			data: EmbedUrlDataSourceModel{DashboardId: types.Int64Null(), CardId: types.Int64Value(2), ParamsJson: types.StringNull(), ExpiresAt: types.StringValue("tomorrow")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, diags := makeEmbeddingClaims(tt.data)
+			if !diags.HasError() {
+				t.Errorf("Expected an error.")
+			}
+		})
+	}
+}
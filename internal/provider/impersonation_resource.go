@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &ImpersonationResource{}
+var _ resource.ResourceWithValidateConfig = &ImpersonationResource{}
+
+// Creates a new impersonation resource.
+func NewImpersonationResource() resource.Resource {
+	return &ImpersonationResource{
+		MetabaseBaseResource{name: "impersonation"},
+	}
+}
+
+// A resource handling a Metabase connection impersonation, running the queries of a group on a database using a
+// database role set from a user attribute.
+type ImpersonationResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for an impersonation.
+type ImpersonationResourceModel struct {
+	Id         types.Int64  `tfsdk:"id"`          // The ID of the impersonation.
+	GroupId    types.Int64  `tfsdk:"group_id"`    // The ID of the impersonated group.
+	DatabaseId types.Int64  `tfsdk:"database_id"` // The ID of the database.
+	Attribute  types.String `tfsdk:"attribute"`   // The user attribute containing the database role.
+
+	RevokeViewDataTo types.String `tfsdk:"revoke_view_data_to"` // The view data permission set when destroying the resource.
+}
+
+// The view data permission set when destroying an impersonation, if `revoke_view_data_to` is not set.
+const defaultRevokedImpersonationViewData = metabase.PermissionsGraphDatabasePermissionsViewData0Blocked
+
+func (r *ImpersonationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A Metabase connection impersonation, running the queries of members of a group on a database using the database role set in one of their user attributes. This requires a Pro or Enterprise edition of Metabase.
+
+The view data permission of the group on the database is set to ` + "`impersonated`" + ` when the impersonation is created. Impersonated permissions which are not part of the configuration of the ` + "`metabase_permissions_graph`" + ` and ` + "`metabase_database_permissions`" + ` resources are not reported by them, such that the other permissions of the group on the database can be managed separately. Destroying the resource deletes the impersonation, and sets the view data permission of the group on the database to ` + "`revoke_view_data_to`" + `.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the impersonation.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the impersonated group.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"database_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the database on which queries are run using the role.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"attribute": schema.StringAttribute{
+				MarkdownDescription: "The user attribute containing the database role used to run the queries.",
+				Required:            true,
+			},
+			"revoke_view_data_to": schema.StringAttribute{
+				MarkdownDescription: "The view data permission of the group on the database set when destroying the resource, either `blocked` or `unrestricted`. Defaults to `blocked`, in which case the group can no longer create queries on the database either.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ImpersonationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ImpersonationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RevokeViewDataTo.IsNull() || data.RevokeViewDataTo.IsUnknown() {
+		return
+	}
+
+	switch metabase.PermissionsGraphDatabasePermissionsViewData0(data.RevokeViewDataTo.ValueString()) {
+	case metabase.PermissionsGraphDatabasePermissionsViewData0Blocked, metabase.PermissionsGraphDatabasePermissionsViewData0Unrestricted:
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("revoke_view_data_to"),
+			"Unsupported view data permission.",
+			fmt.Sprintf("Expected `blocked` or `unrestricted`, got `%s`.", data.RevokeViewDataTo.ValueString()),
+		)
+	}
+}
+
+// Updates the given `ImpersonationResourceModel` from the `ConnectionImpersonation` returned by the Metabase API.
+func updateModelFromImpersonation(i metabase.ConnectionImpersonation, data *ImpersonationResourceModel) {
+	data.Id = int64ValueOrNull(i.Id)
+	data.GroupId = types.Int64Value(int64(i.GroupId))
+	data.DatabaseId = types.Int64Value(int64(i.DbId))
+	data.Attribute = types.StringValue(i.Attribute)
+}
+
+// Finds the impersonation in the model, using its group and database if they are known, or its ID otherwise (e.g. when
+// importing the resource). Returns nil if the impersonation does not exist.
+func findImpersonation(ctx context.Context, client *metabase.ClientWithResponses, data ImpersonationResourceModel) (*metabase.ConnectionImpersonation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := metabase.ListImpersonationsParams{
+		GroupId: valueInt64OrNull(data.GroupId),
+		DbId:    valueInt64OrNull(data.DatabaseId),
+	}
+	listResp, err := client.ListImpersonationsWithResponse(ctx, &params)
+
+	diags.Append(checkMetabaseResponse(listResp, err, []int{200}, "list impersonations")...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, i := range *listResp.JSON200 {
+		if params.GroupId != nil && params.DbId != nil {
+			if i.GroupId == *params.GroupId && i.DbId == *params.DbId {
+				return &i, diags
+			}
+		} else if i.Id != nil && int64(*i.Id) == data.Id.ValueInt64() {
+			return &i, diags
+		}
+	}
+
+	return nil, diags
+}
+
+// Replaces the permissions of the group on the database in the permissions graph, along with the given impersonations.
+// Impersonations can only be created using the permissions graph, such that the update is retried if the graph is
+// modified concurrently.
+func replaceImpersonationPermissions(ctx context.Context, client *metabase.ClientWithResponses, groupId int, databaseId int, permissions metabase.PermissionsGraphDatabasePermissions, impersonations *[]metabase.ConnectionImpersonation) diag.Diagnostics {
+	return retryOnPermissionsGraphConflict(func() (bool, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		getResp, err := client.GetPermissionsGraphWithResponse(ctx)
+		diags.Append(checkMetabaseResponse(getResp, err, []int{200}, "get permissions graph")...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		updateResp, err := client.ReplacePermissionsGraphWithResponse(ctx, metabase.PermissionsGraph{
+			Revision: getResp.JSON200.Revision,
+			Groups: map[string]metabase.PermissionsGraphDatabasePermissionsMap{
+				strconv.Itoa(groupId): {
+					strconv.Itoa(databaseId): permissions,
+				},
+			},
+			Impersonations: impersonations,
+		})
+		diags.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update permissions graph")...)
+
+		return err == nil && updateResp.StatusCode() == http.StatusConflict, diags
+	})
+}
+
+// Sets the view data permission of the group on the database to impersonated, and creates or updates the
+// impersonation along with it.
+func updateImpersonation(ctx context.Context, client *metabase.ClientWithResponses, data *ImpersonationResourceModel) diag.Diagnostics {
+	groupId := int(data.GroupId.ValueInt64())
+	databaseId := int(data.DatabaseId.ValueInt64())
+
+	var viewData metabase.PermissionsGraphDatabasePermissions_ViewData
+	if err := viewData.FromPermissionsGraphDatabasePermissionsViewData0(metabase.PermissionsGraphDatabasePermissionsViewData0Impersonated); err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Unexpected error setting view data permission.", err.Error())
+		return diags
+	}
+
+	// Only the view data permission is sent, other permissions of the group on the database are left untouched.
+	diags := replaceImpersonationPermissions(ctx, client, groupId, databaseId, metabase.PermissionsGraphDatabasePermissions{ViewData: viewData}, &[]metabase.ConnectionImpersonation{{
+		Id:        valueInt64OrNull(data.Id),
+		GroupId:   groupId,
+		DbId:      databaseId,
+		Attribute: data.Attribute.ValueString(),
+	}})
+	if diags.HasError() {
+		return diags
+	}
+
+	impersonation, findDiags := findImpersonation(ctx, client, *data)
+	diags.Append(findDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if impersonation == nil {
+		diags.AddError("Impersonation not found after updating the permissions graph.", "Metabase may not support connection impersonation on this database or edition.")
+		return diags
+	}
+
+	updateModelFromImpersonation(*impersonation, data)
+
+	return diags
+}
+
+// Sets the view data permission of the group on the database to `revoke_view_data_to`, such that the group no longer
+// has impersonated access to the database.
+func revokeImpersonation(ctx context.Context, client *metabase.ClientWithResponses, data ImpersonationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	revokedViewData := defaultRevokedImpersonationViewData
+	if !data.RevokeViewDataTo.IsNull() {
+		revokedViewData = metabase.PermissionsGraphDatabasePermissionsViewData0(data.RevokeViewDataTo.ValueString())
+	}
+
+	var permissions metabase.PermissionsGraphDatabasePermissions
+	if err := permissions.ViewData.FromPermissionsGraphDatabasePermissionsViewData0(revokedViewData); err != nil {
+		diags.AddError("Unexpected error setting view data permission.", err.Error())
+		return diags
+	}
+
+	// Queries cannot be created on a database the group is blocked from.
+	if revokedViewData == metabase.PermissionsGraphDatabasePermissionsViewData0Blocked {
+		permissions.CreateQueries = &metabase.PermissionsGraphDatabasePermissions_CreateQueries{}
+		if err := permissions.CreateQueries.FromPermissionsGraphDatabasePermissionsCreateQueries(metabase.PermissionsGraphDatabasePermissionsCreateQueriesNo); err != nil {
+			diags.AddError("Unexpected error setting create queries permission.", err.Error())
+			return diags
+		}
+	}
+
+	return replaceImpersonationPermissions(ctx, client, int(data.GroupId.ValueInt64()), int(data.DatabaseId.ValueInt64()), permissions, nil)
+}
+
+func (r *ImpersonationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ImpersonationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID is unknown, and an existing impersonation of the group on the database is updated by Metabase.
+	data.Id = types.Int64Null()
+
+	resp.Diagnostics.Append(updateImpersonation(ctx, r.client, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImpersonationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ImpersonationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	impersonation, diags := findImpersonation(ctx, r.client, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Metabase deletes impersonations when the view data permission of the group is no longer impersonated.
+	if impersonation == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	updateModelFromImpersonation(*impersonation, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImpersonationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ImpersonationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateImpersonation(ctx, r.client, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImpersonationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ImpersonationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(revokeImpersonation(ctx, r.client, *data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Metabase usually deletes the impersonation along with the impersonated permission, in which case it is not found.
+	deleteResp, err := r.client.DeleteImpersonationWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(deleteResp, err, []int{204, 404}, "delete impersonation")...)
+}
+
+func (r *ImpersonationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIntegerId(ctx, req, resp)
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Registers the impersonation endpoints on the stand-in server, listing the impersonations sent in the accepted updates
+// of the permissions graph.
+func serveStandInImpersonations(s *metabaseStandIn, updates *[]map[string]any) {
+	s.mux.HandleFunc("GET /api/ee/advanced-permissions/impersonation", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		impersonations := []map[string]any{}
+		for _, update := range *updates {
+			// Revoking impersonated permissions deletes the impersonations.
+			if update["impersonations"] == nil {
+				impersonations = []map[string]any{}
+				continue
+			}
+
+			for _, i := range update["impersonations"].([]any) {
+				impersonation := i.(map[string]any)
+				impersonation["id"] = 4
+				impersonations = append(impersonations, impersonation)
+			}
+		}
+
+		writeStandInJson(w, http.StatusOK, impersonations)
+	})
+}
+
+func TestUpdateImpersonationStandIn(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	updates := serveStandInPermissionsGraph(s, "/permissions/graph", map[string]map[string]any{
+		"3": {"1": map[string]any{"view-data": "unrestricted", "create-queries": "query-builder-and-native"}},
+	}, 1)
+	serveStandInImpersonations(s, updates)
+
+	data := ImpersonationResourceModel{
+		Id:         types.Int64Null(),
+		GroupId:    types.Int64Value(3),
+		DatabaseId: types.Int64Value(1),
+		Attribute:  types.StringValue("db_role"),
+	}
+
+	diags := updateImpersonation(ctx, s.client(), &data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if len(*updates) != 1 {
+		t.Fatalf("Expected a single accepted update, got %v.", *updates)
+	}
+	update := (*updates)[0]
+	if update["revision"] != float64(2) {
+		t.Errorf("Expected the update to be retried with the latest revision, got %v.", update["revision"])
+	}
+	permissions := update["groups"].(map[string]any)["3"].(map[string]any)["1"].(map[string]any)
+	if permissions["view-data"] != "impersonated" || len(permissions) != 1 {
+		t.Errorf("Expected only the view data permission to be updated, got %v.", permissions)
+	}
+	impersonation := update["impersonations"].([]any)[0].(map[string]any)
+	if impersonation["group_id"] != float64(3) || impersonation["db_id"] != float64(1) || impersonation["attribute"] != "db_role" {
+		t.Errorf("Unexpected impersonation %v.", impersonation)
+	}
+
+	if data.Id != types.Int64Value(4) {
+		t.Errorf("Expected the ID of the impersonation to be set, got %v.", data.Id)
+	}
+}
+
+func TestRevokeImpersonationStandIn(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	updates := serveStandInPermissionsGraph(s, "/permissions/graph", map[string]map[string]any{
+		"3": {"1": map[string]any{"view-data": "impersonated", "create-queries": "query-builder-and-native"}},
+	}, 1)
+
+	data := ImpersonationResourceModel{
+		Id:               types.Int64Value(4),
+		GroupId:          types.Int64Value(3),
+		DatabaseId:       types.Int64Value(1),
+		Attribute:        types.StringValue("db_role"),
+		RevokeViewDataTo: types.StringNull(),
+	}
+
+	diags := revokeImpersonation(ctx, s.client(), data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if len(*updates) != 1 {
+		t.Fatalf("Expected a single accepted update, got %v.", *updates)
+	}
+	permissions := (*updates)[0]["groups"].(map[string]any)["3"].(map[string]any)["1"].(map[string]any)
+	if permissions["view-data"] != "blocked" || permissions["create-queries"] != "no" {
+		t.Errorf("Expected the group to be blocked by default, got %v.", permissions)
+	}
+
+	data.RevokeViewDataTo = types.StringValue("unrestricted")
+	diags = revokeImpersonation(ctx, s.client(), data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	permissions = (*updates)[1]["groups"].(map[string]any)["3"].(map[string]any)["1"].(map[string]any)
+	if permissions["view-data"] != "unrestricted" || len(permissions) != 1 {
+		t.Errorf("Expected only the view data permission to be updated, got %v.", permissions)
+	}
+}
+
+func TestUpdateModelFromPermissionsGraphIgnoresImpersonated(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	s.serveJson("GET", "/ee/advanced-permissions/impersonation", []map[string]any{
+		{"id": 4, "group_id": 3, "db_id": 1, "attribute": "db_role"},
+	})
+	names := newPermissionsGraphNames(s.client())
+
+	graph := metabase.PermissionsGraph{
+		Revision: 1,
+		Groups: map[string]metabase.PermissionsGraphDatabasePermissionsMap{
+			"3": {
+				"1": parseDatabasePermissions(t, `{"view-data": "impersonated", "create-queries": "query-builder-and-native"}`),
+				"2": parseDatabasePermissions(t, `{"view-data": "unrestricted", "create-queries": "query-builder"}`),
+				// The impersonation was deleted without revoking the permission.
+				"3": parseDatabasePermissions(t, `{"view-data": "impersonated", "create-queries": "query-builder"}`),
+			},
+		},
+	}
+
+	data := PermissionsGraphResourceModel{
+		IgnoredGroups: types.SetValueMust(types.Int64Type, []attr.Value{}),
+		Permissions:   types.SetValueMust(databasePermissionsObjectType, []attr.Value{}),
+	}
+	diags := updateModelFromPermissionsGraph(ctx, names, graph, &data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var permissions []DatabasePermissions
+	data.Permissions.ElementsAs(ctx, &permissions, false)
+	if len(permissions) != 2 || slices.ContainsFunc(permissions, func(p DatabasePermissions) bool { return p.Database == types.Int64Value(1) }) {
+		t.Errorf("Expected only the permissions with an impersonation to be ignored, got %v.", permissions)
+	}
+
+	// Impersonated permissions are reported when they are part of the model.
+	impersonated := makeGranularDatabasePermissions(t, types.StringValue("impersonated"))
+	impersonated.CreateQueries = types.StringValue("query-builder-and-native")
+	data.Permissions, diags = types.SetValueFrom(ctx, databasePermissionsObjectType, []DatabasePermissions{impersonated})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	diags = updateModelFromPermissionsGraph(ctx, names, graph, &data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(data.Permissions.Elements()) != 3 {
+		t.Errorf("Expected the impersonated permissions in the model to be reported, got %v.", data.Permissions)
+	}
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+)
+
+// A minimal, in-memory stand-in for the Metabase API. It is only meant for what the Metabase instance used by acceptance
+// tests cannot provide: features of the Pro and Enterprise editions, integrations which are not set up (e.g. Slack or
+// email), older versions of Metabase, and error responses (e.g. conflicts). Other features should be tested against
+// Metabase in `TestAcc*` tests. Endpoints are registered by each test, and all requests are recorded.
+type metabaseStandIn struct {
+	t        *testing.T
+	server   *httptest.Server
+	mux      *http.ServeMux
+	mu       sync.Mutex
+	requests []string // The list of requests received by the server, as "METHOD /path" strings.
+}
+
+// Starts a new stand-in server, which is closed when the test ends.
+func newMetabaseStandIn(t *testing.T) *metabaseStandIn {
+	s := &metabaseStandIn{
+		t:   t,
+		mux: http.NewServeMux(),
+	}
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+		s.mu.Unlock()
+
+		s.mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// Returns the endpoint of the stand-in API, as it should be configured in the provider.
+func (s *metabaseStandIn) endpoint() string {
+	return s.server.URL + "/api"
+}
+
+// Returns the provider configuration pointing to the stand-in API.
+func (s *metabaseStandIn) providerConfig() string {
+	return fmt.Sprintf(`
+provider "metabase" {
+  endpoint = "%s"
+  api_key  = "stand-in"
+}
+`, s.endpoint())
+}
+
+// Returns an API client pointing to the stand-in API.
+func (s *metabaseStandIn) client() *metabase.ClientWithResponses {
+	client, err := metabase.MakeAuthenticatedClientWithApiKey(context.Background(), s.endpoint(), "stand-in")
+	if err != nil {
+		s.t.Fatal(err)
+	}
+
+	return client
+}
+
+// Returns the requests received so far by the server.
+func (s *metabaseStandIn) receivedRequests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+// Writes the given value as a JSON response.
+func writeStandInJson(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// Registers a handler returning a fixed JSON value for the given method and API path (e.g. `/dashboard/1`).
+func (s *metabaseStandIn) serveJson(method string, apiPath string, value any) {
+	s.mux.HandleFunc(fmt.Sprintf("%s /api%s", method, apiPath), func(w http.ResponseWriter, r *http.Request) {
+		writeStandInJson(w, http.StatusOK, value)
+	})
+}
+
+// Registers create, get, update, and delete endpoints for an in-memory collection of objects, under the given API
+// path (e.g. `/pulse`). IDs are assigned incrementally starting from 1. Updates are merged into the existing object.
+// The returned map can be used to inspect or modify the objects.
+func (s *metabaseStandIn) serveObjects(apiPath string) map[int]map[string]any {
+	objects := make(map[int]map[string]any)
+	nextId := 1
+
+	readObject := func(r *http.Request) (map[string]any, error) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		var object map[string]any
+		err = json.Unmarshal(body, &object)
+		return object, err
+	}
+
+	withObject := func(handler func(w http.ResponseWriter, r *http.Request, id int, object map[string]any)) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			id, err := strconv.Atoi(r.PathValue("id"))
+			if err != nil {
+				writeStandInJson(w, http.StatusBadRequest, map[string]any{"message": err.Error()})
+				return
+			}
+
+			object, ok := objects[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte("Not found."))
+				return
+			}
+
+			handler(w, r, id, object)
+		}
+	}
+
+	s.mux.HandleFunc(fmt.Sprintf("POST /api%s", apiPath), func(w http.ResponseWriter, r *http.Request) {
+		object, err := readObject(r)
+		if err != nil {
+			writeStandInJson(w, http.StatusBadRequest, map[string]any{"message": err.Error()})
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		object["id"] = nextId
+		if _, ok := object["archived"]; !ok {
+			object["archived"] = false
+		}
+		objects[nextId] = object
+		nextId++
+
+		writeStandInJson(w, http.StatusOK, object)
+	})
+
+	s.mux.HandleFunc(fmt.Sprintf("GET /api%s/{id}", apiPath), withObject(func(w http.ResponseWriter, r *http.Request, id int, object map[string]any) {
+		writeStandInJson(w, http.StatusOK, object)
+	}))
+
+	s.mux.HandleFunc(fmt.Sprintf("PUT /api%s/{id}", apiPath), withObject(func(w http.ResponseWriter, r *http.Request, id int, object map[string]any) {
+		update, err := readObject(r)
+		if err != nil {
+			writeStandInJson(w, http.StatusBadRequest, map[string]any{"message": err.Error()})
+			return
+		}
+
+		for k, v := range update {
+			object[k] = v
+		}
+		object["id"] = id
+
+		writeStandInJson(w, http.StatusOK, object)
+	}))
+
+	s.mux.HandleFunc(fmt.Sprintf("DELETE /api%s/{id}", apiPath), withObject(func(w http.ResponseWriter, r *http.Request, id int, object map[string]any) {
+		delete(objects, id)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	return objects
+}
+
+// Skips the test if no Terraform binary is available. Tests using the stand-in server do not require a Metabase
+// instance, but running Terraform steps still requires the CLI.
+func skipWithoutTerraformBinary(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("Terraform CLI not found, set TF_ACC_TERRAFORM_PATH or add terraform to the PATH to run this test.")
+	}
+}
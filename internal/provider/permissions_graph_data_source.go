@@ -53,7 +53,7 @@ Unlike the resource, this data source only reads the permissions graph and does
 			},
 			"ignored_groups": schema.SetAttribute{
 				ElementType:         types.Int64Type,
-				MarkdownDescription: "The list of group IDs that should be ignored when reading permissions. By default, this contains the Administrators group (`[2]`).",
+				MarkdownDescription: "The list of group IDs that should be ignored when reading permissions. By default, this contains the Administrators group, found using its type.",
 				Optional:            true,
 			},
 			"permissions": schema.SetNestedAttribute{
@@ -65,16 +65,24 @@ Unlike the resource, this data source only reads the permissions graph and does
 							MarkdownDescription: "The ID of the group to which the permission applies.",
 							Computed:            true,
 						},
+						"group_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the group to which the permission applies.",
+							Computed:            true,
+						},
 						"database": schema.Int64Attribute{
 							MarkdownDescription: "The ID of the database to which the permission applies.",
 							Computed:            true,
 						},
+						"database_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the database to which the permission applies.",
+							Computed:            true,
+						},
 						"view_data": schema.StringAttribute{
-							MarkdownDescription: "The permission definition for data access.",
+							MarkdownDescription: "The permission definition for data access on the entire database, unless it differs between schemas.",
 							Computed:            true,
 						},
 						"create_queries": schema.StringAttribute{
-							MarkdownDescription: "The permission definition for creating queries.",
+							MarkdownDescription: "The permission definition for creating queries on the entire database, unless it differs between schemas.",
 							Computed:            true,
 						},
 						"download": schema.SingleNestedAttribute{
@@ -101,6 +109,62 @@ Unlike the resource, this data source only reads the permissions graph and does
 							MarkdownDescription: "The permission definition for accessing details.",
 							Computed:            true,
 						},
+						"schema": schema.SetNestedAttribute{
+							MarkdownDescription: "Granular permissions for each schema of the database, for the kinds of permission which are not the same for the entire database.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The name of the schema.",
+										Computed:            true,
+									},
+									"view_data": schema.StringAttribute{
+										MarkdownDescription: "The permission definition for data access on the entire schema.",
+										Computed:            true,
+									},
+									"create_queries": schema.StringAttribute{
+										MarkdownDescription: "The permission definition for creating queries on the entire schema.",
+										Computed:            true,
+									},
+									"download": schema.StringAttribute{
+										MarkdownDescription: "The permission definition for downloading data from the entire schema.",
+										Computed:            true,
+									},
+									"data_model": schema.StringAttribute{
+										MarkdownDescription: "The permission definition for accessing the data model of the entire schema.",
+										Computed:            true,
+									},
+									"table": schema.SetNestedAttribute{
+										MarkdownDescription: "Permissions for single tables, for the kinds of permission which are not the same for the entire schema.",
+										Computed:            true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"id": schema.Int64Attribute{
+													MarkdownDescription: "The ID of the table.",
+													Computed:            true,
+												},
+												"view_data": schema.StringAttribute{
+													MarkdownDescription: "The permission definition for data access.",
+													Computed:            true,
+												},
+												"create_queries": schema.StringAttribute{
+													MarkdownDescription: "The permission definition for creating queries.",
+													Computed:            true,
+												},
+												"download": schema.StringAttribute{
+													MarkdownDescription: "The permission definition for downloading data.",
+													Computed:            true,
+												},
+												"data_model": schema.StringAttribute{
+													MarkdownDescription: "The permission definition for accessing the data model.",
+													Computed:            true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -128,48 +192,21 @@ func (d *PermissionsGraphDataSource) Configure(ctx context.Context, req datasour
 }
 
 // Makes a single `DatabasePermissions` Terraform object from a Metabase API's response for the data source.
-func makeDataSourcePermissionsObjectFromDatabasePermissions(ctx context.Context, groupId int, dbId int, p metabase.PermissionsGraphDatabasePermissions) (*types.Object, diag.Diagnostics) {
+func makeDataSourcePermissionsObjectFromDatabasePermissions(ctx context.Context, groupId int, groupName *string, dbId int, databaseName *string, p metabase.PermissionsGraphDatabasePermissions) (*types.Object, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	createQueries := metabase.PermissionsGraphDatabasePermissionsCreateQueriesNo
-	if p.CreateQueries != nil {
-		createQueries = *p.CreateQueries
-	}
-
-	downloadAccess, accessDiags := makeAccessPermissionsFromDatabaseAccess(ctx, p.Download)
-	diags.Append(accessDiags...)
-	if diags.HasError() {
-		return nil, diags
-	}
-
-	dataModelAccess, accessDiags := makeAccessPermissionsFromDatabaseAccess(ctx, p.DataModel)
-	diags.Append(accessDiags...)
+	permissions, permissionsDiags := makeDatabasePermissionsFromApi(ctx, p, nil)
+	diags.Append(permissionsDiags...)
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	var viewData string
-	if viewDataString, err := p.ViewData.AsPermissionsGraphDatabasePermissionsViewData0(); err == nil {
-		viewData = string(viewDataString)
-	} else {
-		viewDataObject, err := p.ViewData.AsPermissionsGraphDatabasePermissionsViewData1()
-		if err != nil {
-			diags.AddError("Unexpected permissions value.", err.Error())
-			return nil, diags
-		}
-		// For the data source, we'll just use a string representation
-		viewData = fmt.Sprintf("%v", viewDataObject)
-	}
+	permissions.Group = types.Int64Value(int64(groupId))
+	permissions.GroupName = stringValueOrNull(groupName)
+	permissions.Database = types.Int64Value(int64(dbId))
+	permissions.DatabaseName = stringValueOrNull(databaseName)
 
-	permissionsObject, objectDiags := types.ObjectValueFrom(ctx, databasePermissionsObjectType.AttrTypes, DatabasePermissions{
-		Group:         types.Int64Value(int64(groupId)),
-		Database:      types.Int64Value(int64(dbId)),
-		ViewData:      types.StringValue(viewData),
-		CreateQueries: types.StringValue(string(createQueries)),
-		Download:      *downloadAccess,
-		DataModel:     *dataModelAccess,
-		Details:       stringValueOrNull(p.Details),
-	})
+	permissionsObject, objectDiags := types.ObjectValueFrom(ctx, databasePermissionsObjectType.AttrTypes, permissions)
 	diags.Append(objectDiags...)
 	if diags.HasError() {
 		return nil, diags
@@ -179,12 +216,12 @@ func makeDataSourcePermissionsObjectFromDatabasePermissions(ctx context.Context,
 }
 
 // Updates the given `PermissionsGraphDataSourceModel` from the `PermissionsGraph` returned by the Metabase API.
-func updateDataSourceModelFromPermissionsGraph(ctx context.Context, g metabase.PermissionsGraph, data *PermissionsGraphDataSourceModel) diag.Diagnostics {
+func updateDataSourceModelFromPermissionsGraph(ctx context.Context, names *permissionsGraphNames, g metabase.PermissionsGraph, data *PermissionsGraphDataSourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	data.Revision = types.Int64Value(int64(g.Revision))
 
-	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, data.IgnoredGroups)
+	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, names, data.IgnoredGroups)
 	diags.Append(groupsDiags...)
 	if diags.HasError() {
 		return diags
@@ -203,6 +240,12 @@ func updateDataSourceModelFromPermissionsGraph(ctx context.Context, g metabase.P
 			return diags
 		}
 
+		groupName, nameDiags := names.groupName(ctx, groupIdInt)
+		diags.Append(nameDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
 		for dbId, dbPermissions := range dbPermissionsMap {
 			// Ignore the Metabase Analytics database until we have proper support.
 			if dbId == metabase.MetabaseAnalyticsDatabaseId {
@@ -215,7 +258,13 @@ func updateDataSourceModelFromPermissionsGraph(ctx context.Context, g metabase.P
 				return diags
 			}
 
-			permissionsObject, objDiags := makeDataSourcePermissionsObjectFromDatabasePermissions(ctx, groupIdInt, dbIdInt, dbPermissions)
+			databaseName, nameDiags := names.databaseName(ctx, dbIdInt)
+			diags.Append(nameDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			permissionsObject, objDiags := makeDataSourcePermissionsObjectFromDatabasePermissions(ctx, groupIdInt, groupName, dbIdInt, databaseName, dbPermissions)
 			diags.Append(objDiags...)
 			if diags.HasError() {
 				return diags
@@ -251,7 +300,7 @@ func (d *PermissionsGraphDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	resp.Diagnostics.Append(updateDataSourceModelFromPermissionsGraph(ctx, *getResp.JSON200, &data)...)
+	resp.Diagnostics.Append(updateDataSourceModelFromPermissionsGraph(ctx, newPermissionsGraphNames(d.client), *getResp.JSON200, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
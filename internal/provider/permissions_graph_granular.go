@@ -0,0 +1,530 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The model for the permissions of a group on a single schema of a database.
+type SchemaPermissions struct {
+	Name          types.String `tfsdk:"name"`           // The name of the schema.
+	ViewData      types.String `tfsdk:"view_data"`      // View data access permission for the entire schema.
+	CreateQueries types.String `tfsdk:"create_queries"` // Create queries access permission for the entire schema.
+	Download      types.String `tfsdk:"download"`       // Download permission for the entire schema.
+	DataModel     types.String `tfsdk:"data_model"`     // Data model permission for the entire schema.
+	Tables        types.Set    `tfsdk:"table"`          // Permissions for single tables in the schema.
+}
+
+// The model for the permissions of a group on a single table.
+type TablePermissions struct {
+	Id            types.Int64  `tfsdk:"id"`             // The ID of the table.
+	ViewData      types.String `tfsdk:"view_data"`      // View data access permission.
+	CreateQueries types.String `tfsdk:"create_queries"` // Create queries access permission.
+	Download      types.String `tfsdk:"download"`       // Download permission.
+	DataModel     types.String `tfsdk:"data_model"`     // Data model permission.
+}
+
+// The object type definition for the `TablePermissions` model.
+var tablePermissionsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":             types.Int64Type,
+		"view_data":      types.StringType,
+		"create_queries": types.StringType,
+		"download":       types.StringType,
+		"data_model":     types.StringType,
+	},
+}
+
+// The object type definition for the `SchemaPermissions` model.
+var schemaPermissionsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":           types.StringType,
+		"view_data":      types.StringType,
+		"create_queries": types.StringType,
+		"download":       types.StringType,
+		"data_model":     types.StringType,
+		"table":          types.SetType{ElemType: tablePermissionsObjectType},
+	},
+}
+
+// The schema for the `SchemaPermissions` model.
+var schemaPermissionsAttribute = schema.SetNestedAttribute{
+	MarkdownDescription: "Granular permissions for each schema of the database, used for the kinds of permission which are not set for the entire database. A schema should appear only once in the list.",
+	Optional:            true,
+	NestedObject: schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the schema. This is an empty string for databases without schemas.",
+				Required:            true,
+			},
+			"view_data": schema.StringAttribute{
+				MarkdownDescription: "The permission definition for data access on the entire schema.",
+				Optional:            true,
+			},
+			"create_queries": schema.StringAttribute{
+				MarkdownDescription: "The permission definition for creating queries on the entire schema.",
+				Optional:            true,
+			},
+			"download": schema.StringAttribute{
+				MarkdownDescription: "The permission definition for downloading data from the entire schema.",
+				Optional:            true,
+			},
+			"data_model": schema.StringAttribute{
+				MarkdownDescription: "The permission definition for accessing the data model of the entire schema.",
+				Optional:            true,
+			},
+			"table": schema.SetNestedAttribute{
+				MarkdownDescription: "Permissions for single tables, used for the kinds of permission which are not set for the entire schema. A table should appear only once in the list.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the table.",
+							Required:            true,
+						},
+						"view_data": schema.StringAttribute{
+							MarkdownDescription: "The permission definition for data access.",
+							Optional:            true,
+						},
+						"create_queries": schema.StringAttribute{
+							MarkdownDescription: "The permission definition for creating queries.",
+							Optional:            true,
+						},
+						"download": schema.StringAttribute{
+							MarkdownDescription: "The permission definition for downloading data.",
+							Optional:            true,
+						},
+						"data_model": schema.StringAttribute{
+							MarkdownDescription: "The permission definition for accessing the data model.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// A single kind of permission (e.g. view data) of a group on a database. It is either a single value for the entire
+// database, or a map of values (or nested maps of tables) for each schema.
+type granularPermission struct {
+	value    string                         // The permission, when it is the same for the entire database, schema or table.
+	children map[string]*granularPermission // The permissions for each schema or table, when they differ.
+}
+
+// The kinds of permission which can be set granularly, in the order of the fields of the models.
+const (
+	viewDataPermission = iota
+	createQueriesPermission
+	downloadPermission
+	dataModelPermission
+	granularPermissionKinds
+)
+
+// The names of the attributes for each kind of permission.
+var granularPermissionAttributes = [granularPermissionKinds]string{"view_data", "create_queries", "download", "data_model"}
+
+// Returns the permission values of a schema model, indexed by kind.
+func (p SchemaPermissions) values() [granularPermissionKinds]types.String {
+	return [granularPermissionKinds]types.String{p.ViewData, p.CreateQueries, p.Download, p.DataModel}
+}
+
+// Returns the permission values of a table model, indexed by kind.
+func (p TablePermissions) values() [granularPermissionKinds]types.String {
+	return [granularPermissionKinds]types.String{p.ViewData, p.CreateQueries, p.Download, p.DataModel}
+}
+
+// Parses a permission from the JSON returned by the Metabase API, which is either a string or a nested map. `null` is
+// returned as a nil permission.
+func parseGranularPermission(data []byte) (*granularPermission, error) {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	return makeGranularPermission(value)
+}
+
+// Makes a permission from a value decoded from JSON.
+func makeGranularPermission(value any) (*granularPermission, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return &granularPermission{value: v}, nil
+	case map[string]any:
+		p := &granularPermission{children: make(map[string]*granularPermission, len(v))}
+		for key, childValue := range v {
+			child, err := makeGranularPermission(childValue)
+			if err != nil {
+				return nil, err
+			}
+			if child != nil {
+				p.children[key] = child
+			}
+		}
+		return p, nil
+	default:
+		return nil, fmt.Errorf("unexpected permission value: %v", value)
+	}
+}
+
+// Returns the value to send to the Metabase API, which is either a string or a nested map.
+func (p *granularPermission) jsonValue() any {
+	if p.children == nil {
+		return p.value
+	}
+
+	value := make(map[string]any, len(p.children))
+	for key, child := range p.children {
+		value[key] = child.jsonValue()
+	}
+
+	return value
+}
+
+// Returns the JSON to send to the Metabase API, which can be unmarshaled into one of the union types of the client.
+func (p *granularPermission) marshal() ([]byte, error) {
+	return json.Marshal(p.jsonValue())
+}
+
+// Returns the value which applies to the given schema and table, or an empty string if the permission is not set for
+// them.
+func (p *granularPermission) valueFor(schema string, tableId string) string {
+	for _, key := range []string{schema, tableId} {
+		if p == nil || p.children == nil {
+			break
+		}
+		p = p.children[key]
+	}
+
+	if p == nil || p.children != nil {
+		return ""
+	}
+
+	return p.value
+}
+
+// Returns whether all the values in the permission are equal to `value`.
+func (p *granularPermission) isUniform(value string) bool {
+	if p.children == nil {
+		return p.value == value
+	}
+
+	for _, child := range p.children {
+		if !child.isUniform(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns whether every value in `p` is granted by `other`, either because `other` defines the same value for a schema
+// or table, or because it defines it for the entire parent schema or database.
+func (p *granularPermission) isCoveredBy(other *granularPermission) bool {
+	if p == nil || other == nil {
+		return p == nil && other == nil
+	}
+
+	if other.children == nil {
+		return p.isUniform(other.value)
+	}
+	if p.children == nil {
+		return other.isUniform(p.value)
+	}
+
+	for key, child := range p.children {
+		if !child.isCoveredBy(other.children[key]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns whether both permissions grant the same access. Metabase may return a single value for a schema or database
+// where all tables were set to the same value, such that both representations should be considered equal.
+func (p *granularPermission) isEquivalentTo(other *granularPermission) bool {
+	return p.isCoveredBy(other) && other.isCoveredBy(p)
+}
+
+// Makes the permissions of each kind from the schema models. A nil permission is returned for kinds which are not set
+// in any schema.
+func makeGranularPermissionsFromSchemas(ctx context.Context, schemas types.Set) ([granularPermissionKinds]*granularPermission, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var permissions [granularPermissionKinds]*granularPermission
+
+	if schemas.IsNull() || schemas.IsUnknown() {
+		return permissions, diags
+	}
+
+	schemaPermissions := make([]SchemaPermissions, 0, len(schemas.Elements()))
+	diags.Append(schemas.ElementsAs(ctx, &schemaPermissions, false)...)
+	if diags.HasError() {
+		return permissions, diags
+	}
+
+	setChild := func(parent **granularPermission, key string, value types.String) {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+		if *parent == nil {
+			*parent = &granularPermission{children: map[string]*granularPermission{}}
+		}
+		(*parent).children[key] = &granularPermission{value: value.ValueString()}
+	}
+
+	for _, s := range schemaPermissions {
+		schemaName := s.Name.ValueString()
+
+		var tables [granularPermissionKinds]*granularPermission
+		if !s.Tables.IsNull() && !s.Tables.IsUnknown() {
+			tablePermissions := make([]TablePermissions, 0, len(s.Tables.Elements()))
+			diags.Append(s.Tables.ElementsAs(ctx, &tablePermissions, false)...)
+			if diags.HasError() {
+				return permissions, diags
+			}
+
+			for _, t := range tablePermissions {
+				tableId := strconv.FormatInt(t.Id.ValueInt64(), 10)
+				for kind, value := range t.values() {
+					setChild(&tables[kind], tableId, value)
+				}
+			}
+		}
+
+		for kind, value := range s.values() {
+			setChild(&permissions[kind], schemaName, value)
+
+			if tables[kind] != nil && (value.IsNull() || value.IsUnknown()) {
+				if permissions[kind] == nil {
+					permissions[kind] = &granularPermission{children: map[string]*granularPermission{}}
+				}
+				permissions[kind].children[schemaName] = tables[kind]
+			}
+		}
+	}
+
+	return permissions, diags
+}
+
+// Makes the schema models from granular permissions of each kind. Kinds for which the permission is nil or not granular
+// are not part of the schemas. A null set is returned when no permission is granular.
+func makeSchemasFromGranularPermissions(ctx context.Context, permissions [granularPermissionKinds]*granularPermission) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	type tableValues = map[string]*[granularPermissionKinds]types.String
+	schemaValues := map[string]*[granularPermissionKinds]types.String{}
+	schemaTables := map[string]tableValues{}
+
+	nullValues := func() *[granularPermissionKinds]types.String {
+		var values [granularPermissionKinds]types.String
+		for kind := range values {
+			values[kind] = types.StringNull()
+		}
+		return &values
+	}
+
+	for kind, p := range permissions {
+		if p == nil || p.children == nil {
+			continue
+		}
+
+		for schemaName, s := range p.children {
+			if _, ok := schemaValues[schemaName]; !ok {
+				schemaValues[schemaName] = nullValues()
+				schemaTables[schemaName] = tableValues{}
+			}
+
+			if s.children == nil {
+				schemaValues[schemaName][kind] = types.StringValue(s.value)
+				continue
+			}
+
+			for tableId, t := range s.children {
+				if t.children != nil {
+					diags.AddError("Unexpected permissions value.", fmt.Sprintf("The permissions for table %s in schema %q are nested too deeply.", tableId, schemaName))
+					return types.SetNull(schemaPermissionsObjectType), diags
+				}
+
+				if _, ok := schemaTables[schemaName][tableId]; !ok {
+					schemaTables[schemaName][tableId] = nullValues()
+				}
+				schemaTables[schemaName][tableId][kind] = types.StringValue(t.value)
+			}
+		}
+	}
+
+	if len(schemaValues) == 0 {
+		return types.SetNull(schemaPermissionsObjectType), diags
+	}
+
+	schemaNames := make([]string, 0, len(schemaValues))
+	for schemaName := range schemaValues {
+		schemaNames = append(schemaNames, schemaName)
+	}
+	sort.Strings(schemaNames)
+
+	schemas := make([]SchemaPermissions, 0, len(schemaNames))
+	for _, schemaName := range schemaNames {
+		tables := make([]TablePermissions, 0, len(schemaTables[schemaName]))
+		for tableId, values := range schemaTables[schemaName] {
+			id, err := strconv.ParseInt(tableId, 10, 64)
+			if err != nil {
+				diags.AddError("Could not convert the table ID to an integer.", err.Error())
+				return types.SetNull(schemaPermissionsObjectType), diags
+			}
+
+			tables = append(tables, TablePermissions{
+				Id:            types.Int64Value(id),
+				ViewData:      values[viewDataPermission],
+				CreateQueries: values[createQueriesPermission],
+				Download:      values[downloadPermission],
+				DataModel:     values[dataModelPermission],
+			})
+		}
+
+		tablesSet := types.SetNull(tablePermissionsObjectType)
+		if len(tables) > 0 {
+			var setDiags diag.Diagnostics
+			tablesSet, setDiags = types.SetValueFrom(ctx, tablePermissionsObjectType, tables)
+			diags.Append(setDiags...)
+			if diags.HasError() {
+				return types.SetNull(schemaPermissionsObjectType), diags
+			}
+		}
+
+		values := schemaValues[schemaName]
+		schemas = append(schemas, SchemaPermissions{
+			Name:          types.StringValue(schemaName),
+			ViewData:      values[viewDataPermission],
+			CreateQueries: values[createQueriesPermission],
+			Download:      values[downloadPermission],
+			DataModel:     values[dataModelPermission],
+			Tables:        tablesSet,
+		})
+	}
+
+	schemasSet, setDiags := types.SetValueFrom(ctx, schemaPermissionsObjectType, schemas)
+	diags.Append(setDiags...)
+
+	return schemasSet, diags
+}
+
+// Validates the granular permissions of a single database. Each kind of permission can be set either for the entire
+// database, or in schemas. In a schema, it can be set either for the entire schema, or for single tables.
+func validateGranularPermissions(ctx context.Context, p DatabasePermissions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schemas := p.Schemas
+	if schemas.IsUnknown() {
+		return diags
+	}
+
+	// Whether each kind of permission is set for the entire database, or nil if it is unknown.
+	var databaseLevel [granularPermissionKinds]*bool
+	for kind, value := range []attr.Value{p.ViewData, p.CreateQueries, p.Download, p.DataModel} {
+		if !value.IsUnknown() {
+			set := !value.IsNull()
+			databaseLevel[kind] = &set
+		}
+	}
+
+	schemaPermissions := make([]SchemaPermissions, 0, len(schemas.Elements()))
+	diags.Append(schemas.ElementsAs(ctx, &schemaPermissions, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var granular [granularPermissionKinds]bool
+	seenSchemas := make(map[string]bool, len(schemaPermissions))
+	for _, s := range schemaPermissions {
+		if s.Name.IsUnknown() || s.Tables.IsUnknown() {
+			return diags
+		}
+
+		schemaName := s.Name.ValueString()
+		if seenSchemas[schemaName] {
+			diags.AddError("Found duplicate schema permissions definition.", fmt.Sprintf("Schema: %q.", schemaName))
+			return diags
+		}
+		seenSchemas[schemaName] = true
+
+		tablePermissions := make([]TablePermissions, 0, len(s.Tables.Elements()))
+		diags.Append(s.Tables.ElementsAs(ctx, &tablePermissions, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		var tableLevel [granularPermissionKinds]bool
+		seenTables := make(map[int64]bool, len(tablePermissions))
+		for _, t := range tablePermissions {
+			if seenTables[t.Id.ValueInt64()] {
+				diags.AddError("Found duplicate table permissions definition.", fmt.Sprintf("Schema: %q, table ID: %d.", schemaName, t.Id.ValueInt64()))
+				return diags
+			}
+			seenTables[t.Id.ValueInt64()] = true
+
+			for kind, value := range t.values() {
+				tableLevel[kind] = tableLevel[kind] || !value.IsNull()
+			}
+		}
+
+		for kind, value := range s.values() {
+			if !value.IsNull() && tableLevel[kind] {
+				diags.AddError(
+					"Conflicting schema and table permissions.",
+					fmt.Sprintf("The %s permission of schema %q cannot be set both for the entire schema and for single tables.", granularPermissionAttributes[kind], schemaName),
+				)
+				return diags
+			}
+
+			granular[kind] = granular[kind] || !value.IsNull() || tableLevel[kind]
+		}
+	}
+
+	for kind, set := range databaseLevel {
+		if set == nil {
+			continue
+		}
+
+		if *set && granular[kind] {
+			diags.AddError(
+				"Conflicting database and schema permissions.",
+				fmt.Sprintf("The %s permission cannot be set both for the entire database and in schemas.", granularPermissionAttributes[kind]),
+			)
+			return diags
+		}
+
+		// Data access and query creation must always be defined.
+		if !*set && !granular[kind] && (kind == viewDataPermission || kind == createQueriesPermission) {
+			diags.AddError(
+				"Missing database permission.",
+				fmt.Sprintf("The %s permission must be set either for the entire database or in schemas.", granularPermissionAttributes[kind]),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Sets the value of a permission to send to the Metabase API, by unmarshaling it into one of the union types of the
+// client.
+func (p *granularPermission) unmarshalInto(target json.Unmarshaler) error {
+	data, err := p.marshal()
+	if err != nil {
+		return err
+	}
+
+	return target.UnmarshalJSON(data)
+}
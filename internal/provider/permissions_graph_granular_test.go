@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Makes the model of the permissions of a group on database 1, with the given schemas.
+func makeGranularDatabasePermissions(t *testing.T, viewData types.String, schemas ...SchemaPermissions) DatabasePermissions {
+	schemasSet := types.SetNull(schemaPermissionsObjectType)
+	if len(schemas) > 0 {
+		set, diags := types.SetValueFrom(context.Background(), schemaPermissionsObjectType, schemas)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		schemasSet = set
+	}
+
+	return DatabasePermissions{
+		Group:         types.Int64Value(3),
+		GroupName:     types.StringNull(),
+		Database:      types.Int64Value(1),
+		DatabaseName:  types.StringNull(),
+		ViewData:      viewData,
+		CreateQueries: types.StringNull(),
+		Download:      types.ObjectNull(accessPermissionsObjectType.AttrTypes),
+		DataModel:     types.ObjectNull(accessPermissionsObjectType.AttrTypes),
+		Details:       types.StringNull(),
+		Schemas:       schemasSet,
+	}
+}
+
+// Makes the model of the permissions on a schema, with the given tables.
+func makeSchemaPermissions(t *testing.T, name string, createQueries types.String, tables ...TablePermissions) SchemaPermissions {
+	tablesSet := types.SetNull(tablePermissionsObjectType)
+	if len(tables) > 0 {
+		set, diags := types.SetValueFrom(context.Background(), tablePermissionsObjectType, tables)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		tablesSet = set
+	}
+
+	return SchemaPermissions{
+		Name:          types.StringValue(name),
+		ViewData:      types.StringNull(),
+		CreateQueries: createQueries,
+		Download:      types.StringNull(),
+		DataModel:     types.StringNull(),
+		Tables:        tablesSet,
+	}
+}
+
+// Makes the model of the create queries permission on a table.
+func makeTableCreateQueriesPermissions(id int64, createQueries string) TablePermissions {
+	return TablePermissions{
+		Id:            types.Int64Value(id),
+		ViewData:      types.StringNull(),
+		CreateQueries: types.StringValue(createQueries),
+		Download:      types.StringNull(),
+		DataModel:     types.StringNull(),
+	}
+}
+
+// Parses the permissions of a database returned by the Metabase API.
+func parseDatabasePermissions(t *testing.T, value string) metabase.PermissionsGraphDatabasePermissions {
+	var p metabase.PermissionsGraphDatabasePermissions
+	if err := json.Unmarshal([]byte(value), &p); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestMakePermissionsGraphFromGranularModel(t *testing.T) {
+	ctx := context.Background()
+
+	permissions := makeGranularDatabasePermissions(t, types.StringValue("unrestricted"),
+		makeSchemaPermissions(t, "public", types.StringValue("query-builder")),
+		makeSchemaPermissions(t, "finance", types.StringNull(),
+			makeTableCreateQueriesPermissions(10, "query-builder"),
+			makeTableCreateQueriesPermissions(11, "no"),
+		),
+	)
+	permissionsSet, diags := types.SetValueFrom(ctx, databasePermissionsObjectType, []DatabasePermissions{permissions})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	graph, diags := makePermissionsGraphFromModel(ctx, PermissionsGraphResourceModel{
+		Revision:            types.Int64Value(1),
+		AdvancedPermissions: types.BoolValue(false),
+		Permissions:         permissionsSet,
+	}, nil)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	body, err := json.Marshal(graph.Groups["3"]["1"])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"create-queries":{"finance":{"10":"query-builder","11":"no"},"public":"query-builder"},"view-data":"unrestricted"}`
+	if string(body) != expected {
+		t.Errorf("Expected %s, got %s.", expected, body)
+	}
+}
+
+func TestMakeDatabasePermissionsFromGranularApi(t *testing.T) {
+	ctx := context.Background()
+
+	p := parseDatabasePermissions(t, `{
+		"view-data": "unrestricted",
+		"create-queries": {"public": "query-builder", "finance": {"10": "query-builder", "11": "no"}},
+		"download": {"schemas": {"public": "full"}}
+	}`)
+
+	permissions, diags := makeDatabasePermissionsFromApi(ctx, p, nil)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if permissions.ViewData.ValueString() != "unrestricted" || !permissions.CreateQueries.IsNull() || !permissions.Download.IsNull() {
+		t.Errorf("Expected only view data to be set for the entire database, got %v.", permissions)
+	}
+
+	schemas := make([]SchemaPermissions, 0)
+	permissions.Schemas.ElementsAs(ctx, &schemas, false)
+	if len(schemas) != 2 {
+		t.Fatalf("Expected 2 schemas, got %v.", permissions.Schemas)
+	}
+
+	for _, s := range schemas {
+		switch s.Name.ValueString() {
+		case "public":
+			if s.CreateQueries.ValueString() != "query-builder" || s.Download.ValueString() != "full" || !s.Tables.IsNull() {
+				t.Errorf("Unexpected permissions for the public schema: %v.", s)
+			}
+		case "finance":
+			tables := make([]TablePermissions, 0)
+			s.Tables.ElementsAs(ctx, &tables, false)
+			if !s.CreateQueries.IsNull() || len(tables) != 2 {
+				t.Errorf("Unexpected permissions for the finance schema: %v.", s)
+			}
+			for _, table := range tables {
+				if (table.Id.ValueInt64() == 10) != (table.CreateQueries.ValueString() == "query-builder") {
+					t.Errorf("Unexpected permissions for table %d: %v.", table.Id.ValueInt64(), table)
+				}
+			}
+		default:
+			t.Errorf("Unexpected schema %v.", s.Name)
+		}
+	}
+}
+
+func TestMakeDatabasePermissionsFromApiKeepsEquivalentModel(t *testing.T) {
+	ctx := context.Background()
+
+	existing := makeGranularDatabasePermissions(t, types.StringValue("unrestricted"),
+		makeSchemaPermissions(t, "finance", types.StringNull(),
+			makeTableCreateQueriesPermissions(10, "query-builder"),
+			makeTableCreateQueriesPermissions(11, "query-builder"),
+		),
+	)
+
+	// Metabase returns a single value for the schema when all its tables have the same permission.
+	collapsed := parseDatabasePermissions(t, `{"view-data": "unrestricted", "create-queries": {"finance": "query-builder"}}`)
+	permissions, diags := makeDatabasePermissionsFromApi(ctx, collapsed, &existing)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !permissions.Schemas.Equal(existing.Schemas) {
+		t.Errorf("Expected the existing schemas to be kept, got %v.", permissions.Schemas)
+	}
+
+	// A table whose permission was changed outside of Terraform is reported as drift.
+	drifted := parseDatabasePermissions(t, `{"view-data": "unrestricted", "create-queries": {"finance": {"10": "query-builder", "11": "query-builder", "12": "query-builder-and-native"}}}`)
+	permissions, diags = makeDatabasePermissionsFromApi(ctx, drifted, &existing)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if permissions.Schemas.Equal(existing.Schemas) {
+		t.Errorf("Expected the new table permission to be reported.")
+	}
+}
+
+func TestValidateGranularPermissions(t *testing.T) {
+	ctx := context.Background()
+
+	valid := makeGranularDatabasePermissions(t, types.StringValue("unrestricted"),
+		makeSchemaPermissions(t, "public", types.StringValue("query-builder")),
+	)
+	if diags := validateGranularPermissions(ctx, valid); diags.HasError() {
+		t.Errorf("Expected valid permissions, got %v.", diags)
+	}
+
+	missing := makeGranularDatabasePermissions(t, types.StringNull(),
+		makeSchemaPermissions(t, "public", types.StringValue("query-builder")),
+	)
+	if diags := validateGranularPermissions(ctx, missing); !diags.HasError() {
+		t.Errorf("Expected an error when view data is not set.")
+	}
+
+	conflicting := makeGranularDatabasePermissions(t, types.StringValue("unrestricted"),
+		makeSchemaPermissions(t, "public", types.StringValue("query-builder"),
+			makeTableCreateQueriesPermissions(10, "no"),
+		),
+	)
+	if diags := validateGranularPermissions(ctx, conflicting); !diags.HasError() {
+		t.Errorf("Expected an error when a permission is set for both a schema and its tables.")
+	}
+}
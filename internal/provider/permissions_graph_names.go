@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Checks that exactly one of the ID or the name of the object to which a permission applies is set.
+func validatePermissionsGraphKey(id attr.Value, name types.String, idAttribute string, nameAttribute string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if id.IsNull() == name.IsNull() {
+		diags.AddAttributeError(
+			path.Root("permissions"),
+			"Invalid permission key.",
+			fmt.Sprintf("Exactly one of `%s` or `%s` must be set in each permission.", idAttribute, nameAttribute),
+		)
+	}
+
+	return diags
+}
+
+// Resolves the names of permissions groups, databases and collections, which can be used instead of IDs as keys in
+// the permissions graphs, and the impersonations of permissions groups on databases. Each list of objects is only fetched
+// from the Metabase API the first time it is needed.
+type permissionsGraphNames struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+
+	groups      []metabase.PermissionsGroup // The permissions groups, nil until fetched.
+	databases   map[string][]int            // The IDs of the databases, indexed by name. Nil until fetched.
+	collections map[string][]string         // The IDs of the collections, indexed by path. Nil until fetched.
+
+	impersonations map[[2]int]bool // The group and database IDs of the impersonations. Nil until fetched.
+}
+
+// Creates a new resolver of permissions graph names, which does not fetch anything until it is used.
+func newPermissionsGraphNames(client *metabase.ClientWithResponses) *permissionsGraphNames {
+	return &permissionsGraphNames{client: client}
+}
+
+// Fetches the list of permissions groups, if it has not been fetched yet.
+func (n *permissionsGraphNames) loadGroups(ctx context.Context) diag.Diagnostics {
+	if n.groups != nil {
+		return nil
+	}
+
+	groups, diags := listPermissionsGroups(ctx, n.client)
+	if diags.HasError() {
+		return diags
+	}
+
+	n.groups = groups
+
+	return diags
+}
+
+// Returns the ID of the permissions group with the given name, or nil if there is no such group.
+func (n *permissionsGraphNames) groupId(ctx context.Context, name string) (*int, diag.Diagnostics) {
+	diags := n.loadGroups(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, g := range n.groups {
+		if g.Name == name {
+			return &g.Id, diags
+		}
+	}
+
+	return nil, diags
+}
+
+// Returns the name of the permissions group with the given ID, or nil if there is no such group.
+func (n *permissionsGraphNames) groupName(ctx context.Context, id int) (*string, diag.Diagnostics) {
+	diags := n.loadGroups(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, g := range n.groups {
+		if g.Id == id {
+			return &g.Name, diags
+		}
+	}
+
+	return nil, diags
+}
+
+// Returns the ID of the group automatically created by Metabase with the given `magic_group_type`. Older versions of
+// Metabase do not return the type of groups, in which case the given default ID is returned.
+func (n *permissionsGraphNames) magicGroupId(ctx context.Context, magicGroupType string, defaultId int) (int, diag.Diagnostics) {
+	diags := n.loadGroups(ctx)
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	for _, g := range n.groups {
+		if g.MagicGroupType != nil && *g.MagicGroupType == magicGroupType {
+			return g.Id, diags
+		}
+	}
+
+	return defaultId, diags
+}
+
+// Fetches the list of impersonations, if it has not been fetched yet.
+func (n *permissionsGraphNames) loadImpersonations(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if n.impersonations != nil {
+		return diags
+	}
+
+	listResp, err := n.client.ListImpersonationsWithResponse(ctx, &metabase.ListImpersonationsParams{})
+
+	diags.Append(checkMetabaseResponse(listResp, err, []int{200}, "list impersonations")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	n.impersonations = make(map[[2]int]bool, len(*listResp.JSON200))
+	for _, i := range *listResp.JSON200 {
+		n.impersonations[[2]int{i.GroupId, i.DbId}] = true
+	}
+
+	return diags
+}
+
+// Returns whether an impersonation of the group on the database exists.
+func (n *permissionsGraphNames) hasImpersonation(ctx context.Context, groupId int, databaseId int) (bool, diag.Diagnostics) {
+	diags := n.loadImpersonations(ctx)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return n.impersonations[[2]int{groupId, databaseId}], diags
+}
+
+// Fetches the list of databases, if it has not been fetched yet.
+func (n *permissionsGraphNames) loadDatabases(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if n.databases != nil {
+		return diags
+	}
+
+	listResp, err := n.client.ListDatabasesWithResponse(ctx, &metabase.ListDatabasesParams{})
+
+	diags.Append(checkMetabaseResponse(listResp, err, []int{200}, "list databases")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	n.databases = make(map[string][]int, len(listResp.JSON200.Data))
+	for _, db := range listResp.JSON200.Data {
+		n.databases[db.Name] = append(n.databases[db.Name], db.Id)
+	}
+
+	return diags
+}
+
+// Returns the ID of the database with the given name, or nil if there is no such database. Several databases can have
+// the same name in Metabase, in which case an error is returned.
+func (n *permissionsGraphNames) databaseId(ctx context.Context, name string) (*int, diag.Diagnostics) {
+	diags := n.loadDatabases(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ids := n.databases[name]
+	if len(ids) > 1 {
+		diags.AddError("Ambiguous database name.", fmt.Sprintf("Several databases are named %q (IDs %v). Use the database ID instead.", name, ids))
+		return nil, diags
+	}
+	if len(ids) == 0 {
+		return nil, diags
+	}
+
+	return &ids[0], diags
+}
+
+// Returns the name of the database with the given ID, or nil if there is no such database.
+func (n *permissionsGraphNames) databaseName(ctx context.Context, id int) (*string, diag.Diagnostics) {
+	diags := n.loadDatabases(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for name, ids := range n.databases {
+		for _, dbId := range ids {
+			if dbId == id {
+				return &name, diags
+			}
+		}
+	}
+
+	return nil, diags
+}
+
+// Returns the name of the database with the given ID if no other database has the same name, or nil otherwise. Only
+// such names can be used to reference the database.
+func (n *permissionsGraphNames) uniqueDatabaseName(ctx context.Context, id int) (*string, diag.Diagnostics) {
+	name, diags := n.databaseName(ctx, id)
+	if diags.HasError() || name == nil || len(n.databases[*name]) != 1 {
+		return nil, diags
+	}
+
+	return name, diags
+}
+
+// Returns the ID of a collection returned by the Metabase API as a string, the way collections are referenced in the
+// collection graph.
+func getCollectionIdString(c metabase.Collection) (string, error) {
+	if id, err := c.Id.AsCollectionId1(); err == nil {
+		return strconv.Itoa(id), nil
+	}
+
+	return c.Id.AsCollectionId0()
+}
+
+// Fetches the list of collections and computes their paths, if it has not been fetched yet. Personal collections are
+// excluded, as they do not appear in the collection graph.
+func (n *permissionsGraphNames) loadCollections(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if n.collections != nil {
+		return diags
+	}
+
+	listResp, err := n.client.ListCollectionsWithResponse(ctx, &metabase.ListCollectionsParams{})
+
+	diags.Append(checkMetabaseResponse(listResp, err, []int{200}, "list collections")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Personal collections are not in the names, such that their descendants are also excluded.
+	names := make(map[string]string, len(*listResp.JSON200))
+	for _, c := range *listResp.JSON200 {
+		id, err := getCollectionIdString(c)
+		if err != nil {
+			diags.AddError("Unexpected collection ID.", err.Error())
+			return diags
+		}
+
+		if c.PersonalOwnerId == nil {
+			names[id] = c.Name
+		}
+	}
+
+	n.collections = make(map[string][]string, len(*listResp.JSON200))
+	for _, c := range *listResp.JSON200 {
+		id, _ := getCollectionIdString(c)
+		if c.PersonalOwnerId != nil || id == "root" {
+			continue
+		}
+
+		// The location lists the IDs of the ancestors of the collection, e.g. `/5/16/`.
+		segments := []string{}
+		if c.Location != nil {
+			for _, ancestorId := range strings.Split(strings.Trim(*c.Location, "/"), "/") {
+				if ancestorId == "" {
+					continue
+				}
+
+				ancestorName, ok := names[ancestorId]
+				if !ok {
+					// The ancestor is a personal collection or is not visible, the path cannot be computed.
+					segments = nil
+					break
+				}
+
+				segments = append(segments, ancestorName)
+			}
+		}
+		if segments == nil {
+			continue
+		}
+
+		path := strings.Join(append(segments, c.Name), "/")
+		n.collections[path] = append(n.collections[path], id)
+	}
+
+	return diags
+}
+
+// Returns the ID of the collection with the given path, e.g. `Marketing/Reports`, or nil if there is no such
+// collection. Sibling collections can have the same name in Metabase, in which case an error is returned.
+func (n *permissionsGraphNames) collectionId(ctx context.Context, path string) (*string, diag.Diagnostics) {
+	diags := n.loadCollections(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ids := n.collections[path]
+	if len(ids) > 1 {
+		diags.AddError("Ambiguous collection path.", fmt.Sprintf("Several collections have the path %q (IDs %v). Use the collection ID instead.", path, ids))
+		return nil, diags
+	}
+	if len(ids) == 0 {
+		return nil, diags
+	}
+
+	return &ids[0], diags
+}
+
+// Returns the path of the collection with the given ID, or nil if the collection is not found.
+func (n *permissionsGraphNames) collectionPath(ctx context.Context, id string) (*string, diag.Diagnostics) {
+	diags := n.loadCollections(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for path, ids := range n.collections {
+		for _, colId := range ids {
+			if colId == id {
+				return &path, diags
+			}
+		}
+	}
+
+	return nil, diags
+}
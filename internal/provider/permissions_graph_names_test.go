@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Registers the permissions groups, databases and collections endpoints on the stand-in server.
+func serveStandInPermissionsGraphNames(s *metabaseStandIn) {
+	s.serveJson("GET", "/permissions/group", []map[string]any{
+		{"id": 1, "name": "All Users", "magic_group_type": "all-internal-users"},
+		{"id": 7, "name": "Administrators", "magic_group_type": "admin"},
+		{"id": 3, "name": "Analysts", "magic_group_type": nil},
+	})
+	s.serveJson("GET", "/database", map[string]any{
+		"data": []map[string]any{
+			{"id": 1, "name": "Warehouse", "engine": "postgres"},
+			{"id": 2, "name": "Sample", "engine": "h2"},
+			{"id": 3, "name": "Sample", "engine": "h2"},
+		},
+		"total": 3,
+	})
+	s.serveJson("GET", "/collection", []map[string]any{
+		{"id": "root", "name": "Our analytics"},
+		{"id": 5, "name": "Marketing", "location": "/"},
+		{"id": 16, "name": "Reports", "location": "/5/"},
+		{"id": 20, "name": "Reports", "location": "/"},
+		{"id": 30, "name": "Ada's Personal Collection", "location": "/", "personal_owner_id": 1},
+		{"id": 31, "name": "Drafts", "location": "/30/"},
+	})
+}
+
+func TestPermissionsGraphNamesStandIn(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	serveStandInPermissionsGraphNames(s)
+	names := newPermissionsGraphNames(s.client())
+
+	groupId, diags := names.groupId(ctx, "Analysts")
+	if diags.HasError() || groupId == nil || *groupId != 3 {
+		t.Errorf("Expected group 3, got %v and %v.", groupId, diags)
+	}
+
+	administratorsId, diags := names.magicGroupId(ctx, metabase.AdministratorsMagicGroupType, metabase.AdministratorsPermissionsGroupId)
+	if diags.HasError() || administratorsId != 7 {
+		t.Errorf("Expected the Administrators group to be found by type, got %d and %v.", administratorsId, diags)
+	}
+
+	databaseId, diags := names.databaseId(ctx, "Warehouse")
+	if diags.HasError() || databaseId == nil || *databaseId != 1 {
+		t.Errorf("Expected database 1, got %v and %v.", databaseId, diags)
+	}
+
+	_, diags = names.databaseId(ctx, "Sample")
+	if !diags.HasError() {
+		t.Errorf("Expected an error for an ambiguous database name.")
+	}
+
+	databaseName, diags := names.uniqueDatabaseName(ctx, 1)
+	if diags.HasError() || databaseName == nil || *databaseName != "Warehouse" {
+		t.Errorf("Expected database name Warehouse, got %v and %v.", databaseName, diags)
+	}
+
+	databaseName, diags = names.uniqueDatabaseName(ctx, 2)
+	if diags.HasError() || databaseName != nil {
+		t.Errorf("Expected no name for a database with an ambiguous name, got %v and %v.", databaseName, diags)
+	}
+
+	collectionId, diags := names.collectionId(ctx, "Marketing/Reports")
+	if diags.HasError() || collectionId == nil || *collectionId != "16" {
+		t.Errorf("Expected collection 16, got %v and %v.", collectionId, diags)
+	}
+
+	collectionId, diags = names.collectionId(ctx, "Ada's Personal Collection/Drafts")
+	if diags.HasError() || collectionId != nil {
+		t.Errorf("Expected personal collections to be excluded, got %v and %v.", collectionId, diags)
+	}
+
+	path, diags := names.collectionPath(ctx, "20")
+	if diags.HasError() || path == nil || *path != "Reports" {
+		t.Errorf("Expected path Reports, got %v and %v.", path, diags)
+	}
+
+	// Each list is only fetched once.
+	if requests := len(s.receivedRequests()); requests != 3 {
+		t.Errorf("Expected 3 requests, got %v.", s.receivedRequests())
+	}
+}
+
+func TestMagicGroupIdWithoutType(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	s.serveJson("GET", "/permissions/group", []map[string]any{
+		{"id": 1, "name": "All Users"},
+		{"id": 2, "name": "Administrators"},
+	})
+
+	ignoredGroups, diags := getIgnoredPermissionsGroups(ctx, newPermissionsGraphNames(s.client()), types.SetNull(types.Int64Type))
+	if diags.HasError() || len(ignoredGroups) != 1 || !ignoredGroups["2"] {
+		t.Errorf("Expected the default Administrators group to be ignored, got %v and %v.", ignoredGroups, diags)
+	}
+}
+
+func TestResolvePermissionsGraphModelNamesStandIn(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	serveStandInPermissionsGraphNames(s)
+	names := newPermissionsGraphNames(s.client())
+
+	permission := func(group types.Int64, groupName string, database types.Int64, databaseName string) DatabasePermissions {
+		p := DatabasePermissions{
+			Group:         group,
+			GroupName:     types.StringNull(),
+			Database:      database,
+			DatabaseName:  types.StringNull(),
+			ViewData:      types.StringValue("unrestricted"),
+			CreateQueries: types.StringValue("query-builder"),
+			Download:      types.ObjectNull(accessPermissionsObjectType.AttrTypes),
+			DataModel:     types.ObjectNull(accessPermissionsObjectType.AttrTypes),
+			Details:       types.StringNull(),
+			Schemas:       types.SetNull(schemaPermissionsObjectType),
+		}
+		if groupName != "" {
+			p.GroupName = types.StringValue(groupName)
+		}
+		if databaseName != "" {
+			p.DatabaseName = types.StringValue(databaseName)
+		}
+		return p
+	}
+
+	makeModel := func(permissions ...DatabasePermissions) PermissionsGraphResourceModel {
+		set, diags := types.SetValueFrom(ctx, databasePermissionsObjectType, permissions)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		return PermissionsGraphResourceModel{Permissions: set}
+	}
+
+	data := makeModel(
+		permission(types.Int64Unknown(), "Analysts", types.Int64Unknown(), "Warehouse"),
+		permission(types.Int64Unknown(), "Created Later", types.Int64Value(1), ""),
+	)
+
+	diags := resolvePermissionsGraphModelNames(ctx, names, &data, false)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	expected := makeModel(
+		permission(types.Int64Value(3), "Analysts", types.Int64Value(1), "Warehouse"),
+		permission(types.Int64Unknown(), "Created Later", types.Int64Value(1), ""),
+	)
+	if !data.Permissions.Equal(expected.Permissions) {
+		t.Errorf("Expected permissions %v, got %v.", expected.Permissions, data.Permissions)
+	}
+
+	diags = resolvePermissionsGraphModelNames(ctx, names, &data, true)
+	if !diags.HasError() {
+		t.Errorf("Expected an error for a group which does not exist when applying.")
+	}
+}
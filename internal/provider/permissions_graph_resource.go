@@ -18,6 +18,8 @@ import (
 
 // Ensures provider defined types fully satisfy framework interfaces.
 var _ resource.ResourceWithImportState = &PermissionsGraphResource{}
+var _ resource.ResourceWithValidateConfig = &PermissionsGraphResource{}
+var _ resource.ResourceWithModifyPlan = &PermissionsGraphResource{}
 
 // Creates a new permissions graph resource.
 func NewPermissionsGraphResource() resource.Resource {
@@ -43,24 +45,30 @@ type PermissionsGraphResourceModel struct {
 // The model for a single edge in the permissions graph.
 type DatabasePermissions struct {
 	Group         types.Int64  `tfsdk:"group"`          // The ID of the permissions group to which the permission applies.
+	GroupName     types.String `tfsdk:"group_name"`     // The name of the permissions group, which can be set instead of its ID.
 	Database      types.Int64  `tfsdk:"database"`       // The ID of the database to which the permission applies.
+	DatabaseName  types.String `tfsdk:"database_name"`  // The name of the database, which can be set instead of its ID.
 	ViewData      types.String `tfsdk:"view_data"`      // View data access permission.
 	CreateQueries types.String `tfsdk:"create_queries"` // Create queries access permission.
 	Download      types.Object `tfsdk:"download"`       // Download-related permission (only available with advanced permissions).
 	DataModel     types.Object `tfsdk:"data_model"`     // Data-model-related permission (only available with advanced permissions).
 	Details       types.String `tfsdk:"details"`        // Details permission (only available with advanced permissions).
+	Schemas       types.Set    `tfsdk:"schema"`         // Granular permissions for each schema and table.
 }
 
 // The object type definition for the `DatabasePermissions` model.
 var databasePermissionsObjectType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
 		"group":          types.Int64Type,
+		"group_name":     types.StringType,
 		"database":       types.Int64Type,
+		"database_name":  types.StringType,
 		"view_data":      types.StringType,
 		"create_queries": types.StringType,
 		"download":       accessPermissionsObjectType,
 		"data_model":     accessPermissionsObjectType,
 		"details":        types.StringType,
+		"schema":         types.SetType{ElemType: schemaPermissionsObjectType},
 	},
 }
 
@@ -92,7 +100,11 @@ Metabase exposes a single resource to define all permissions related to database
 
 The permissions graph cannot be created or deleted. Trying to create it will result in an error. It should be imported instead. Trying to delete the resource will succeed with no impact on Metabase (it is a no-op).
 
-Permissions for the Administrators group cannot be changed. To avoid issues during the update, all permissions for the Administrators group are ignored by default. This behavior can be changed using the ignored groups attribute.`,
+Groups and databases can be referenced either by ID or by name, using ` + "`group_name`" + ` and ` + "`database_name`" + `. Names are resolved to IDs when planning, or when applying if the group or database does not exist yet.
+
+Permissions for the Administrators group cannot be changed. To avoid issues during the update, all permissions for the Administrators group are ignored by default. This behavior can be changed using the ignored groups attribute.
+
+Impersonated permissions (` + "`view_data = \"impersonated\"`" + `) which are not part of the configuration are not reported while their impersonation exists, as they are usually managed along with their user attribute by the ` + "`metabase_impersonation`" + ` resource.`,
 
 		Attributes: map[string]schema.Attribute{
 			"revision": schema.Int64Attribute{
@@ -105,7 +117,7 @@ Permissions for the Administrators group cannot be changed. To avoid issues duri
 			},
 			"ignored_groups": schema.SetAttribute{
 				ElementType:         types.Int64Type,
-				MarkdownDescription: "The list of group IDs that should be ignored when reading and updating permissions. By default, this contains the Administrators group (`[2]`).",
+				MarkdownDescription: "The list of group IDs that should be ignored when reading and updating permissions. By default, this contains the Administrators group, found using its type.",
 				Optional:            true,
 			},
 			"permissions": schema.SetNestedAttribute{
@@ -114,20 +126,32 @@ Permissions for the Administrators group cannot be changed. To avoid issues duri
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"group": schema.Int64Attribute{
-							MarkdownDescription: "The ID of the group to which the permission applies.",
-							Required:            true,
+							MarkdownDescription: "The ID of the group to which the permission applies. Exactly one of `group` or `group_name` must be set.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"group_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the group to which the permission applies, resolved to its ID. If not set, this is the name of the group referenced by ID.",
+							Optional:            true,
+							Computed:            true,
 						},
 						"database": schema.Int64Attribute{
-							MarkdownDescription: "The ID of the database to which the permission applies.",
-							Required:            true,
+							MarkdownDescription: "The ID of the database to which the permission applies. Exactly one of `database` or `database_name` must be set.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"database_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the database to which the permission applies, resolved to its ID. If not set, this is the name of the database referenced by ID, unless several databases have this name.",
+							Optional:            true,
+							Computed:            true,
 						},
 						"view_data": schema.StringAttribute{
-							MarkdownDescription: "The permission definition for data access.",
-							Required:            true,
+							MarkdownDescription: "The permission definition for data access on the entire database. Either this or the `view_data` of schemas must be set.",
+							Optional:            true,
 						},
 						"create_queries": schema.StringAttribute{
-							MarkdownDescription: "The permission definition for creating queries.",
-							Required:            true,
+							MarkdownDescription: "The permission definition for creating queries on the entire database. Either this or the `create_queries` of schemas must be set.",
+							Optional:            true,
 						},
 						"download": schema.SingleNestedAttribute{
 							MarkdownDescription: "The permission definition for downloading data.",
@@ -143,6 +167,7 @@ Permissions for the Administrators group cannot be changed. To avoid issues duri
 							MarkdownDescription: "The permission definition for accessing details.",
 							Optional:            true,
 						},
+						"schema": schemaPermissionsAttribute,
 					},
 				},
 			},
@@ -150,103 +175,220 @@ Permissions for the Administrators group cannot be changed. To avoid issues duri
 	}
 }
 
-// Makes a `AccessPermissions` Terraform object from a Metabase API value.
-// A nil input will be returned as a null object.
-func makeAccessPermissionsFromDatabaseAccess(ctx context.Context, da *metabase.PermissionsGraphDatabaseAccess) (*types.Object, diag.Diagnostics) {
-	if da == nil {
-		nullObject := types.ObjectNull(accessPermissionsObjectType.AttrTypes)
-		return &nullObject, diag.Diagnostics{}
+// Returns the permissions of each kind from a Metabase API value. Kinds which are not part of the value are nil.
+func makeGranularPermissionsFromDatabasePermissions(p metabase.PermissionsGraphDatabasePermissions) ([granularPermissionKinds]*granularPermission, error) {
+	var permissions [granularPermissionKinds]*granularPermission
+
+	values := [granularPermissionKinds]json.Marshaler{viewDataPermission: p.ViewData}
+	if p.CreateQueries != nil {
+		values[createQueriesPermission] = p.CreateQueries
+	}
+	if p.Download != nil && p.Download.Schemas != nil {
+		values[downloadPermission] = p.Download.Schemas
+	}
+	if p.DataModel != nil && p.DataModel.Schemas != nil {
+		values[dataModelPermission] = p.DataModel.Schemas
 	}
 
-	var diags diag.Diagnostics
-	schemas, err := da.Schemas.AsPermissionsGraphDatabaseAccessSchemas0()
-	if err != nil {
-		diags.AddError("Unexpected permissions value. This could be caused by using granular permissions (unsupported). Remove granular permissions and try again", err.Error())
-		return nil, diags
+	for kind, value := range values {
+		if value == nil {
+			continue
+		}
+
+		data, err := value.MarshalJSON()
+		if err != nil {
+			return permissions, err
+		}
+
+		permissions[kind], err = parseGranularPermission(data)
+		if err != nil {
+			return permissions, err
+		}
 	}
 
-	obj, diags := types.ObjectValueFrom(ctx, accessPermissionsObjectType.AttrTypes, AccessPermissions{
-		Schemas: stringValueOrNull(&schemas),
-	})
+	return permissions, nil
+}
+
+// Returns the permissions of each kind defined in the model, either for the entire database or in schemas. Also
+// returns whether the view data permission is defined as a JSON object rather than a single value.
+func makeGranularPermissionsFromModel(ctx context.Context, p DatabasePermissions) ([granularPermissionKinds]*granularPermission, bool, diag.Diagnostics) {
+	permissions, diags := makeGranularPermissionsFromSchemas(ctx, p.Schemas)
 	if diags.HasError() {
-		return nil, diags
+		return permissions, false, diags
 	}
 
-	return &obj, diags
+	var viewDataIsJson bool
+	if !p.ViewData.IsNull() && !p.ViewData.IsUnknown() {
+		var viewDataObject map[string]any
+		if err := json.Unmarshal([]byte(p.ViewData.ValueString()), &viewDataObject); err == nil {
+			viewData, err := makeGranularPermission(viewDataObject)
+			if err != nil {
+				diags.AddError("Unexpected view data permissions value.", err.Error())
+				return permissions, false, diags
+			}
+
+			permissions[viewDataPermission] = viewData
+			viewDataIsJson = true
+		} else {
+			permissions[viewDataPermission] = &granularPermission{value: p.ViewData.ValueString()}
+		}
+	}
+
+	if !p.CreateQueries.IsNull() && !p.CreateQueries.IsUnknown() {
+		permissions[createQueriesPermission] = &granularPermission{value: p.CreateQueries.ValueString()}
+	}
+
+	for kind, access := range map[int]types.Object{downloadPermission: p.Download, dataModelPermission: p.DataModel} {
+		if access.IsNull() || access.IsUnknown() {
+			continue
+		}
+
+		var ap AccessPermissions
+		diags.Append(access.As(ctx, &ap, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return permissions, false, diags
+		}
+
+		if !ap.Schemas.IsNull() && !ap.Schemas.IsUnknown() {
+			permissions[kind] = &granularPermission{value: ap.Schemas.ValueString()}
+		}
+	}
+
+	return permissions, viewDataIsJson, diags
 }
 
-// Makes a single `DatabasePermissions` Terraform object from a Metabase API's response.
-func makePermissionsObjectFromDatabasePermissions(ctx context.Context, groupId int, dbId int, p metabase.PermissionsGraphDatabasePermissions, existing *DatabasePermissions) (*types.Object, diag.Diagnostics) {
-	var diags diag.Diagnostics
+// Makes the `AccessPermissions` object for a permission defined for the entire database. A null object is returned if
+// the permission is not set, or if it is granular.
+func makeAccessPermissionsFromGranularPermission(ctx context.Context, p *granularPermission) (types.Object, diag.Diagnostics) {
+	if p == nil || p.children != nil {
+		return types.ObjectNull(accessPermissionsObjectType.AttrTypes), diag.Diagnostics{}
+	}
 
-	createQueries := metabase.PermissionsGraphDatabasePermissionsCreateQueriesNo
-	if p.CreateQueries != nil {
-		createQueries = *p.CreateQueries
+	return types.ObjectValueFrom(ctx, accessPermissionsObjectType.AttrTypes, AccessPermissions{
+		Schemas: types.StringValue(p.value),
+	})
+}
+
+// Returns the value of a permission defined for the entire database, or null if it is not set or if it is granular.
+func valueOfGranularPermissionOrNull(p *granularPermission) types.String {
+	if p == nil || p.children != nil {
+		return types.StringNull()
 	}
 
-	downloadAccess, accessDiags := makeAccessPermissionsFromDatabaseAccess(ctx, p.Download)
-	diags.Append(accessDiags...)
-	if diags.HasError() {
+	return types.StringValue(p.value)
+}
+
+// Makes the `DatabasePermissions` model from a Metabase API's response. Only the permissions are set, the group and
+// database should be set by the caller.
+// For each kind of permission, if the existing model grants the same access as the API value, the existing model is
+// kept. For example, tables with the same permission can be returned by Metabase as a single value for their schema.
+func makeDatabasePermissionsFromApi(ctx context.Context, p metabase.PermissionsGraphDatabasePermissions, existing *DatabasePermissions) (*DatabasePermissions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	permissions, err := makeGranularPermissionsFromDatabasePermissions(p)
+	if err != nil {
+		diags.AddError("Unexpected permissions value.", err.Error())
 		return nil, diags
 	}
 
-	dataModelAccess, accessDiags := makeAccessPermissionsFromDatabaseAccess(ctx, p.DataModel)
-	diags.Append(accessDiags...)
-	if diags.HasError() {
-		return nil, diags
+	if permissions[createQueriesPermission] == nil {
+		permissions[createQueriesPermission] = &granularPermission{value: string(metabase.PermissionsGraphDatabasePermissionsCreateQueriesNo)}
 	}
 
-	var existingViewData *string
-	var existingViewDataIsJson bool
+	var keepExisting [granularPermissionKinds]bool
+	var viewDataIsJson bool
 	if existing != nil {
-		existingViewData = existing.ViewData.ValueStringPointer()
+		var existingPermissions [granularPermissionKinds]*granularPermission
+		existingPermissions, viewDataIsJson, diags = makeGranularPermissionsFromModel(ctx, *existing)
+		if diags.HasError() {
+			return nil, diags
+		}
 
-		if existingViewData != nil {
-			var viewDataObject map[string]any
-			err := json.Unmarshal([]byte(*existingViewData), &viewDataObject)
-			existingViewDataIsJson = err == nil
+		for kind := range permissions {
+			if existingPermissions[kind].isEquivalentTo(permissions[kind]) {
+				permissions[kind] = existingPermissions[kind]
+				keepExisting[kind] = true
+			}
 		}
 	}
 
-	var viewData string
-	if viewDataString, err := p.ViewData.AsPermissionsGraphDatabasePermissionsViewData0(); err == nil {
-		if existingViewData != nil && existingViewDataIsJson {
-			// If the types between the existing model and the API response differ, assuming they have the same meaning.
-			// For example, in some cases, sending { "public": "unrestricted" } will be returned as "unrestricted" by the API.
-			viewData = *existingViewData
-		} else {
-			viewData = string(viewDataString)
-		}
-	} else {
-		viewDataObject, err := p.ViewData.AsPermissionsGraphDatabasePermissionsViewData1()
-		if err != nil {
-			diags.AddError("Unexpected permissions value.", err.Error())
-			return nil, diags
-		}
+	viewData := valueOfGranularPermissionOrNull(permissions[viewDataPermission])
+	if viewDataIsJson {
+		// The view data permission was set as JSON, which is kept as is if it has not changed.
+		if keepExisting[viewDataPermission] {
+			viewData = existing.ViewData
+		} else if permissions[viewDataPermission] != nil {
+			viewDataBytes, err := permissions[viewDataPermission].marshal()
+			if err != nil {
+				diags.AddError("Unexpected error marshaling view data permissions to JSON.", err.Error())
+				return nil, diags
+			}
 
-		viewDataBytes, err := json.Marshal(viewDataObject)
-		if err != nil {
-			diags.AddError("Unexpected error marshaling view data permissions to JSON.", err.Error())
-			return nil, diags
+			viewData = types.StringValue(string(viewDataBytes))
 		}
 
-		// Same reasoning as for the string case above.
-		if existingViewData == nil || existingViewDataIsJson {
-			viewData = string(viewDataBytes)
-		} else {
-			viewData = *existingViewData
-		}
+		// The JSON value already contains the granular view data permissions.
+		permissions[viewDataPermission] = nil
 	}
 
-	permissionsObject, objectDiags := types.ObjectValueFrom(ctx, databasePermissionsObjectType.AttrTypes, DatabasePermissions{
-		Group:         types.Int64Value(int64(groupId)),
-		Database:      types.Int64Value(int64(dbId)),
-		ViewData:      types.StringValue(viewData),
-		CreateQueries: types.StringValue(string(createQueries)),
-		Download:      *downloadAccess,
-		DataModel:     *dataModelAccess,
+	download, objectDiags := makeAccessPermissionsFromGranularPermission(ctx, permissions[downloadPermission])
+	diags.Append(objectDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if keepExisting[downloadPermission] && permissions[downloadPermission] == nil {
+		download = existing.Download
+	}
+
+	dataModel, objectDiags := makeAccessPermissionsFromGranularPermission(ctx, permissions[dataModelPermission])
+	diags.Append(objectDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if keepExisting[dataModelPermission] && permissions[dataModelPermission] == nil {
+		dataModel = existing.DataModel
+	}
+
+	schemas, setDiags := makeSchemasFromGranularPermissions(ctx, permissions)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &DatabasePermissions{
+		ViewData:      viewData,
+		CreateQueries: valueOfGranularPermissionOrNull(permissions[createQueriesPermission]),
+		Download:      download,
+		DataModel:     dataModel,
 		Details:       stringValueOrNull(p.Details),
-	})
+		Schemas:       schemas,
+	}, diags
+}
+
+// Returns whether the view data permission of a group on an entire database is impersonated, i.e. queries are run
+// using a database role set from a user attribute.
+func isImpersonatedDatabasePermissions(p metabase.PermissionsGraphDatabasePermissions) bool {
+	viewData, err := p.ViewData.AsPermissionsGraphDatabasePermissionsViewData0()
+	return err == nil && viewData == metabase.PermissionsGraphDatabasePermissionsViewData0Impersonated
+}
+
+// Makes a single `DatabasePermissions` Terraform object from a Metabase API's response.
+// The names of the group and database are those of the existing model, if any.
+func makePermissionsObjectFromDatabasePermissions(ctx context.Context, groupId int, dbId int, p metabase.PermissionsGraphDatabasePermissions, existing *DatabasePermissions, groupName types.String, databaseName types.String) (*types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	permissions, permissionsDiags := makeDatabasePermissionsFromApi(ctx, p, existing)
+	diags.Append(permissionsDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	permissions.Group = types.Int64Value(int64(groupId))
+	permissions.GroupName = groupName
+	permissions.Database = types.Int64Value(int64(dbId))
+	permissions.DatabaseName = databaseName
+
+	permissionsObject, objectDiags := types.ObjectValueFrom(ctx, databasePermissionsObjectType.AttrTypes, permissions)
 	diags.Append(objectDiags...)
 	if diags.HasError() {
 		return nil, diags
@@ -256,12 +398,14 @@ func makePermissionsObjectFromDatabasePermissions(ctx context.Context, groupId i
 }
 
 // Updates the given `PermissionsGraphResourceModel` from the `PermissionsGraph` returned by the Metabase API.
-func updateModelFromPermissionsGraph(ctx context.Context, g metabase.PermissionsGraph, data *PermissionsGraphResourceModel) diag.Diagnostics {
+// Groups and databases referenced by name in the existing model keep their name, including in permissions which were
+// added outside of Terraform.
+func updateModelFromPermissionsGraph(ctx context.Context, names *permissionsGraphNames, g metabase.PermissionsGraph, data *PermissionsGraphResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	data.Revision = types.Int64Value(int64(g.Revision))
 
-	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, data.IgnoredGroups)
+	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, names, data.IgnoredGroups)
 	diags.Append(groupsDiags...)
 	if diags.HasError() {
 		return diags
@@ -274,6 +418,23 @@ func updateModelFromPermissionsGraph(ctx context.Context, g metabase.Permissions
 		return diags
 	}
 
+	groupNames := make(map[int64]types.String)
+	databaseNames := make(map[int64]types.String)
+	for _, p := range existingModelPermissions {
+		if !p.GroupName.IsNull() {
+			groupNames[p.Group.ValueInt64()] = p.GroupName
+		}
+		if !p.DatabaseName.IsNull() {
+			databaseNames[p.Database.ValueInt64()] = p.DatabaseName
+		}
+	}
+	nameOrNull := func(names map[int64]types.String, id int) types.String {
+		if name, ok := names[int64(id)]; ok {
+			return name
+		}
+		return types.StringNull()
+	}
+
 	permissionsList := make([]attr.Value, 0, len(data.Permissions.Elements()))
 	for groupId, dbPermissionsMap := range g.Groups {
 		// Permissions for ignored groups are not stored in the state for clarity.
@@ -308,7 +469,23 @@ func updateModelFromPermissionsGraph(ctx context.Context, g metabase.Permissions
 				}
 			}
 
-			permissionsObject, objDiags := makePermissionsObjectFromDatabasePermissions(ctx, groupIdInt, dbIdInt, dbPermissions, existingPermission)
+			// Impersonated permissions are usually managed along with their attribute by the `metabase_impersonation`
+			// resource. Unless they are part of the model, they are not reported, as they would otherwise be seen as
+			// drift and revoked by the next update, which would also delete the impersonation. Impersonated
+			// permissions left without an impersonation are still reported.
+			if existingPermission == nil && isImpersonatedDatabasePermissions(dbPermissions) {
+				impersonated, impersonationDiags := names.hasImpersonation(ctx, groupIdInt, dbIdInt)
+				diags.Append(impersonationDiags...)
+				if diags.HasError() {
+					return diags
+				}
+
+				if impersonated {
+					continue
+				}
+			}
+
+			permissionsObject, objDiags := makePermissionsObjectFromDatabasePermissions(ctx, groupIdInt, dbIdInt, dbPermissions, existingPermission, nameOrNull(groupNames, groupIdInt), nameOrNull(databaseNames, dbIdInt))
 			diags.Append(objDiags...)
 			if diags.HasError() {
 				return diags
@@ -368,6 +545,25 @@ func makeDatasetAccessFromModel(ctx context.Context, apObj types.Object, setIfNu
 	}, diags
 }
 
+// Makes a Metabase API `PermissionsGraphDatabaseAccess` struct from a permission set in schemas, or from the Terraform
+// model object if the permission is not granular.
+func makeDatasetAccessFromGranularPermission(ctx context.Context, p *granularPermission, apObj types.Object, setIfNull bool) (*metabase.PermissionsGraphDatabaseAccess, diag.Diagnostics) {
+	if p == nil || p.children == nil {
+		return makeDatasetAccessFromModel(ctx, apObj, setIfNull)
+	}
+
+	var diags diag.Diagnostics
+	var schemas metabase.PermissionsGraphDatabaseAccess_Schemas
+	if err := p.unmarshalInto(&schemas); err != nil {
+		diags.AddError("Unexpected error setting permissions value", err.Error())
+		return nil, diags
+	}
+
+	return &metabase.PermissionsGraphDatabaseAccess{
+		Schemas: &schemas,
+	}, diags
+}
+
 // Makes the entire permissions graph from the Terraform model.
 // Passing the current state allows comparing the plan to an existing set of permissions. This allows explicitly
 // removing permissions by sending "none" values to the Metabase API.
@@ -409,33 +605,39 @@ func makePermissionsGraphFromModel(ctx context.Context, data PermissionsGraphRes
 			return nil, diags
 		}
 
-		viewDataString := p.ViewData.ValueString()
+		// The view data permission is parsed as JSON if possible, and can be set for each schema and table.
+		granularPermissions, _, granularDiags := makeGranularPermissionsFromModel(ctx, p)
+		diags.Append(granularDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if granularPermissions[viewDataPermission] == nil {
+			diags.AddError("Missing view data permission.", fmt.Sprintf("Group ID: %s, Database ID: %s.", groupId, databaseId))
+			return nil, diags
+		}
 		var viewData metabase.PermissionsGraphDatabasePermissions_ViewData
-		var viewDataObject map[string]any
-		// Tries to parse the string as JSON.
-		if err := json.Unmarshal([]byte(viewDataString), &viewDataObject); err == nil {
-			viewData.FromPermissionsGraphDatabasePermissionsViewData1(
-				metabase.PermissionsGraphDatabasePermissionsViewData1(viewDataObject),
-			)
-		} else {
-			viewData.FromPermissionsGraphDatabasePermissionsViewData0(
-				metabase.PermissionsGraphDatabasePermissionsViewData0(viewDataString),
-			)
+		if err := granularPermissions[viewDataPermission].unmarshalInto(&viewData); err != nil {
+			diags.AddError("Unexpected error setting view data permission.", err.Error())
+			return nil, diags
 		}
 
-		createQueries := valueApproximateStringOrNull[metabase.PermissionsGraphDatabasePermissionsCreateQueries](p.CreateQueries)
-		if createQueries == nil {
-			no := metabase.PermissionsGraphDatabasePermissionsCreateQueriesNo
-			createQueries = &no
+		if granularPermissions[createQueriesPermission] == nil {
+			granularPermissions[createQueriesPermission] = &granularPermission{value: string(metabase.PermissionsGraphDatabasePermissionsCreateQueriesNo)}
+		}
+		var createQueries metabase.PermissionsGraphDatabasePermissions_CreateQueries
+		if err := granularPermissions[createQueriesPermission].unmarshalInto(&createQueries); err != nil {
+			diags.AddError("Unexpected error setting create queries permission.", err.Error())
+			return nil, diags
 		}
 
-		download, accessDiags := makeDatasetAccessFromModel(ctx, p.Download, advancedPermissions)
+		download, accessDiags := makeDatasetAccessFromGranularPermission(ctx, granularPermissions[downloadPermission], p.Download, advancedPermissions)
 		diags.Append(accessDiags...)
 		if diags.HasError() {
 			return nil, diags
 		}
 
-		dataModel, accessDiags := makeDatasetAccessFromModel(ctx, p.DataModel, advancedPermissions)
+		dataModel, accessDiags := makeDatasetAccessFromGranularPermission(ctx, granularPermissions[dataModelPermission], p.DataModel, advancedPermissions)
 		diags.Append(accessDiags...)
 		if diags.HasError() {
 			return nil, diags
@@ -449,7 +651,7 @@ func makePermissionsGraphFromModel(ctx context.Context, data PermissionsGraphRes
 
 		dbPermMap[databaseId] = metabase.PermissionsGraphDatabasePermissions{
 			ViewData:      viewData,
-			CreateQueries: createQueries,
+			CreateQueries: &createQueries,
 			Download:      download,
 			DataModel:     dataModel,
 			Details:       details,
@@ -499,7 +701,12 @@ func makePermissionsGraphFromModel(ctx context.Context, data PermissionsGraphRes
 				diags.AddError("Unexpected error setting schema none value", err.Error())
 				return nil, diags
 			}
-			no := metabase.PermissionsGraphDatabasePermissionsCreateQueriesNo
+			var no metabase.PermissionsGraphDatabasePermissions_CreateQueries
+			err = no.FromPermissionsGraphDatabasePermissionsCreateQueries(metabase.PermissionsGraphDatabasePermissionsCreateQueriesNo)
+			if err != nil {
+				diags.AddError("Unexpected error setting create queries none value", err.Error())
+				return nil, diags
+			}
 			deletedPermissions := metabase.PermissionsGraphDatabasePermissions{
 				CreateQueries: &no,
 			}
@@ -523,6 +730,180 @@ func makePermissionsGraphFromModel(ctx context.Context, data PermissionsGraphRes
 	}, diags
 }
 
+// Resolves the IDs of the groups and databases referenced by name in the permissions of the model.
+// When `required` is false, names which do not match any group or database leave the ID unknown, as the group or
+// database may be created during the apply. Otherwise an error is returned.
+func resolvePermissionsGraphModelNames(ctx context.Context, names *permissionsGraphNames, data *PermissionsGraphResourceModel, required bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Permissions.IsNull() || data.Permissions.IsUnknown() {
+		return diags
+	}
+
+	permissions := make([]DatabasePermissions, 0, len(data.Permissions.Elements()))
+	diags.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, p := range permissions {
+		if !p.GroupName.IsNull() && !p.GroupName.IsUnknown() {
+			groupId, groupDiags := names.groupId(ctx, p.GroupName.ValueString())
+			diags.Append(groupDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if groupId != nil {
+				permissions[i].Group = types.Int64Value(int64(*groupId))
+			} else if required {
+				diags.AddError("Permissions group not found.", fmt.Sprintf("No Metabase permissions group is named %q.", p.GroupName.ValueString()))
+				return diags
+			} else {
+				permissions[i].Group = types.Int64Unknown()
+			}
+		}
+
+		if !p.DatabaseName.IsNull() && !p.DatabaseName.IsUnknown() {
+			databaseId, databaseDiags := names.databaseId(ctx, p.DatabaseName.ValueString())
+			diags.Append(databaseDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if databaseId != nil {
+				permissions[i].Database = types.Int64Value(int64(*databaseId))
+			} else if required {
+				diags.AddError("Database not found.", fmt.Sprintf("No Metabase database is named %q.", p.DatabaseName.ValueString()))
+				return diags
+			} else {
+				permissions[i].Database = types.Int64Unknown()
+			}
+		}
+	}
+
+	permissionsSet, setDiags := types.SetValueFrom(ctx, databasePermissionsObjectType, permissions)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Permissions = permissionsSet
+
+	return diags
+}
+
+// Sets the names of the groups and databases referenced by ID in the permissions of the model, such that configurations
+// referencing them by name do not differ from the state, e.g. after importing the graph. Names are left unknown when the
+// ID is not known yet, and null when the database name is ambiguous and cannot be used as a reference.
+func fillPermissionsGraphModelNames(ctx context.Context, names *permissionsGraphNames, data *PermissionsGraphResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Permissions.IsNull() || data.Permissions.IsUnknown() {
+		return diags
+	}
+
+	permissions := make([]DatabasePermissions, 0, len(data.Permissions.Elements()))
+	diags.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, p := range permissions {
+		if p.GroupName.IsNull() || p.GroupName.IsUnknown() {
+			if p.Group.IsUnknown() {
+				permissions[i].GroupName = types.StringUnknown()
+			} else {
+				groupName, groupDiags := names.groupName(ctx, int(p.Group.ValueInt64()))
+				diags.Append(groupDiags...)
+				if diags.HasError() {
+					return diags
+				}
+
+				permissions[i].GroupName = types.StringPointerValue(groupName)
+			}
+		}
+
+		if p.DatabaseName.IsNull() || p.DatabaseName.IsUnknown() {
+			if p.Database.IsUnknown() {
+				permissions[i].DatabaseName = types.StringUnknown()
+			} else {
+				databaseName, databaseDiags := names.uniqueDatabaseName(ctx, int(p.Database.ValueInt64()))
+				diags.Append(databaseDiags...)
+				if diags.HasError() {
+					return diags
+				}
+
+				permissions[i].DatabaseName = types.StringPointerValue(databaseName)
+			}
+		}
+	}
+
+	permissionsSet, setDiags := types.SetValueFrom(ctx, databasePermissionsObjectType, permissions)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Permissions = permissionsSet
+
+	return diags
+}
+
+func (r *PermissionsGraphResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PermissionsGraphResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Permissions.IsUnknown() {
+		return
+	}
+
+	permissions := make([]DatabasePermissions, 0, len(data.Permissions.Elements()))
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range permissions {
+		resp.Diagnostics.Append(validatePermissionsGraphKey(p.Group, p.GroupName, "group", "group_name")...)
+		resp.Diagnostics.Append(validatePermissionsGraphKey(p.Database, p.DatabaseName, "database", "database_name")...)
+		resp.Diagnostics.Append(validateGranularPermissions(ctx, p)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+func (r *PermissionsGraphResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Names cannot be resolved when the provider is not configured yet, or when the resource is destroyed.
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data PermissionsGraphResourceModel
+
+	// The permissions are planned from the configuration, as the names computed for set elements in the prior state
+	// cannot be matched reliably with the elements of the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("permissions"), &data.Permissions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := newPermissionsGraphNames(r.client)
+
+	resp.Diagnostics.Append(resolvePermissionsGraphModelNames(ctx, names, &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(fillPermissionsGraphModelNames(ctx, names, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permissions"), data.Permissions)...)
+}
+
 func (r *PermissionsGraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	resp.Diagnostics.AddError("Creating the permissions graph is not allowed, import it instead.", "")
 }
@@ -542,7 +923,14 @@ func (r *PermissionsGraphResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromPermissionsGraph(ctx, *getResp.JSON200, data)...)
+	names := newPermissionsGraphNames(r.client)
+
+	resp.Diagnostics.Append(updateModelFromPermissionsGraph(ctx, names, *getResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(fillPermissionsGraphModelNames(ctx, names, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -563,6 +951,14 @@ func (r *PermissionsGraphResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	names := newPermissionsGraphNames(r.client)
+
+	// Names which could not be resolved when planning reference groups or databases created during the apply.
+	resp.Diagnostics.Append(resolvePermissionsGraphModelNames(ctx, names, data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body, diags := makePermissionsGraphFromModel(ctx, *data, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -576,7 +972,12 @@ func (r *PermissionsGraphResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromPermissionsGraph(ctx, *updateResp.JSON200, data)...)
+	resp.Diagnostics.Append(updateModelFromPermissionsGraph(ctx, names, *updateResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(fillPermissionsGraphModelNames(ctx, names, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/occam-bci/terraform-provider-metabase/metabase"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func testAccPermissionsGraphResource(createQueries, viewData string) string {
@@ -36,6 +37,42 @@ resource "metabase_permissions_graph" "graph" {
 	)
 }
 
+// Returns the permissions graph granting granular permissions to create queries on the sample database.
+func testAccPermissionsGraphResourceWithSchemas(schemas string) string {
+	return fmt.Sprintf(`
+import {
+  to = metabase_permissions_graph.graph
+  id = "1"
+}
+
+data "metabase_table" "sample" {
+  for_each = toset(["ACCOUNTS", "ANALYTIC_EVENTS", "FEEDBACK", "INVOICES", "ORDERS", "PEOPLE", "PRODUCTS", "REVIEWS"])
+
+  db_id  = 1
+  schema = "PUBLIC"
+  name   = each.key
+}
+
+resource "metabase_permissions_graph" "graph" {
+  advanced_permissions = false
+
+  permissions = [
+    {
+      group    = 1
+      database = 1
+      download = {
+        schemas = "full"
+      }
+      view_data = "unrestricted"
+      schema    = %s
+    },
+  ]
+}
+	`,
+		schemas,
+	)
+}
+
 func TestAccPermissionsGraphResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -70,6 +107,115 @@ func TestAccPermissionsGraphResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("metabase_permissions_graph.graph", "revision"),
 				),
 			},
+			// Metabase reports a permission set on the only schema of the database as a permission on the database.
+			{
+				Config: providerApiKeyConfig + testAccPermissionsGraphResourceWithSchemas(`[
+        {
+          name           = "PUBLIC"
+          create_queries = "query-builder"
+        },
+      ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_permissions_graph.graph", "permissions.0.schema.#", "1"),
+					resource.TestCheckResourceAttr("metabase_permissions_graph.graph", "permissions.0.schema.0.create_queries", "query-builder"),
+					resource.TestCheckNoResourceAttr("metabase_permissions_graph.graph", "permissions.0.create_queries"),
+				),
+			},
+			{
+				Config: providerApiKeyConfig + testAccPermissionsGraphResourceWithSchemas(`[
+        {
+          name = "PUBLIC"
+          table = [
+            for name, table in data.metabase_table.sample : {
+              id             = table.id
+              create_queries = contains(["ORDERS", "PRODUCTS"], name) ? "query-builder" : "no"
+            }
+          ]
+        },
+      ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_permissions_graph.graph", "permissions.0.schema.#", "1"),
+					resource.TestCheckResourceAttr("metabase_permissions_graph.graph", "permissions.0.schema.0.table.#", "8"),
+					resource.TestCheckNoResourceAttr("metabase_permissions_graph.graph", "permissions.0.schema.0.create_queries"),
+				),
+			},
+			// The advanced permissions flag is not part of the graph returned by Metabase.
+			{
+				ResourceName:                         "metabase_permissions_graph.graph",
+				ImportState:                          true,
+				ImportStateId:                        "1",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "revision",
+				ImportStateVerifyIgnore:              []string{"advanced_permissions"},
+			},
+		},
+	})
+}
+
+func testAccPermissionsGraphResourceWithNames(createQueries string) string {
+	return fmt.Sprintf(`
+import {
+  to = metabase_permissions_graph.graph
+  id = "1"
+}
+
+resource "metabase_permissions_graph" "graph" {
+  advanced_permissions = false
+
+  permissions = [
+    {
+      group_name    = "All Users"
+      database_name = "Sample Database"
+      download = {
+        schemas = "full"
+      }
+      view_data      = "unrestricted"
+      create_queries = "%s"
+    },
+  ]
+}
+	`,
+		createQueries,
+	)
+}
+
+func TestAccPermissionsGraphResourceWithNames(t *testing.T) {
+	config := providerApiKeyConfig + testAccPermissionsGraphResourceWithNames(string(metabase.PermissionsGraphDatabasePermissionsCreateQueriesQueryBuilder))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_permissions_graph.graph", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("metabase_permissions_graph.graph", "permissions.0.group", "1"),
+					resource.TestCheckResourceAttr("metabase_permissions_graph.graph", "permissions.0.group_name", "All Users"),
+					resource.TestCheckResourceAttr("metabase_permissions_graph.graph", "permissions.0.database", "1"),
+					resource.TestCheckResourceAttr("metabase_permissions_graph.graph", "permissions.0.database_name", "Sample Database"),
+				),
+			},
+			// Importing the graph only sets the IDs of groups and databases, from which the names are computed. The
+			// `advanced_permissions` attribute is not imported, but the permissions must not change.
+			{
+				ResourceName:           "metabase_permissions_graph.graph",
+				ImportState:            true,
+				ImportStateKind:        resource.ImportBlockWithID,
+				ImportStateConfigExact: true,
+				ExpectNonEmptyPlan:     true,
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						testAccExpectUnchangedAttribute("metabase_permissions_graph.graph", "permissions"),
+					},
+				},
+			},
+			{
+				Config: providerApiKeyConfig + testAccPermissionsGraphResourceWithNames(string(metabase.PermissionsGraphDatabasePermissionsCreateQueriesQueryBuilderAndNative)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_permissions_graph.graph", "permissions.0.create_queries", "query-builder-and-native"),
+					resource.TestCheckResourceAttr("metabase_permissions_graph.graph", "permissions.0.group_name", "All Users"),
+				),
+			},
 		},
 	})
 }
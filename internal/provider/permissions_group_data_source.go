@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionsGroupDataSource{}
+
+// Creates a new permissions group data source.
+func NewPermissionsGroupDataSource() datasource.DataSource {
+	return &PermissionsGroupDataSource{}
+}
+
+// A data source finding an existing Metabase permissions group by name.
+type PermissionsGroupDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the permissions group data source.
+type PermissionsGroupDataSourceModel struct {
+	Id          types.Int64  `tfsdk:"id"`           // The ID of the group.
+	Name        types.String `tfsdk:"name"`         // The name of the group.
+	MemberCount types.Int64  `tfsdk:"member_count"` // The number of members in the group.
+}
+
+func (d *PermissionsGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions_group"
+}
+
+func (d *PermissionsGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `An existing Metabase permissions group, found using its name.
+
+This data source can be used to reference groups which are not managed by Terraform, e.g. the built-in ` + "`All Users`" + ` and ` + "`Administrators`" + ` groups, or groups synchronized from an identity provider.`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Required:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the group.",
+				Computed:            true,
+			},
+			"member_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of members in the group.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PermissionsGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Lists all the permissions groups in the Metabase instance.
+func listPermissionsGroups(ctx context.Context, client *metabase.ClientWithResponses) ([]metabase.PermissionsGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	listResp, err := client.ListPermissionsGroupsWithResponse(ctx)
+
+	diags.Append(checkMetabaseResponse(listResp, err, []int{200}, "list permissions groups")...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return *listResp.JSON200, diags
+}
+
+func (d *PermissionsGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionsGroupDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, diags := listPermissionsGroups(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, g := range groups {
+		if g.Name == data.Name.ValueString() {
+			data.Id = types.Int64Value(int64(g.Id))
+			data.MemberCount = int64ValueOrNull(g.MemberCount)
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("Permissions group not found.", fmt.Sprintf("No Metabase permissions group is named %q.", data.Name.ValueString()))
+}
@@ -0,0 +1,417 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &PermissionsGroupMembersResource{}
+var _ resource.ResourceWithValidateConfig = &PermissionsGroupMembersResource{}
+var _ resource.ResourceWithModifyPlan = &PermissionsGroupMembersResource{}
+
+// Creates a new permissions group members resource.
+func NewPermissionsGroupMembersResource() resource.Resource {
+	return &PermissionsGroupMembersResource{
+		MetabaseBaseResource{name: "permissions_group_members"},
+	}
+}
+
+// A resource authoritatively handling the members of a permissions group.
+type PermissionsGroupMembersResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for the members of a permissions group.
+type PermissionsGroupMembersResourceModel struct {
+	Id                  types.Int64 `tfsdk:"id"`                   // The ID of the permissions group.
+	GroupId             types.Int64 `tfsdk:"group_id"`             // The ID of the permissions group.
+	UserIds             types.Set   `tfsdk:"user_ids"`             // The IDs of the users who are members of the group.
+	ManagerIds          types.Set   `tfsdk:"manager_ids"`          // The IDs of the members who are managers of the group.
+	AllowAdministrators types.Bool  `tfsdk:"allow_administrators"` // Whether the group can be the Administrators group.
+}
+
+func (r *PermissionsGroupMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The complete list of members of a Metabase permissions group.
+
+This resource is authoritative: users added to the group outside of Terraform (e.g. in the Metabase UI) are reported as drift and removed when applying. It should not be used alongside ` + "`metabase_permissions_group_membership`" + ` resources for the same group.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permissions group.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permissions group. The All Users group cannot be managed, as all users automatically belong to it.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the users who are members of the group.",
+				ElementType:         types.Int64Type,
+				Required:            true,
+			},
+			"manager_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the members who are managers of the group. This requires advanced permissions. Each ID should also be listed in `user_ids`. If not set, group managers are not managed by Terraform.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"allow_administrators": schema.BoolAttribute{
+				MarkdownDescription: "Must be set to `true` to manage the members of the Administrators group. This protects against accidentally removing all administrators.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Checks that the group of a `PermissionsGroupMembersResourceModel` is not the All Users group, nor the Administrators
+// group unless it is explicitly allowed, given the IDs of both groups.
+func validatePermissionsGroupMembersGroup(data PermissionsGroupMembersResourceModel, allUsersGroupId int, administratorsGroupId int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.GroupId.IsUnknown() || data.GroupId.IsNull() {
+		return diags
+	}
+
+	switch int(data.GroupId.ValueInt64()) {
+	case allUsersGroupId:
+		diags.AddAttributeError(
+			path.Root("group_id"),
+			"Unsupported permissions group.",
+			"The members of the All Users group cannot be managed, as all users automatically belong to it.",
+		)
+	case administratorsGroupId:
+		if !data.AllowAdministrators.ValueBool() {
+			diags.AddAttributeError(
+				path.Root("group_id"),
+				"Protected permissions group.",
+				"Set `allow_administrators` to `true` to manage the members of the Administrators group.",
+			)
+		}
+	}
+
+	return diags
+}
+
+// Checks the group of a `PermissionsGroupMembersResourceModel`, finding the All Users and Administrators groups using
+// their type rather than assuming their IDs.
+func checkPermissionsGroupMembersGroup(ctx context.Context, client *metabase.ClientWithResponses, data PermissionsGroupMembersResourceModel) diag.Diagnostics {
+	names := newPermissionsGraphNames(client)
+
+	allUsersGroupId, diags := names.magicGroupId(ctx, metabase.AllUsersMagicGroupType, metabase.AllUsersPermissionsGroupId)
+	if diags.HasError() {
+		return diags
+	}
+
+	administratorsGroupId, groupDiags := names.magicGroupId(ctx, metabase.AdministratorsMagicGroupType, metabase.AdministratorsPermissionsGroupId)
+	diags.Append(groupDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(validatePermissionsGroupMembersGroup(data, allUsersGroupId, administratorsGroupId)...)
+
+	return diags
+}
+
+// Validates the managers of a `PermissionsGroupMembersResourceModel`. The group is checked when planning, as the IDs of
+// the All Users and Administrators groups must be fetched from Metabase.
+func validatePermissionsGroupMembersModel(ctx context.Context, data PermissionsGroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.UserIds.IsUnknown() || data.ManagerIds.IsUnknown() || data.ManagerIds.IsNull() {
+		return diags
+	}
+
+	// IDs may be unknown when they reference users created in the same plan, in which case they cannot be compared.
+	var userIds, managerIds []types.Int64
+	diags.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+	diags.Append(data.ManagerIds.ElementsAs(ctx, &managerIds, false)...)
+	if diags.HasError() || slices.ContainsFunc(userIds, types.Int64.IsUnknown) {
+		return diags
+	}
+
+	for _, managerId := range managerIds {
+		if !managerId.IsUnknown() && !slices.Contains(userIds, managerId) {
+			diags.AddAttributeError(
+				path.Root("manager_ids"),
+				"Manager is not a member.",
+				fmt.Sprintf("User %d is listed as a manager of the group, but not in `user_ids`.", managerId.ValueInt64()),
+			)
+		}
+	}
+
+	return diags
+}
+
+func (r *PermissionsGroupMembersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PermissionsGroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePermissionsGroupMembersModel(ctx, data)...)
+}
+
+func (r *PermissionsGroupMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Groups cannot be fetched when the provider is not configured yet, and need not be checked when the resource is
+	// destroyed.
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data PermissionsGroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.GroupId.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkPermissionsGroupMembersGroup(ctx, r.client, data)...)
+}
+
+// Updates the given `PermissionsGroupMembersResourceModel` from the members returned by the Metabase API. Managers
+// are only set if they are managed by Terraform.
+func updateModelFromPermissionsGroupMembers(ctx context.Context, members []metabase.PermissionsGroupMember, data *PermissionsGroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	userIds := make([]int64, 0, len(members))
+	managerIds := []int64{}
+	for _, member := range members {
+		userIds = append(userIds, int64(member.UserId))
+		if member.IsGroupManager != nil && *member.IsGroupManager {
+			managerIds = append(managerIds, int64(member.UserId))
+		}
+	}
+
+	userIdsValue, setDiags := types.SetValueFrom(ctx, types.Int64Type, userIds)
+	diags.Append(setDiags...)
+	data.UserIds = userIdsValue
+
+	if !data.ManagerIds.IsNull() {
+		managerIdsValue, setDiags := types.SetValueFrom(ctx, types.Int64Type, managerIds)
+		diags.Append(setDiags...)
+		data.ManagerIds = managerIdsValue
+	}
+
+	data.Id = data.GroupId
+
+	return diags
+}
+
+// Adds, removes, and updates the members of a permissions group such that they match the given model. Managers are
+// only updated if they are managed by Terraform.
+func updatePermissionsGroupMembers(ctx context.Context, client *metabase.ClientWithResponses, data PermissionsGroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupId := int(data.GroupId.ValueInt64())
+
+	var userIds []int64
+	diags.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+
+	var managerIds []int64
+	if !data.ManagerIds.IsNull() {
+		diags.Append(data.ManagerIds.ElementsAs(ctx, &managerIds, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	members, found, diags := getPermissionsGroupMembers(ctx, client, groupId)
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		diags.AddError("Permissions group not found.", fmt.Sprintf("The permissions group %d does not exist.", groupId))
+		return diags
+	}
+
+	currentMembers := make(map[int64]metabase.PermissionsGroupMember, len(members))
+	for _, member := range members {
+		currentMembers[int64(member.UserId)] = member
+	}
+
+	// Members added outside of Terraform are removed.
+	for userId, member := range currentMembers {
+		if slices.Contains(userIds, userId) {
+			continue
+		}
+
+		diags.Append(deletePermissionsGroupMember(ctx, client, groupId, member)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, userId := range userIds {
+		var isGroupManager *bool
+		if !data.ManagerIds.IsNull() {
+			isGroupManager = new(bool)
+			*isGroupManager = slices.Contains(managerIds, userId)
+		}
+
+		member, isMember := currentMembers[userId]
+		if !isMember {
+			// Managers are not set when creating memberships if they are not managed, as this requires advanced
+			// permissions.
+			if isGroupManager != nil && !*isGroupManager {
+				isGroupManager = nil
+			}
+
+			diags.Append(createPermissionsMembership(ctx, client, int(userId), groupId, isGroupManager)...)
+		} else if isGroupManager != nil && *isGroupManager != (member.IsGroupManager != nil && *member.IsGroupManager) {
+			diags.Append(updatePermissionsGroupMember(ctx, client, groupId, member, *isGroupManager)...)
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Reads the members of the group and updates the model accordingly.
+func (r *PermissionsGroupMembersResource) readMembers(ctx context.Context, data *PermissionsGroupMembersResourceModel) (bool, diag.Diagnostics) {
+	members, found, diags := getPermissionsGroupMembers(ctx, r.client, int(data.GroupId.ValueInt64()))
+	if diags.HasError() || !found {
+		return found, diags
+	}
+
+	diags.Append(updateModelFromPermissionsGroupMembers(ctx, members, data)...)
+
+	return true, diags
+}
+
+func (r *PermissionsGroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PermissionsGroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The group may only be known when applying, in which case it could not be checked when planning.
+	resp.Diagnostics.Append(checkPermissionsGroupMembersGroup(ctx, r.client, *data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updatePermissionsGroupMembers(ctx, r.client, *data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := r.readMembers(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionsGroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PermissionsGroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.readMembers(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionsGroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PermissionsGroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updatePermissionsGroupMembers(ctx, r.client, *data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := r.readMembers(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionsGroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *PermissionsGroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupId := int(data.GroupId.ValueInt64())
+
+	var userIds []int64
+	resp.Diagnostics.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, found, diags := getPermissionsGroupMembers(ctx, r.client, groupId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !found {
+		return
+	}
+
+	// Only the members managed by Terraform are removed from the group.
+	for _, member := range members {
+		if !slices.Contains(userIds, int64(member.UserId)) {
+			continue
+		}
+
+		resp.Diagnostics.Append(deletePermissionsGroupMember(ctx, r.client, groupId, member)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+func (r *PermissionsGroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to convert ID to an integer.", req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), groupId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...)
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func makeTestInt64Set(values ...attr.Value) types.Set {
+	return types.SetValueMust(types.Int64Type, values)
+}
+
+func TestValidatePermissionsGroupMembersModel(t *testing.T) {
+	ctx := context.Background()
+
+	base := PermissionsGroupMembersResourceModel{
+		GroupId:             types.Int64Value(3),
+		UserIds:             makeTestInt64Set(types.Int64Value(7), types.Int64Value(8)),
+		ManagerIds:          makeTestInt64Set(types.Int64Value(8)),
+		AllowAdministrators: types.BoolNull(),
+	}
+
+	tests := []struct {
+		name     string
+		data     func() PermissionsGroupMembersResourceModel
+		hasError bool
+	}{
+		{name: "valid", data: func() PermissionsGroupMembersResourceModel { return base }},
+		{
+			// The group is checked when planning, without assuming the IDs of the All Users and Administrators groups.
+			name: "administrators group",
+			data: func() PermissionsGroupMembersResourceModel {
+				d := base
+				d.GroupId = types.Int64Value(metabase.AdministratorsPermissionsGroupId)
+				return d
+			},
+		},
+		{
+			name: "manager not a member",
+			data: func() PermissionsGroupMembersResourceModel {
+				d := base
+				d.ManagerIds = makeTestInt64Set(types.Int64Value(9))
+				return d
+			},
+			hasError: true,
+		},
+		{
+			name: "unknown member",
+			data: func() PermissionsGroupMembersResourceModel {
+				d := base
+				d.UserIds = makeTestInt64Set(types.Int64Value(7), types.Int64Unknown())
+				d.ManagerIds = makeTestInt64Set(types.Int64Value(9))
+				return d
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validatePermissionsGroupMembersModel(ctx, tt.data())
+			if diags.HasError() != tt.hasError {
+				t.Errorf("validatePermissionsGroupMembersModel() errors = %v, want error: %v", diags, tt.hasError)
+			}
+		})
+	}
+}
+
+func testAccPermissionsGroupMembersResource(name string, members []string) string {
+	userIds := []string{}
+	for _, member := range members {
+		userIds = append(userIds, fmt.Sprintf("metabase_user.%s_%s.id", name, member))
+	}
+
+	return fmt.Sprintf(`
+resource "metabase_permissions_group" "%s" {
+  name = "👥 Members"
+}
+
+resource "metabase_permissions_group_members" "%s" {
+  group_id = metabase_permissions_group.%s.id
+  user_ids = [%s]
+}
+`,
+		name,
+		name,
+		name,
+		strings.Join(userIds, ", "),
+	) +
+		testAccUserResource(name+"_a", "members-a@example.com", "Member", "A") +
+		testAccUserResource(name+"_b", "members-b@example.com", "Member", "B") +
+		testAccUserResource(name+"_c", "members-c@example.com", "Member", "C")
+}
+
+// Checks that the members of the group are exactly the given users.
+func testAccCheckPermissionsGroupMembers(groupResourceName string, userResourceNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		groupRs, ok := s.RootModule().Resources[groupResourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state.", groupResourceName)
+		}
+
+		groupId, err := strconv.Atoi(groupRs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		expected := []int{}
+		for _, userResourceName := range userResourceNames {
+			userRs, ok := s.RootModule().Resources[userResourceName]
+			if !ok {
+				return fmt.Errorf("Failed to find resource %s in state.", userResourceName)
+			}
+
+			userId, err := strconv.Atoi(userRs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			expected = append(expected, userId)
+		}
+
+		members, _, diags := getPermissionsGroupMembers(context.Background(), testAccMetabaseClient, groupId)
+		if diags.HasError() {
+			return fmt.Errorf("Failed to list group members: %v.", diags)
+		}
+
+		userIds := []int{}
+		for _, member := range members {
+			userIds = append(userIds, member.UserId)
+		}
+
+		slices.Sort(expected)
+		slices.Sort(userIds)
+		if !slices.Equal(userIds, expected) {
+			return fmt.Errorf("Expected group members %v, got %v.", expected, userIds)
+		}
+
+		return nil
+	}
+}
+
+func TestAccPermissionsGroupMembersResource(t *testing.T) {
+	var groupId, userCId int
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckUserDestroy,
+			testAccCheckPermissionsGroupDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccPermissionsGroupMembersResource("test_members", []string{"a", "b"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccStoreResourceId("metabase_permissions_group.test_members", &groupId),
+					testAccStoreResourceId("metabase_user.test_members_c", &userCId),
+					testAccCheckPermissionsGroupMembers(
+						"metabase_permissions_group.test_members",
+						"metabase_user.test_members_a",
+						"metabase_user.test_members_b",
+					),
+					resource.TestCheckResourceAttrPair("metabase_permissions_group_members.test_members", "id", "metabase_permissions_group.test_members", "id"),
+					resource.TestCheckResourceAttr("metabase_permissions_group_members.test_members", "user_ids.#", "2"),
+					resource.TestCheckNoResourceAttr("metabase_permissions_group_members.test_members", "manager_ids"),
+				),
+			},
+			{
+				ResourceName:      "metabase_permissions_group_members.test_members",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Users added in the Metabase UI are reported as drift.
+				PreConfig: func() {
+					if diags := createPermissionsMembership(context.Background(), testAccMetabaseClient, userCId, groupId, nil); diags.HasError() {
+						t.Fatalf("Failed to add the user to the group: %v.", diags)
+					}
+				},
+				Config:             providerApiKeyConfig + testAccPermissionsGroupMembersResource("test_members", []string{"a", "b"}),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: providerApiKeyConfig + testAccPermissionsGroupMembersResource("test_members", []string{"a", "b"}),
+				Check: testAccCheckPermissionsGroupMembers(
+					"metabase_permissions_group.test_members",
+					"metabase_user.test_members_a",
+					"metabase_user.test_members_b",
+				),
+			},
+			{
+				Config: providerApiKeyConfig + testAccPermissionsGroupMembersResource("test_members", []string{"b", "c"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPermissionsGroupMembers(
+						"metabase_permissions_group.test_members",
+						"metabase_user.test_members_b",
+						"metabase_user.test_members_c",
+					),
+					resource.TestCheckResourceAttr("metabase_permissions_group_members.test_members", "user_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPermissionsGroupMembersResourceProtectedGroups(t *testing.T) {
+	config := func(groupName string) string {
+		return providerApiKeyConfig + fmt.Sprintf(`
+data "metabase_permissions_group" "protected" {
+  name = "%s"
+}
+
+resource "metabase_permissions_group_members" "protected" {
+  group_id = data.metabase_permissions_group.protected.id
+  user_ids = []
+}
+`,
+			groupName,
+		)
+	}
+
+	// The groups are found using their type when planning, such that no membership is modified.
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("Administrators"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Protected permissions group"),
+			},
+			{
+				Config:      config("All Users"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unsupported permissions group"),
+			},
+		},
+	})
+}
+
+func TestCheckPermissionsGroupMembersGroupStandIn(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	// The Administrators group does not have its usual ID.
+	serveStandInPermissionsGraphNames(s)
+	client := s.client()
+
+	tests := []struct {
+		name                string
+		groupId             int64
+		allowAdministrators types.Bool
+		hasError            bool
+	}{
+		{name: "regular group", groupId: 3, allowAdministrators: types.BoolNull()},
+		{name: "default administrators ID", groupId: metabase.AdministratorsPermissionsGroupId, allowAdministrators: types.BoolNull()},
+		{name: "all users group", groupId: 1, allowAdministrators: types.BoolNull(), hasError: true},
+		{name: "administrators group", groupId: 7, allowAdministrators: types.BoolNull(), hasError: true},
+		{name: "allowed administrators group", groupId: 7, allowAdministrators: types.BoolValue(true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := PermissionsGroupMembersResourceModel{
+				GroupId:             types.Int64Value(tt.groupId),
+				UserIds:             makeTestInt64Set(types.Int64Value(8)),
+				ManagerIds:          types.SetNull(types.Int64Type),
+				AllowAdministrators: tt.allowAdministrators,
+			}
+
+			diags := checkPermissionsGroupMembersGroup(ctx, client, data)
+			if diags.HasError() != tt.hasError {
+				t.Errorf("checkPermissionsGroupMembersGroup() errors = %v, want error: %v", diags, tt.hasError)
+			}
+		})
+	}
+}
@@ -1,14 +1,17 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -60,86 +63,242 @@ func (r *PermissionsGroupMembershipResource) Schema(ctx context.Context, req res
 				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
 			},
 			"is_group_manager": schema.BoolAttribute{
-				MarkdownDescription: "Whether the user is a manager of this group.",
+				MarkdownDescription: "Whether the user is a manager of this group (Pro and Enterprise editions). If not set, it is not managed by Terraform.",
 				Optional:            true,
 			},
 		},
 	}
 }
 
-func (r *PermissionsGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data *PermissionsGroupMembershipResourceModel
+// Serializes the updates made to the group memberships of each user through the user endpoint, which replaces the
+// entire list of memberships. Keys are user IDs and values are `*sync.Mutex`.
+var userMembershipsLocks sync.Map
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+// Locks the group memberships of the given user, and returns the function unlocking them.
+func lockUserMemberships(userId int) func() {
+	lock, _ := userMembershipsLocks.LoadOrStore(userId, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
 
-	userId := int(data.UserId.ValueInt64())
-	groupId := int(data.GroupId.ValueInt64())
-	isGroupManager := false
-	if !data.IsGroupManager.IsNull() && !data.IsGroupManager.IsUnknown() {
-		isGroupManager = data.IsGroupManager.ValueBool()
-	}
+// Replaces the group memberships of a user with the list returned by the `edit` function, using the user endpoint.
+// This is only used as a fallback for versions of Metabase which do not support the membership endpoints. As the
+// entire list is replaced, updates for the same user are serialized such that concurrent changes are not lost.
+func updateUserMemberships(
+	ctx context.Context,
+	client *metabase.ClientWithResponses,
+	userId int,
+	edit func(memberships []metabase.UserGroupMembership) ([]metabase.UserGroupMembership, diag.Diagnostics),
+) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	// Read the user to get current memberships
-	getUserResp, err := r.client.GetUserWithResponse(ctx, userId)
-	resp.Diagnostics.Append(checkMetabaseResponse(getUserResp, err, []int{200}, "get user")...)
-	if resp.Diagnostics.HasError() {
-		return
+	unlock := lockUserMemberships(userId)
+	defer unlock()
+
+	getUserResp, err := client.GetUserWithResponse(ctx, userId)
+	diags.Append(checkMetabaseResponse(getUserResp, err, []int{200}, "get user")...)
+	if diags.HasError() {
+		return diags
 	}
 
-	// Parse user with memberships
 	// Use Body field which already contains the read bytes (HTTPResponse.Body is already closed)
 	var userWithMemberships metabase.UserWithMemberships
 	if err := json.Unmarshal(getUserResp.Body, &userWithMemberships); err != nil {
-		resp.Diagnostics.AddError("Failed to parse user response", err.Error())
-		return
+		diags.AddError("Failed to parse user response", err.Error())
+		return diags
 	}
 
-	// Check if membership already exists
-	for _, membership := range userWithMemberships.UserGroupMemberships {
-		if membership.Id == groupId {
-			resp.Diagnostics.AddError("Membership already exists", fmt.Sprintf("User %d is already a member of group %d", userId, groupId))
-			return
-		}
+	memberships, editDiags := edit(userWithMemberships.UserGroupMemberships)
+	diags.Append(editDiags...)
+	if diags.HasError() {
+		return diags
 	}
 
-	// Add the new membership
-	memberships := userWithMemberships.UserGroupMemberships
-	memberships = append(memberships, metabase.UserGroupMembership{
-		Id:             groupId,
-		IsGroupManager: isGroupManager,
-	})
-
-	// Update user with new memberships
-	email := userWithMemberships.Email
-	firstName := userWithMemberships.FirstName
-	lastName := userWithMemberships.LastName
-
 	updateBody := metabase.UpdateUserBodyWithMemberships{
-		Email:                &email,
-		FirstName:            &firstName,
-		LastName:             &lastName,
+		Email:                &userWithMemberships.Email,
+		FirstName:            &userWithMemberships.FirstName,
+		LastName:             &userWithMemberships.LastName,
 		UserGroupMemberships: &memberships,
 	}
 
 	jsonBody, err := json.Marshal(updateBody)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to marshal update request", err.Error())
-		return
+		diags.AddError("Failed to marshal update request", err.Error())
+		return diags
 	}
 
-	httpResp, err := r.client.DoHTTPRequest(ctx, "PUT", fmt.Sprintf("user/%d", userId), strings.NewReader(string(jsonBody)))
+	httpResp, err := client.DoHTTPRequest(ctx, "PUT", fmt.Sprintf("user/%d", userId), bytes.NewReader(jsonBody))
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create membership", err.Error())
-		return
+		diags.AddError("Failed to update user memberships", err.Error())
+		return diags
 	}
 	defer httpResp.Body.Close()
 
 	if httpResp.StatusCode != 200 {
 		bodyBytes, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("Failed to create membership", fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(bodyBytes)))
+		diags.AddError("Failed to update user memberships", fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(bodyBytes)))
+		return diags
+	}
+
+	return diags
+}
+
+// Lists the members of a permissions group. The returned boolean is false if the group does not exist.
+func getPermissionsGroupMembers(ctx context.Context, client *metabase.ClientWithResponses, groupId int) ([]metabase.PermissionsGroupMember, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	getResp, err := client.GetPermissionsGroupWithResponse(ctx, groupId)
+	diags.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get permissions group")...)
+	if diags.HasError() || getResp.StatusCode() == 404 {
+		return nil, false, diags
+	}
+
+	if getResp.JSON200.Members == nil {
+		return []metabase.PermissionsGroupMember{}, true, diags
+	}
+
+	return *getResp.JSON200.Members, true, diags
+}
+
+// Finds the membership of a user in a permissions group, using the members of the group. Returns nil if the user is
+// not a member of the group.
+func findPermissionsMembership(ctx context.Context, client *metabase.ClientWithResponses, userId int, groupId int) (*metabase.PermissionsGroupMember, diag.Diagnostics) {
+	members, _, diags := getPermissionsGroupMembers(ctx, client, groupId)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, member := range members {
+		if member.UserId == userId {
+			return &member, diags
+		}
+	}
+
+	return nil, diags
+}
+
+// Adds a user to a permissions group using the membership endpoint, which does not affect other memberships of the
+// user. Falls back to the user endpoint if the membership endpoint is not supported.
+func createPermissionsMembership(ctx context.Context, client *metabase.ClientWithResponses, userId int, groupId int, isGroupManager *bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	createResp, err := client.CreatePermissionsMembershipWithResponse(ctx, metabase.CreatePermissionsMembershipBody{
+		GroupId:        groupId,
+		UserId:         userId,
+		IsGroupManager: isGroupManager,
+	})
+	diags.Append(checkMetabaseResponse(createResp, err, []int{200, 404}, "create permissions membership")...)
+	if diags.HasError() || createResp.StatusCode() == 200 {
+		return diags
+	}
+
+	return updateUserMemberships(ctx, client, userId, func(memberships []metabase.UserGroupMembership) ([]metabase.UserGroupMembership, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		for _, membership := range memberships {
+			if membership.Id == groupId {
+				diags.AddError("Membership already exists", fmt.Sprintf("User %d is already a member of group %d", userId, groupId))
+				return nil, diags
+			}
+		}
+
+		return append(memberships, metabase.UserGroupMembership{
+			Id:             groupId,
+			IsGroupManager: isGroupManager != nil && *isGroupManager,
+		}), diags
+	})
+}
+
+// Updates whether a user is a manager of a permissions group using the membership endpoint. Falls back to the user
+// endpoint if the membership endpoint is not supported.
+func updatePermissionsMembership(ctx context.Context, client *metabase.ClientWithResponses, userId int, groupId int, isGroupManager bool) diag.Diagnostics {
+	member, diags := findPermissionsMembership(ctx, client, userId, groupId)
+	if diags.HasError() {
+		return diags
+	}
+	if member == nil {
+		diags.AddError("Membership not found", fmt.Sprintf("User %d is not a member of group %d", userId, groupId))
+		return diags
+	}
+
+	diags.Append(updatePermissionsGroupMember(ctx, client, groupId, *member, isGroupManager)...)
+	return diags
+}
+
+// Updates whether the given member of a permissions group is a manager of the group.
+func updatePermissionsGroupMember(ctx context.Context, client *metabase.ClientWithResponses, groupId int, member metabase.PermissionsGroupMember, isGroupManager bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if member.MembershipId != nil {
+		updateResp, err := client.UpdatePermissionsMembershipWithResponse(ctx, *member.MembershipId, metabase.UpdatePermissionsMembershipBody{
+			IsGroupManager: isGroupManager,
+		})
+		diags.Append(checkMetabaseResponse(updateResp, err, []int{200, 404}, "update permissions membership")...)
+		if diags.HasError() || updateResp.StatusCode() == 200 {
+			return diags
+		}
+	}
+
+	return updateUserMemberships(ctx, client, member.UserId, func(memberships []metabase.UserGroupMembership) ([]metabase.UserGroupMembership, diag.Diagnostics) {
+		for i, membership := range memberships {
+			if membership.Id == groupId {
+				memberships[i].IsGroupManager = isGroupManager
+			}
+		}
+
+		return memberships, nil
+	})
+}
+
+// Removes a user from a permissions group using the membership endpoint. Falls back to the user endpoint if the
+// membership endpoint is not supported. Removing a user who is not a member of the group is not an error.
+func deletePermissionsMembership(ctx context.Context, client *metabase.ClientWithResponses, userId int, groupId int) diag.Diagnostics {
+	member, diags := findPermissionsMembership(ctx, client, userId, groupId)
+	if diags.HasError() || member == nil {
+		return diags
+	}
+
+	diags.Append(deletePermissionsGroupMember(ctx, client, groupId, *member)...)
+	return diags
+}
+
+// Removes the given member from a permissions group.
+func deletePermissionsGroupMember(ctx context.Context, client *metabase.ClientWithResponses, groupId int, member metabase.PermissionsGroupMember) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if member.MembershipId != nil {
+		deleteResp, err := client.DeletePermissionsMembershipWithResponse(ctx, *member.MembershipId)
+		diags.Append(checkMetabaseResponse(deleteResp, err, []int{204, 404}, "delete permissions membership")...)
+		if diags.HasError() || deleteResp.StatusCode() == 204 {
+			return diags
+		}
+	}
+
+	return updateUserMemberships(ctx, client, member.UserId, func(memberships []metabase.UserGroupMembership) ([]metabase.UserGroupMembership, diag.Diagnostics) {
+		remaining := []metabase.UserGroupMembership{}
+		for _, membership := range memberships {
+			if membership.Id != groupId {
+				remaining = append(remaining, membership)
+			}
+		}
+
+		return remaining, nil
+	})
+}
+
+func (r *PermissionsGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PermissionsGroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupId := int(data.GroupId.ValueInt64())
+
+	resp.Diagnostics.Append(createPermissionsMembership(ctx, r.client, int(data.UserId.ValueInt64()), groupId, data.IsGroupManager.ValueBoolPointer())...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -185,7 +344,10 @@ func (r *PermissionsGroupMembershipResource) Read(ctx context.Context, req resou
 	for _, membership := range userWithMemberships.UserGroupMemberships {
 		if membership.Id == groupId {
 			found = true
-			data.IsGroupManager = types.BoolValue(membership.IsGroupManager)
+			// Group managers are only managed when set, as they are not returned by Metabase Open Source.
+			if !data.IsGroupManager.IsNull() {
+				data.IsGroupManager = types.BoolValue(membership.IsGroupManager)
+			}
 			break
 		}
 	}
@@ -206,65 +368,11 @@ func (r *PermissionsGroupMembershipResource) Update(ctx context.Context, req res
 		return
 	}
 
-	userId := int(data.UserId.ValueInt64())
-	groupId := int(data.GroupId.ValueInt64())
-	isGroupManager := data.IsGroupManager.ValueBool()
-
-	// Read the user to get current memberships
-	getUserResp, err := r.client.GetUserWithResponse(ctx, userId)
-	resp.Diagnostics.Append(checkMetabaseResponse(getUserResp, err, []int{200}, "get user")...)
+	resp.Diagnostics.Append(updatePermissionsMembership(ctx, r.client, int(data.UserId.ValueInt64()), int(data.GroupId.ValueInt64()), data.IsGroupManager.ValueBool())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Parse user with memberships
-	// Use Body field which already contains the read bytes (HTTPResponse.Body is already closed)
-	var userWithMemberships metabase.UserWithMemberships
-	if err := json.Unmarshal(getUserResp.Body, &userWithMemberships); err != nil {
-		resp.Diagnostics.AddError("Failed to parse user response", err.Error())
-		return
-	}
-
-	// Update the membership
-	memberships := userWithMemberships.UserGroupMemberships
-	for i, membership := range memberships {
-		if membership.Id == groupId {
-			memberships[i].IsGroupManager = isGroupManager
-			break
-		}
-	}
-
-	// Update user with modified memberships
-	email := userWithMemberships.Email
-	firstName := userWithMemberships.FirstName
-	lastName := userWithMemberships.LastName
-
-	updateBody := metabase.UpdateUserBodyWithMemberships{
-		Email:                &email,
-		FirstName:            &firstName,
-		LastName:             &lastName,
-		UserGroupMemberships: &memberships,
-	}
-
-	jsonBody, err := json.Marshal(updateBody)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to marshal update request", err.Error())
-		return
-	}
-
-	httpResp, err := r.client.DoHTTPRequest(ctx, "PUT", fmt.Sprintf("user/%d", userId), strings.NewReader(string(jsonBody)))
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to update membership", err.Error())
-		return
-	}
-	defer httpResp.Body.Close()
-
-	if httpResp.StatusCode != 200 {
-		bodyBytes, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("Failed to update membership", fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(bodyBytes)))
-		return
-	}
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -276,62 +384,7 @@ func (r *PermissionsGroupMembershipResource) Delete(ctx context.Context, req res
 		return
 	}
 
-	userId := int(data.UserId.ValueInt64())
-	groupId := int(data.GroupId.ValueInt64())
-
-	// Read the user to get current memberships
-	getUserResp, err := r.client.GetUserWithResponse(ctx, userId)
-	resp.Diagnostics.Append(checkMetabaseResponse(getUserResp, err, []int{200}, "get user")...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Parse user with memberships
-	// Use Body field which already contains the read bytes (HTTPResponse.Body is already closed)
-	var userWithMemberships metabase.UserWithMemberships
-	if err := json.Unmarshal(getUserResp.Body, &userWithMemberships); err != nil {
-		resp.Diagnostics.AddError("Failed to parse user response", err.Error())
-		return
-	}
-
-	// Remove the membership
-	memberships := []metabase.UserGroupMembership{}
-	for _, membership := range userWithMemberships.UserGroupMemberships {
-		if membership.Id != groupId {
-			memberships = append(memberships, membership)
-		}
-	}
-
-	// Update user with removed membership
-	email := userWithMemberships.Email
-	firstName := userWithMemberships.FirstName
-	lastName := userWithMemberships.LastName
-
-	updateBody := metabase.UpdateUserBodyWithMemberships{
-		Email:                &email,
-		FirstName:            &firstName,
-		LastName:             &lastName,
-		UserGroupMemberships: &memberships,
-	}
-
-	jsonBody, err := json.Marshal(updateBody)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to marshal update request", err.Error())
-		return
-	}
-
-	httpResp, err := r.client.DoHTTPRequest(ctx, "PUT", fmt.Sprintf("user/%d", userId), strings.NewReader(string(jsonBody)))
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to delete membership", err.Error())
-		return
-	}
-	defer httpResp.Body.Close()
-
-	if httpResp.StatusCode != 200 {
-		bodyBytes, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("Failed to delete membership", fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(bodyBytes)))
-		return
-	}
+	resp.Diagnostics.Append(deletePermissionsMembership(ctx, r.client, int(data.UserId.ValueInt64()), int(data.GroupId.ValueInt64()))...)
 }
 
 func (r *PermissionsGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccPermissionsGroupMembershipResource(name string, groups []string) string {
+	groupsConfig := ""
+	for _, group := range groups {
+		groupsConfig += fmt.Sprintf(`
+resource "metabase_permissions_group" "%s_%s" {
+  name = "👥 Membership %s"
+}
+
+resource "metabase_permissions_group_membership" "%s_%s" {
+  user_id  = metabase_user.%s.id
+  group_id = metabase_permissions_group.%s_%s.id
+}
+`,
+			name,
+			group,
+			group,
+			name,
+			group,
+			name,
+			name,
+			group,
+		)
+	}
+
+	return testAccUserResource(name, "membership@example.com", "Member", "Ship") + groupsConfig
+}
+
+// Checks that the user is a member of exactly the given groups, besides the All Users group.
+func testAccCheckUserGroups(userResourceName string, groupResourceNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		userRs, ok := s.RootModule().Resources[userResourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state.", userResourceName)
+		}
+
+		userId, err := strconv.Atoi(userRs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		expected := []int{}
+		for _, groupResourceName := range groupResourceNames {
+			groupRs, ok := s.RootModule().Resources[groupResourceName]
+			if !ok {
+				return fmt.Errorf("Failed to find resource %s in state.", groupResourceName)
+			}
+
+			groupId, err := strconv.Atoi(groupRs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			expected = append(expected, groupId)
+		}
+
+		response, err := testAccMetabaseClient.GetUserWithResponse(context.Background(), userId)
+		if diags := checkMetabaseResponse(response, err, []int{200}, "get user"); diags.HasError() {
+			return fmt.Errorf("Failed to get user: %v.", diags)
+		}
+
+		var user metabase.UserWithMemberships
+		if err := json.Unmarshal(response.Body, &user); err != nil {
+			return err
+		}
+
+		groupIds := []int{}
+		for _, membership := range user.UserGroupMemberships {
+			if membership.Id != metabase.AllUsersPermissionsGroupId {
+				groupIds = append(groupIds, membership.Id)
+			}
+		}
+
+		slices.Sort(expected)
+		slices.Sort(groupIds)
+		if !slices.Equal(groupIds, expected) {
+			return fmt.Errorf("Expected the user to be a member of groups %v, got %v.", expected, groupIds)
+		}
+
+		return nil
+	}
+}
+
+func TestAccPermissionsGroupMembershipResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckUserDestroy,
+			testAccCheckPermissionsGroupDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				// Memberships of the same user are created concurrently, and none of them should be lost.
+				Config: providerApiKeyConfig + testAccPermissionsGroupMembershipResource("test_membership", []string{"a", "b", "c", "d"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserGroups(
+						"metabase_user.test_membership",
+						"metabase_permissions_group.test_membership_a",
+						"metabase_permissions_group.test_membership_b",
+						"metabase_permissions_group.test_membership_c",
+						"metabase_permissions_group.test_membership_d",
+					),
+					resource.TestCheckResourceAttrPair("metabase_permissions_group_membership.test_membership_a", "user_id", "metabase_user.test_membership", "id"),
+					resource.TestCheckResourceAttrPair("metabase_permissions_group_membership.test_membership_a", "group_id", "metabase_permissions_group.test_membership_a", "id"),
+					resource.TestCheckNoResourceAttr("metabase_permissions_group_membership.test_membership_a", "is_group_manager"),
+				),
+			},
+			{
+				ResourceName: "metabase_permissions_group_membership.test_membership_a",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["metabase_permissions_group_membership.test_membership_a"]
+					return rs.Primary.Attributes["user_id"] + ":" + rs.Primary.Attributes["group_id"], nil
+				},
+				ImportStateVerify: true,
+			},
+			{
+				// Removing memberships concurrently should leave the other memberships untouched.
+				Config: providerApiKeyConfig + testAccPermissionsGroupMembershipResource("test_membership", []string{"a", "d"}),
+				Check: testAccCheckUserGroups(
+					"metabase_user.test_membership",
+					"metabase_permissions_group.test_membership_a",
+					"metabase_permissions_group.test_membership_d",
+				),
+			},
+		},
+	})
+}
+
+func TestPermissionsMembershipFallbackStandIn(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	users := s.serveObjects("/user")
+	client := s.client()
+
+	// The membership endpoints are not registered, which is how older versions of Metabase respond.
+	users[7] = map[string]any{
+		"id":                     7,
+		"email":                  "user@example.com",
+		"first_name":             "First",
+		"last_name":              "Last",
+		"user_group_memberships": []any{map[string]any{"id": 1}},
+	}
+
+	groupIds := []int{3, 4, 5, 6, 8, 9, 10, 11}
+
+	// Concurrent additions for the same user should not overwrite each other.
+	var wg sync.WaitGroup
+	for _, groupId := range groupIds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if diags := createPermissionsMembership(ctx, client, 7, groupId, nil); diags.HasError() {
+				t.Error(diags)
+			}
+		}()
+	}
+	wg.Wait()
+
+	userGroupIds := func() []int {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		ids := []int{}
+		for _, m := range users[7]["user_group_memberships"].([]any) {
+			ids = append(ids, int(m.(map[string]any)["id"].(float64)))
+		}
+		slices.Sort(ids)
+		return ids
+	}
+
+	expected := append([]int{1}, groupIds...)
+	if ids := userGroupIds(); !slices.Equal(ids, expected) {
+		t.Errorf("Expected the user to be a member of groups %v, got %v.", expected, ids)
+	}
+
+	// Members are listed without membership IDs, such that the user endpoint is used to remove the user from the group.
+	s.serveJson("GET", "/permissions/group/3", metabase.PermissionsGroup{
+		Id:      3,
+		Name:    "Group",
+		Members: &[]metabase.PermissionsGroupMember{{UserId: 7}},
+	})
+
+	diags := deletePermissionsMembership(ctx, client, 7, 3)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	expected = slices.DeleteFunc(expected, func(id int) bool { return id == 3 })
+	if ids := userGroupIds(); !slices.Equal(ids, expected) {
+		t.Errorf("Expected the user to be a member of groups %v, got %v.", expected, ids)
+	}
+}
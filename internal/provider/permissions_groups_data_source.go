@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionsGroupsDataSource{}
+
+// Creates a new permissions groups data source.
+func NewPermissionsGroupsDataSource() datasource.DataSource {
+	return &PermissionsGroupsDataSource{}
+}
+
+// A data source listing Metabase permissions groups, optionally filtered by name.
+type PermissionsGroupsDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the permissions groups data source.
+type PermissionsGroupsDataSourceModel struct {
+	Names  types.Set  `tfsdk:"names"`  // Only lists the groups with these names.
+	Groups types.List `tfsdk:"groups"` // The groups matching the filter.
+	Ids    types.Map  `tfsdk:"ids"`    // The IDs of the groups matching the filter, indexed by name.
+}
+
+// The object type for a single permissions group in the list.
+var permissionsGroupObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":           types.Int64Type,
+		"name":         types.StringType,
+		"member_count": types.Int64Type,
+	},
+}
+
+func (d *PermissionsGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions_groups"
+}
+
+func (d *PermissionsGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The list of Metabase permissions groups, optionally filtered by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"names": schema.SetAttribute{
+				MarkdownDescription: "Only lists the groups with these names. Names which do not match any group result in an error.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"ids": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the groups matching the filter, indexed by name.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"groups": schema.ListNestedAttribute{
+				MarkdownDescription: "The groups matching the filter, sorted by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the group.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the group.",
+							Computed:            true,
+						},
+						"member_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of members in the group.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionsGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Converts the permissions groups returned by the Metabase API to the list of groups sorted by ID, and the map of their
+// IDs indexed by name. If `names` is not nil, only the groups with these names are included, and names which do not
+// match any group are reported as errors.
+func makePermissionsGroupsValues(groups []metabase.PermissionsGroup, names []string) (types.List, types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	groups = slices.Clone(groups)
+	slices.SortFunc(groups, func(a, b metabase.PermissionsGroup) int { return a.Id - b.Id })
+
+	groupValues := make([]attr.Value, 0, len(groups))
+	idValues := make(map[string]attr.Value, len(groups))
+	for _, g := range groups {
+		if names != nil && !slices.Contains(names, g.Name) {
+			continue
+		}
+
+		groupValue, objectDiags := types.ObjectValue(permissionsGroupObjectType.AttrTypes, map[string]attr.Value{
+			"id":           types.Int64Value(int64(g.Id)),
+			"name":         types.StringValue(g.Name),
+			"member_count": int64ValueOrNull(g.MemberCount),
+		})
+		diags.Append(objectDiags...)
+
+		groupValues = append(groupValues, groupValue)
+		idValues[g.Name] = types.Int64Value(int64(g.Id))
+	}
+
+	for _, name := range names {
+		if _, ok := idValues[name]; !ok {
+			diags.AddError("Permissions group not found.", fmt.Sprintf("No Metabase permissions group is named %q.", name))
+		}
+	}
+
+	groupsList, listDiags := types.ListValue(permissionsGroupObjectType, groupValues)
+	diags.Append(listDiags...)
+	idsMap, mapDiags := types.MapValue(types.Int64Type, idValues)
+	diags.Append(mapDiags...)
+
+	return groupsList, idsMap, diags
+}
+
+func (d *PermissionsGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionsGroupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names []string
+	if !data.Names.IsNull() {
+		names = []string{}
+		resp.Diagnostics.Append(data.Names.ElementsAs(ctx, &names, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	groups, diags := listPermissionsGroups(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupsList, idsMap, diags := makePermissionsGroupsValues(groups, names)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Groups = groupsList
+	data.Ids = idsMap
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccPermissionsGroupsDataSource(name string) string {
+	return fmt.Sprintf(`
+resource "metabase_permissions_group" "%s" {
+  name = "🔎 Data source group"
+}
+
+data "metabase_permissions_group" "%s" {
+  name = metabase_permissions_group.%s.name
+}
+
+data "metabase_permissions_groups" "%s" {
+  names = [metabase_permissions_group.%s.name, "Administrators"]
+}
+
+data "metabase_permissions_groups" "%s_all" {
+  depends_on = [metabase_permissions_group.%s]
+}
+`,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+	)
+}
+
+func TestAccPermissionsGroupsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionsGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccPermissionsGroupsDataSource("test_lookup"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.metabase_permissions_group.test_lookup", "id", "metabase_permissions_group.test_lookup", "id"),
+					resource.TestCheckResourceAttr("data.metabase_permissions_group.test_lookup", "member_count", "0"),
+					resource.TestCheckResourceAttr("data.metabase_permissions_groups.test_lookup", "groups.#", "2"),
+					resource.TestCheckResourceAttrPair("data.metabase_permissions_groups.test_lookup", "ids.🔎 Data source group", "metabase_permissions_group.test_lookup", "id"),
+					resource.TestCheckResourceAttrSet("data.metabase_permissions_groups.test_lookup", "ids.Administrators"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.metabase_permissions_groups.test_lookup_all", "groups.*", map[string]string{
+						"name": "🔎 Data source group",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestMakePermissionsGroupsValues(t *testing.T) {
+	analystsCount, allUsersCount := 4, 12
+	groups := []metabase.PermissionsGroup{
+		{Id: 3, Name: "Analysts", MemberCount: &analystsCount},
+		{Id: 1, Name: "All Users", MemberCount: &allUsersCount},
+		{Id: 2, Name: "Administrators"},
+	}
+
+	list, _, diags := makePermissionsGroupsValues(groups, nil)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(list.Elements()) != 3 || !list.Elements()[0].(types.Object).Attributes()["name"].Equal(types.StringValue("All Users")) {
+		t.Errorf("Expected all groups sorted by ID, got %v.", list)
+	}
+
+	list, ids, diags := makePermissionsGroupsValues(groups, []string{"Analysts"})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	expectedIds := types.MapValueMust(types.Int64Type, map[string]attr.Value{"Analysts": types.Int64Value(3)})
+	if len(list.Elements()) != 1 || !ids.Equal(expectedIds) {
+		t.Errorf("Expected only the Analysts group, got %v and %v.", list, ids)
+	}
+	if count := list.Elements()[0].(types.Object).Attributes()["member_count"]; !count.Equal(types.Int64Value(4)) {
+		t.Errorf("Expected 4 members, got %v.", count)
+	}
+
+	_, _, diags = makePermissionsGroupsValues(groups, []string{"Analysts", "Missing"})
+	if !diags.HasError() {
+		t.Errorf("Expected an error for a missing group.")
+	}
+}
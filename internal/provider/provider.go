@@ -118,16 +118,31 @@ func (p *MetabaseProvider) Configure(ctx context.Context, req provider.Configure
 
 func (p *MetabaseProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewActionResource,
+		NewAlertResource,
+		NewCacheConfigResource,
 		NewCardResource,
 		NewCollectionGraphResource,
+		NewCollectionPermissionsResource,
+		NewCollectionPinsResource,
 		NewCollectionResource,
 		NewContentTranslationResource,
+		NewDashboardCopyResource,
 		NewDashboardResource,
+		NewDashboardSubscriptionResource,
+		NewDatabasePermissionsResource,
 		NewDatabaseResource,
+		NewImpersonationResource,
 		NewPermissionsGraphResource,
 		NewPermissionsGroupResource,
+		NewPermissionsGroupMembersResource,
 		NewPermissionsGroupMembershipResource,
+		NewRevisionRevertResource,
+		NewSandboxResource,
 		NewTableResource,
+		NewTimelineEventResource,
+		NewTimelineResource,
+		NewUserPasswordResetResource,
 		NewUserResource,
 	}
 }
@@ -135,8 +150,15 @@ func (p *MetabaseProvider) Resources(ctx context.Context) []func() resource.Reso
 func (p *MetabaseProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewCollectionGraphDataSource,
+		NewEmbedUrlDataSource,
 		NewPermissionsGraphDataSource,
+		NewPermissionsGroupDataSource,
+		NewPermissionsGroupsDataSource,
+		NewRevisionsDataSource,
 		NewTableDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewVirtualDashcardDataSource,
 	}
 }
 
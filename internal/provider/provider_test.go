@@ -4,10 +4,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
+	"strconv"
 
 	"github.com/occam-bci/terraform-provider-metabase/metabase"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 var providerConfig = fmt.Sprintf(`
@@ -42,3 +47,51 @@ var testAccMetabaseClient, _ = metabase.MakeAuthenticatedClientWithUsernameAndPa
 	os.Getenv("METABASE_USERNAME"),
 	os.Getenv("METABASE_PASSWORD"),
 )
+
+// Returns a check storing the ID of the given resource, such that later steps can make changes outside of Terraform.
+func testAccStoreResourceId(resourceName string, id *int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state.", resourceName)
+		}
+
+		resourceId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*id = resourceId
+
+		return nil
+	}
+}
+
+// A plan check asserting that an attribute of a resource is left unchanged, even if other attributes change.
+type expectUnchangedAttribute struct {
+	resourceAddress string
+	attribute       string
+}
+
+func (e expectUnchangedAttribute) CheckPlan(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	for _, rc := range req.Plan.ResourceChanges {
+		if rc.Address != e.resourceAddress {
+			continue
+		}
+
+		before, _ := rc.Change.Before.(map[string]any)
+		after, _ := rc.Change.After.(map[string]any)
+		if !reflect.DeepEqual(before[e.attribute], after[e.attribute]) {
+			resp.Error = fmt.Errorf("Expected %s.%s to be unchanged, got %v and %v.", e.resourceAddress, e.attribute, before[e.attribute], after[e.attribute])
+		}
+
+		return
+	}
+
+	resp.Error = fmt.Errorf("Failed to find resource %s in plan.", e.resourceAddress)
+}
+
+// Returns a plan check asserting that the given attribute of the resource is not changed by the plan.
+func testAccExpectUnchangedAttribute(resourceAddress string, attribute string) plancheck.PlanCheck {
+	return expectUnchangedAttribute{resourceAddress: resourceAddress, attribute: attribute}
+}
@@ -0,0 +1,562 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// The Terraform model for the schedule of a pulse, i.e. a dashboard subscription or an alert.
+type PulseScheduleModel struct {
+	Type  types.String `tfsdk:"type"`  // How often the pulse is sent.
+	Hour  types.Int64  `tfsdk:"hour"`  // The hour of the day at which the pulse is sent.
+	Day   types.String `tfsdk:"day"`   // The day of the week at which the pulse is sent.
+	Frame types.String `tfsdk:"frame"` // The time of the month at which the pulse is sent.
+}
+
+// The Terraform model for the email channel of a pulse.
+type PulseEmailModel struct {
+	UserIds  types.Set `tfsdk:"user_ids"`  // The IDs of the Metabase users receiving the emails.
+	GroupIds types.Set `tfsdk:"group_ids"` // The IDs of the permissions groups whose members receive the emails.
+	Emails   types.Set `tfsdk:"emails"`    // The email addresses of recipients which are not Metabase users.
+}
+
+// The Terraform model for the Slack channel of a pulse.
+type PulseSlackModel struct {
+	Channel types.String `tfsdk:"channel"` // The Slack channel to which the pulse is sent.
+}
+
+// The object type definition for the `PulseScheduleModel` model.
+var pulseScheduleObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":  types.StringType,
+		"hour":  types.Int64Type,
+		"day":   types.StringType,
+		"frame": types.StringType,
+	},
+}
+
+// The object type definition for the `PulseEmailModel` model.
+var pulseEmailObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"user_ids":  types.SetType{ElemType: types.Int64Type},
+		"group_ids": types.SetType{ElemType: types.Int64Type},
+		"emails":    types.SetType{ElemType: types.StringType},
+	},
+}
+
+// The object type definition for the `PulseSlackModel` model.
+var pulseSlackObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"channel": types.StringType,
+	},
+}
+
+// The frequencies at which a pulse can be sent.
+var pulseScheduleTypes = []string{"hourly", "daily", "weekly", "monthly"}
+
+// The days of the week, as expected by the Metabase API.
+var pulseScheduleDays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+// The times of the month at which a monthly pulse can be sent.
+var pulseScheduleFrames = []string{"first", "mid", "last"}
+
+// The schema for the `schedule` attribute of a pulse.
+var pulseScheduleAttribute = schema.SingleNestedAttribute{
+	MarkdownDescription: "When the pulse is sent. The schedule applies to all channels.",
+	Required:            true,
+	Attributes: map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			MarkdownDescription: "How often the pulse is sent. One of `hourly`, `daily`, `weekly`, or `monthly`.",
+			Required:            true,
+		},
+		"hour": schema.Int64Attribute{
+			MarkdownDescription: "The hour of the day (from `0` to `23`, in the timezone of the Metabase instance) at which the pulse is sent. Required for all types except `hourly`.",
+			Optional:            true,
+		},
+		"day": schema.StringAttribute{
+			MarkdownDescription: "The day of the week at which the pulse is sent, e.g. `mon`. Required for `weekly` schedules. For `monthly` schedules, this can be combined with a `first` or `last` frame, e.g. to send the pulse on the first Monday of the month.",
+			Optional:            true,
+		},
+		"frame": schema.StringAttribute{
+			MarkdownDescription: "The time of the month at which the pulse is sent. One of `first`, `mid`, or `last`. Required for `monthly` schedules.",
+			Optional:            true,
+		},
+	},
+}
+
+// The schema for the `email` attribute of a pulse.
+var pulseEmailAttribute = schema.SingleNestedAttribute{
+	MarkdownDescription: "Sends the pulse by email.",
+	Optional:            true,
+	Attributes: map[string]schema.Attribute{
+		"user_ids": schema.SetAttribute{
+			MarkdownDescription: "The IDs of the Metabase users receiving the email.",
+			ElementType:         types.Int64Type,
+			Optional:            true,
+		},
+		"group_ids": schema.SetAttribute{
+			MarkdownDescription: "The IDs of permissions groups whose members receive the email. Metabase does not support groups as recipients, so groups are expanded to their members when the pulse is created or updated. A difference will be detected if the members of a group change afterwards.",
+			ElementType:         types.Int64Type,
+			Optional:            true,
+		},
+		"emails": schema.SetAttribute{
+			MarkdownDescription: "The email addresses of recipients which are not Metabase users.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	},
+}
+
+// The schema for the `slack` attribute of a pulse.
+var pulseSlackAttribute = schema.SingleNestedAttribute{
+	MarkdownDescription: "Sends the pulse to Slack. This requires Slack to be set up in Metabase.",
+	Optional:            true,
+	Attributes: map[string]schema.Attribute{
+		"channel": schema.StringAttribute{
+			MarkdownDescription: "The Slack channel to which the pulse is sent, e.g. `#data-ops`, or `@user` for a direct message.",
+			Required:            true,
+		},
+	},
+}
+
+// Validates the `schedule` attribute of a pulse. Unknown values are ignored.
+func validatePulseSchedule(ctx context.Context, schedule types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if schedule.IsNull() || schedule.IsUnknown() {
+		return diags
+	}
+
+	var s PulseScheduleModel
+	diags.Append(schedule.As(ctx, &s, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || s.Type.IsUnknown() {
+		return diags
+	}
+
+	schedulePath := path.Root("schedule")
+	scheduleType := s.Type.ValueString()
+	if !slices.Contains(pulseScheduleTypes, scheduleType) {
+		diags.AddAttributeError(
+			schedulePath.AtName("type"),
+			"Unsupported schedule type.",
+			fmt.Sprintf("Got %q, expected one of: %s.", scheduleType, strings.Join(pulseScheduleTypes, ", ")),
+		)
+		return diags
+	}
+
+	if !s.Hour.IsNull() && !s.Hour.IsUnknown() && (s.Hour.ValueInt64() < 0 || s.Hour.ValueInt64() > 23) {
+		diags.AddAttributeError(schedulePath.AtName("hour"), "Invalid schedule hour.", fmt.Sprintf("Got %d, expected a value between 0 and 23.", s.Hour.ValueInt64()))
+	}
+
+	if !s.Day.IsNull() && !s.Day.IsUnknown() && !slices.Contains(pulseScheduleDays, s.Day.ValueString()) {
+		diags.AddAttributeError(
+			schedulePath.AtName("day"),
+			"Invalid schedule day.",
+			fmt.Sprintf("Got %q, expected one of: %s.", s.Day.ValueString(), strings.Join(pulseScheduleDays, ", ")),
+		)
+	}
+
+	if !s.Frame.IsNull() && !s.Frame.IsUnknown() && !slices.Contains(pulseScheduleFrames, s.Frame.ValueString()) {
+		diags.AddAttributeError(
+			schedulePath.AtName("frame"),
+			"Invalid schedule frame.",
+			fmt.Sprintf("Got %q, expected one of: %s.", s.Frame.ValueString(), strings.Join(pulseScheduleFrames, ", ")),
+		)
+	}
+
+	// Lists the attributes that must (`true`) or must not (`false`) be set for the schedule type. Attributes which are
+	// not listed are optional.
+	var expected map[string]bool
+	switch scheduleType {
+	case "hourly":
+		expected = map[string]bool{"hour": false, "day": false, "frame": false}
+	case "daily":
+		expected = map[string]bool{"hour": true, "day": false, "frame": false}
+	case "weekly":
+		expected = map[string]bool{"hour": true, "day": true, "frame": false}
+	case "monthly":
+		expected = map[string]bool{"hour": true, "frame": true}
+		if s.Frame.ValueString() == "mid" {
+			expected["day"] = false
+		}
+	}
+
+	isSet := map[string]bool{
+		"hour":  !s.Hour.IsNull(),
+		"day":   !s.Day.IsNull(),
+		"frame": !s.Frame.IsNull(),
+	}
+
+	for _, name := range []string{"hour", "day", "frame"} {
+		required, ok := expected[name]
+		if !ok || required == isSet[name] {
+			continue
+		}
+
+		if required {
+			diags.AddAttributeError(schedulePath.AtName(name), "Missing schedule attribute.", fmt.Sprintf("The %s attribute is required for %s schedules.", name, scheduleType))
+		} else {
+			diags.AddAttributeError(schedulePath.AtName(name), "Unexpected schedule attribute.", fmt.Sprintf("The %s attribute cannot be set for this %s schedule.", name, scheduleType))
+		}
+	}
+
+	return diags
+}
+
+// Validates that a pulse is sent through at least one channel.
+func validatePulseChannels(email types.Object, slack types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if email.IsNull() && slack.IsNull() {
+		diags.AddAttributeError(path.Root("email"), "Missing pulse channel.", "At least one of email or slack should be set.")
+	}
+
+	return diags
+}
+
+// Returns the IDs of the members of a permissions group.
+func getPermissionsGroupMemberIds(ctx context.Context, client *metabase.ClientWithResponses, groupId int) ([]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	getResp, err := client.GetPermissionsGroupWithResponse(ctx, groupId)
+
+	diags.Append(checkMetabaseResponse(getResp, err, []int{200}, "get permissions group")...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	memberIds := []int{}
+	if getResp.JSON200.Members != nil {
+		for _, m := range *getResp.JSON200.Members {
+			memberIds = append(memberIds, m.UserId)
+		}
+	}
+
+	return memberIds, diags
+}
+
+// Makes the schedule attributes of a channel from the `schedule` attribute.
+func makePulseChannelSchedule(ctx context.Context, schedule types.Object, channel *metabase.PulseChannel) diag.Diagnostics {
+	var s PulseScheduleModel
+	diags := schedule.As(ctx, &s, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+
+	channel.ScheduleType = metabase.PulseChannelScheduleType(s.Type.ValueString())
+	channel.ScheduleHour = valueInt64OrNull(s.Hour)
+	channel.ScheduleDay = valueStringOrNull(s.Day)
+	channel.ScheduleFrame = valueStringOrNull(s.Frame)
+
+	return diags
+}
+
+// Makes the list of email recipients from the `email` attribute. Permissions groups are expanded to their members.
+func makePulseEmailRecipients(ctx context.Context, client *metabase.ClientWithResponses, e PulseEmailModel) ([]metabase.PulseChannelRecipient, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var userIds []int64
+	if !e.UserIds.IsNull() {
+		diags.Append(e.UserIds.ElementsAs(ctx, &userIds, false)...)
+	}
+
+	var groupIds []int64
+	if !e.GroupIds.IsNull() {
+		diags.Append(e.GroupIds.ElementsAs(ctx, &groupIds, false)...)
+	}
+
+	var emails []string
+	if !e.Emails.IsNull() {
+		diags.Append(e.Emails.ElementsAs(ctx, &emails, false)...)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	recipientIds := make([]int, 0, len(userIds))
+	for _, u := range userIds {
+		recipientIds = append(recipientIds, int(u))
+	}
+
+	for _, g := range groupIds {
+		memberIds, memberDiags := getPermissionsGroupMemberIds(ctx, client, int(g))
+		diags.Append(memberDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		recipientIds = append(recipientIds, memberIds...)
+	}
+
+	slices.Sort(recipientIds)
+	recipientIds = slices.Compact(recipientIds)
+
+	recipients := make([]metabase.PulseChannelRecipient, 0, len(recipientIds)+len(emails))
+	for _, id := range recipientIds {
+		recipients = append(recipients, metabase.PulseChannelRecipient{Id: &id})
+	}
+
+	slices.Sort(emails)
+	for _, email := range emails {
+		recipients = append(recipients, metabase.PulseChannelRecipient{Email: &email})
+	}
+
+	return recipients, diags
+}
+
+// Makes the list of channels that can be sent to the Metabase API from the `schedule`, `email`, and `slack`
+// attributes of a pulse.
+func makePulseChannels(ctx context.Context, client *metabase.ClientWithResponses, schedule types.Object, email types.Object, slack types.Object) ([]metabase.PulseChannel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	channels := make([]metabase.PulseChannel, 0, 2)
+
+	if !email.IsNull() {
+		var e PulseEmailModel
+		diags.Append(email.As(ctx, &e, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		recipients, recipientsDiags := makePulseEmailRecipients(ctx, client, e)
+		diags.Append(recipientsDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		channel := metabase.PulseChannel{
+			ChannelType: metabase.PulseChannelChannelType("email"),
+			Enabled:     true,
+			Recipients:  &recipients,
+			Details:     &map[string]any{},
+		}
+		diags.Append(makePulseChannelSchedule(ctx, schedule, &channel)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		channels = append(channels, channel)
+	}
+
+	if !slack.IsNull() {
+		var s PulseSlackModel
+		diags.Append(slack.As(ctx, &s, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		channel := metabase.PulseChannel{
+			ChannelType: metabase.PulseChannelChannelType("slack"),
+			Enabled:     true,
+			Recipients:  &[]metabase.PulseChannelRecipient{},
+			Details:     &map[string]any{"channel": s.Channel.ValueString()},
+		}
+		diags.Append(makePulseChannelSchedule(ctx, schedule, &channel)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		channels = append(channels, channel)
+	}
+
+	return channels, diags
+}
+
+// Makes the `schedule` attribute from a channel returned by the Metabase API. Only the attributes relevant to the
+// schedule type are kept, as Metabase may return leftover values from a previous schedule.
+func makePulseScheduleFromChannel(channel metabase.PulseChannel) (types.Object, diag.Diagnostics) {
+	scheduleType := string(channel.ScheduleType)
+
+	hour := types.Int64Null()
+	day := types.StringNull()
+	frame := types.StringNull()
+
+	switch scheduleType {
+	case "daily":
+		hour = int64ValueOrNull(channel.ScheduleHour)
+	case "weekly":
+		hour = int64ValueOrNull(channel.ScheduleHour)
+		day = stringValueOrNull(channel.ScheduleDay)
+	case "monthly":
+		hour = int64ValueOrNull(channel.ScheduleHour)
+		day = stringValueOrNull(channel.ScheduleDay)
+		frame = stringValueOrNull(channel.ScheduleFrame)
+	}
+
+	return types.ObjectValue(pulseScheduleObjectType.AttrTypes, map[string]attr.Value{
+		"type":  types.StringValue(scheduleType),
+		"hour":  hour,
+		"day":   day,
+		"frame": frame,
+	})
+}
+
+// Returns a set value, or null if the set is empty and the existing value is null.
+func makeSetValueOrNull[T any](ctx context.Context, elemType attr.Type, existing types.Set, values []T) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 && existing.IsNull() {
+		return types.SetNull(elemType), diag.Diagnostics{}
+	}
+
+	return types.SetValueFrom(ctx, elemType, values)
+}
+
+// Makes the `email` attribute from the recipients returned by the Metabase API.
+// Because groups are expanded to their members, an existing group is only kept if all its members are still
+// recipients. Recipients which are not part of a kept group are listed individually.
+func makePulseEmailFromChannel(ctx context.Context, client *metabase.ClientWithResponses, channel metabase.PulseChannel, existing types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	e := PulseEmailModel{
+		UserIds:  types.SetNull(types.Int64Type),
+		GroupIds: types.SetNull(types.Int64Type),
+		Emails:   types.SetNull(types.StringType),
+	}
+	if !existing.IsNull() && !existing.IsUnknown() {
+		diags.Append(existing.As(ctx, &e, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return types.ObjectNull(pulseEmailObjectType.AttrTypes), diags
+		}
+	}
+
+	var existingUserIds []int64
+	if !e.UserIds.IsNull() && !e.UserIds.IsUnknown() {
+		diags.Append(e.UserIds.ElementsAs(ctx, &existingUserIds, false)...)
+	}
+
+	var existingGroupIds []int64
+	if !e.GroupIds.IsNull() && !e.GroupIds.IsUnknown() {
+		diags.Append(e.GroupIds.ElementsAs(ctx, &existingGroupIds, false)...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectNull(pulseEmailObjectType.AttrTypes), diags
+	}
+
+	recipientIds := make(map[int64]bool)
+	emails := []string{}
+	if channel.Recipients != nil {
+		for _, r := range *channel.Recipients {
+			if r.Id != nil {
+				recipientIds[int64(*r.Id)] = true
+			} else if r.Email != nil {
+				emails = append(emails, *r.Email)
+			}
+		}
+	}
+
+	groupIds := []int64{}
+	coveredIds := make(map[int64]bool)
+	for _, g := range existingGroupIds {
+		memberIds, memberDiags := getPermissionsGroupMemberIds(ctx, client, int(g))
+		diags.Append(memberDiags...)
+		if diags.HasError() {
+			return types.ObjectNull(pulseEmailObjectType.AttrTypes), diags
+		}
+
+		if !slices.ContainsFunc(memberIds, func(id int) bool { return !recipientIds[int64(id)] }) {
+			groupIds = append(groupIds, g)
+			for _, id := range memberIds {
+				coveredIds[int64(id)] = true
+			}
+		}
+	}
+
+	userIds := []int64{}
+	for id := range recipientIds {
+		if !coveredIds[id] || slices.Contains(existingUserIds, id) {
+			userIds = append(userIds, id)
+		}
+	}
+	slices.Sort(userIds)
+
+	var setDiags diag.Diagnostics
+	e.UserIds, setDiags = makeSetValueOrNull(ctx, types.Int64Type, e.UserIds, userIds)
+	diags.Append(setDiags...)
+	e.GroupIds, setDiags = makeSetValueOrNull(ctx, types.Int64Type, e.GroupIds, groupIds)
+	diags.Append(setDiags...)
+	e.Emails, setDiags = makeSetValueOrNull(ctx, types.StringType, e.Emails, emails)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return types.ObjectNull(pulseEmailObjectType.AttrTypes), diags
+	}
+
+	email, objectDiags := types.ObjectValueFrom(ctx, pulseEmailObjectType.AttrTypes, e)
+	diags.Append(objectDiags...)
+
+	return email, diags
+}
+
+// Updates the `schedule`, `email`, and `slack` attributes of a pulse from the channels returned by the Metabase API.
+// Disabled channels are ignored.
+func updatePulseChannelsFromApi(ctx context.Context, client *metabase.ClientWithResponses, channels []metabase.PulseChannel, schedule *types.Object, email *types.Object, slack *types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	newSchedule := types.ObjectNull(pulseScheduleObjectType.AttrTypes)
+	newEmail := types.ObjectNull(pulseEmailObjectType.AttrTypes)
+	newSlack := types.ObjectNull(pulseSlackObjectType.AttrTypes)
+
+	for _, c := range channels {
+		if !c.Enabled {
+			continue
+		}
+
+		switch c.ChannelType {
+		case "email":
+			if !newEmail.IsNull() {
+				continue
+			}
+
+			var emailDiags diag.Diagnostics
+			newEmail, emailDiags = makePulseEmailFromChannel(ctx, client, c, *email)
+			diags.Append(emailDiags...)
+		case "slack":
+			if !newSlack.IsNull() {
+				continue
+			}
+
+			slackChannel := ""
+			if c.Details != nil {
+				slackChannel, _ = (*c.Details)["channel"].(string)
+			}
+
+			var slackDiags diag.Diagnostics
+			newSlack, slackDiags = types.ObjectValue(pulseSlackObjectType.AttrTypes, map[string]attr.Value{
+				"channel": types.StringValue(slackChannel),
+			})
+			diags.Append(slackDiags...)
+		default:
+			continue
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+
+		// All channels are expected to share the same schedule, the first one is used.
+		if newSchedule.IsNull() {
+			var scheduleDiags diag.Diagnostics
+			newSchedule, scheduleDiags = makePulseScheduleFromChannel(c)
+			diags.Append(scheduleDiags...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	*schedule = newSchedule
+	*email = newEmail
+	*slack = newSlack
+
+	return diags
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithValidateConfig = &RevisionRevertResource{}
+
+// Creates a new revision revert resource.
+func NewRevisionRevertResource() resource.Resource {
+	return &RevisionRevertResource{
+		MetabaseBaseResource{name: "revision_revert"},
+	}
+}
+
+// A resource reverting a card or dashboard to a previous revision when it is created.
+// This is a one-off operation: reading the resource does not call the Metabase API, and deleting it only removes it from
+// the state.
+type RevisionRevertResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for a revision revert.
+type RevisionRevertResourceModel struct {
+	Id         types.Int64  `tfsdk:"id"`          // The ID of the revision created by the revert.
+	Entity     types.String `tfsdk:"entity"`      // The type of entity.
+	EntityId   types.Int64  `tfsdk:"entity_id"`   // The ID of the entity.
+	RevisionId types.Int64  `tfsdk:"revision_id"` // The ID of the revision to revert to.
+}
+
+func (r *RevisionRevertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Reverts a Metabase card (question) or dashboard to a previous revision.
+
+The revert is performed when the resource is created, and creates a new revision in Metabase. Changing any attribute performs a new revert. Destroying the resource does not undo the revert.
+
+If the card or dashboard is also managed by Terraform, its resource will plan to restore the configured definition after the revert. The configuration should be updated to match the reverted definition, e.g. using the ` + "`metabase_revisions`" + ` data source to find the right revision.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the revision created by the revert.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"entity": schema.StringAttribute{
+				MarkdownDescription: "The type of entity. Either `card` or `dashboard`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"entity_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the card or dashboard.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"revision_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the revision to revert to.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *RevisionRevertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RevisionRevertResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateRevisionEntity(data.Entity)...)
+}
+
+func (r *RevisionRevertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *RevisionRevertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	revertResp, err := r.client.RevertRevisionWithResponse(ctx, metabase.RevertRevisionBody{
+		Entity:     metabase.RevisionEntity(data.Entity.ValueString()),
+		Id:         int(data.EntityId.ValueInt64()),
+		RevisionId: int(data.RevisionId.ValueInt64()),
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(revertResp, err, []int{200}, "revert revision")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.Int64Value(int64(revertResp.JSON200.Id))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RevisionRevertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The revert is a one-off operation, there is nothing to refresh.
+}
+
+func (r *RevisionRevertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, updates cannot occur.
+	resp.Diagnostics.AddError("Unexpected update of a revision revert.", "All attributes of a revision revert require replacement. Please report this issue to the provider developers.")
+}
+
+func (r *RevisionRevertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Reverts cannot be undone, the resource is simply removed from the state.
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccRevisionRevertResource(name string, cardName string, revert bool) string {
+	revertConfig := ""
+	if revert {
+		revertConfig = fmt.Sprintf(`
+data "metabase_revisions" "%s" {
+  entity    = "card"
+  entity_id = metabase_card.%s.id
+}
+
+resource "metabase_revision_revert" "%s" {
+  entity      = "card"
+  entity_id   = metabase_card.%s.id
+  revision_id = one([for r in data.metabase_revisions.%s.revisions : r.id if r.is_creation])
+}
+`,
+			name,
+			name,
+			name,
+			name,
+			name,
+		)
+	}
+
+	return testAccCardResource(name, cardName, "") + revertConfig
+}
+
+func testAccCheckCardName(resourceName string, expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state.", resourceName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetCardWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 200 {
+			return fmt.Errorf("Received unexpected response from the Metabase API when getting card.")
+		}
+
+		if response.JSON200.Name != expectedName {
+			return fmt.Errorf("Expected card name %q, got %q.", expectedName, response.JSON200.Name)
+		}
+
+		return nil
+	}
+}
+
+func TestAccRevisionRevertResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccRevisionRevertResource("test", "🕰️ Original", false),
+				Check:  testAccCheckCardName("metabase_card.test", "🕰️ Original"),
+			},
+			{
+				Config: providerApiKeyConfig + testAccRevisionRevertResource("test", "🕰️ Updated", false),
+				Check:  testAccCheckCardName("metabase_card.test", "🕰️ Updated"),
+			},
+			{
+				// Reverting a card managed by Terraform is detected as a difference with its configuration.
+				Config: providerApiKeyConfig + testAccRevisionRevertResource("test", "🕰️ Updated", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_revision_revert.test", "id"),
+					testAccCheckCardName("metabase_card.test", "🕰️ Original"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
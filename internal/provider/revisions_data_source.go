@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RevisionsDataSource{}
+
+// Creates a new revisions data source.
+func NewRevisionsDataSource() datasource.DataSource {
+	return &RevisionsDataSource{}
+}
+
+// A data source listing the revisions of a card or dashboard.
+type RevisionsDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the revisions data source.
+type RevisionsDataSourceModel struct {
+	Entity    types.String `tfsdk:"entity"`    // The type of entity.
+	EntityId  types.Int64  `tfsdk:"entity_id"` // The ID of the entity.
+	Revisions types.List   `tfsdk:"revisions"` // The list of revisions, most recent first.
+}
+
+// The types of entities for which Metabase stores revisions.
+var revisionEntities = []string{string(metabase.RevisionEntityCard), string(metabase.RevisionEntityDashboard)}
+
+// The object type for a single revision.
+var revisionObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":           types.Int64Type,
+		"description":  types.StringType,
+		"timestamp":    types.StringType,
+		"user_id":      types.Int64Type,
+		"user_name":    types.StringType,
+		"is_creation":  types.BoolType,
+		"is_reversion": types.BoolType,
+	},
+}
+
+func (d *RevisionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_revisions"
+}
+
+func (d *RevisionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The revision history of a Metabase card (question) or dashboard.
+
+Metabase records a revision each time a card or dashboard is modified, whether from the UI or by Terraform. This data source can be used to inspect the history, and to find the ID of a revision to revert to using the ` + "`metabase_revision_revert`" + ` resource.`,
+
+		Attributes: map[string]schema.Attribute{
+			"entity": schema.StringAttribute{
+				MarkdownDescription: "The type of entity. Either `card` or `dashboard`.",
+				Required:            true,
+			},
+			"entity_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the card or dashboard.",
+				Required:            true,
+			},
+			"revisions": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of revisions, most recent first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the revision.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A human-readable description of the changes.",
+							Computed:            true,
+						},
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "When the revision was created.",
+							Computed:            true,
+						},
+						"user_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the user who made the changes.",
+							Computed:            true,
+						},
+						"user_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the user who made the changes.",
+							Computed:            true,
+						},
+						"is_creation": schema.BoolAttribute{
+							MarkdownDescription: "Whether the revision corresponds to the creation of the entity.",
+							Computed:            true,
+						},
+						"is_reversion": schema.BoolAttribute{
+							MarkdownDescription: "Whether the revision results from reverting to a previous revision.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RevisionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Validates the type of entity for which revisions are listed or reverted.
+func validateRevisionEntity(entity types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if entity.IsNull() || entity.IsUnknown() || slices.Contains(revisionEntities, entity.ValueString()) {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("entity"),
+		"Unsupported revision entity.",
+		fmt.Sprintf("Got %q, expected one of: %s.", entity.ValueString(), strings.Join(revisionEntities, ", ")),
+	)
+
+	return diags
+}
+
+// Returns the list of revisions as a Terraform value.
+func makeRevisionsValue(revisions []metabase.Revision) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	revisionValues := make([]attr.Value, 0, len(revisions))
+	for _, r := range revisions {
+		userId := types.Int64Null()
+		userName := types.StringNull()
+		if r.User != nil {
+			userId = types.Int64Value(int64(r.User.Id))
+			userName = stringValueOrNull(r.User.CommonName)
+		}
+
+		revisionValue, objDiags := types.ObjectValue(revisionObjectType.AttrTypes, map[string]attr.Value{
+			"id":           types.Int64Value(int64(r.Id)),
+			"description":  stringValueOrNull(r.Description),
+			"timestamp":    types.StringValue(r.Timestamp),
+			"user_id":      userId,
+			"user_name":    userName,
+			"is_creation":  types.BoolValue(r.IsCreation),
+			"is_reversion": types.BoolValue(r.IsReversion),
+		})
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return types.ListNull(revisionObjectType), diags
+		}
+
+		revisionValues = append(revisionValues, revisionValue)
+	}
+
+	list, listDiags := types.ListValue(revisionObjectType, revisionValues)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+func (d *RevisionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RevisionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateRevisionEntity(data.Entity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, err := d.client.ListRevisionsWithResponse(ctx, &metabase.ListRevisionsParams{
+		Entity: metabase.RevisionEntity(data.Entity.ValueString()),
+		Id:     int(data.EntityId.ValueInt64()),
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(listResp, err, []int{200}, "list revisions")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Revisions, diags = makeRevisionsValue(*listResp.JSON200)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
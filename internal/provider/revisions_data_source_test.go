@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMakeRevisionsValue(t *testing.T) {
+	ctx := context.Background()
+
+	// Revisions made by users who have since been deleted have no user.
+	var apiRevisions []metabase.Revision
+	err := json.Unmarshal([]byte(`[
+		{
+			"id": 12,
+			"description": "reverted to an earlier version.",
+			"timestamp": "2026-03-02T10:00:00Z",
+			"is_creation": false,
+			"is_reversion": true,
+			"user": {"id": 1, "common_name": "Ada Admin"}
+		},
+		{
+			"id": 11,
+			"description": null,
+			"timestamp": "2026-03-01T10:00:00Z",
+			"is_creation": true,
+			"is_reversion": false,
+			"user": null
+		}
+	]`), &apiRevisions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revisions, diags := makeRevisionsValue(apiRevisions)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var models []struct {
+		Id          types.Int64  `tfsdk:"id"`
+		Description types.String `tfsdk:"description"`
+		Timestamp   types.String `tfsdk:"timestamp"`
+		UserId      types.Int64  `tfsdk:"user_id"`
+		UserName    types.String `tfsdk:"user_name"`
+		IsCreation  types.Bool   `tfsdk:"is_creation"`
+		IsReversion types.Bool   `tfsdk:"is_reversion"`
+	}
+	diags = revisions.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if len(models) != 2 || models[0].UserName.ValueString() != "Ada Admin" || !models[1].UserId.IsNull() || !models[1].IsCreation.ValueBool() {
+		t.Errorf("Unexpected revisions: %+v.", models)
+	}
+
+	if diags := validateRevisionEntity(types.StringValue("collection")); !diags.HasError() {
+		t.Errorf("Expected an error for an unsupported entity.")
+	}
+}
+
+func testAccRevisionsDataSource(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "metabase_revisions" "%s" {
+  entity    = "card"
+  entity_id = metabase_card.%s.id
+}
+`,
+		testAccCardResource(name, "🕰️ Revised card", ""),
+		name,
+		name,
+	)
+}
+
+func TestAccRevisionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccRevisionsDataSource("test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.metabase_revisions.test", "revisions.#", "1"),
+					resource.TestCheckResourceAttr("data.metabase_revisions.test", "revisions.0.is_creation", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,431 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &SandboxResource{}
+var _ resource.ResourceWithValidateConfig = &SandboxResource{}
+
+// Creates a new sandbox resource.
+func NewSandboxResource() resource.Resource {
+	return &SandboxResource{
+		MetabaseBaseResource{name: "sandbox"},
+	}
+}
+
+// A resource handling a Metabase data sandbox (group table access policy), restricting the rows of a table a group can
+// view.
+type SandboxResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for a sandbox.
+type SandboxResourceModel struct {
+	Id                  types.Int64 `tfsdk:"id"`                   // The ID of the sandbox.
+	GroupId             types.Int64 `tfsdk:"group_id"`             // The ID of the sandboxed group.
+	TableId             types.Int64 `tfsdk:"table_id"`             // The ID of the sandboxed table.
+	CardId              types.Int64 `tfsdk:"card_id"`              // The ID of the question used to filter the table.
+	AttributeRemappings types.Map   `tfsdk:"attribute_remappings"` // The user attributes filtering the rows, and their targets.
+}
+
+// The Terraform model for the target of a user attribute in a sandbox.
+type SandboxAttributeRemapping struct {
+	FieldId                types.Int64  `tfsdk:"field_id"`                  // The ID of a field in the table.
+	TemplateTag            types.String `tfsdk:"template_tag"`              // The name of a variable template tag in the question.
+	FieldFilterTemplateTag types.String `tfsdk:"field_filter_template_tag"` // The name of a field filter template tag in the question.
+}
+
+// The object type definition for the `SandboxAttributeRemapping` model.
+var sandboxAttributeRemappingObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"field_id":                  types.Int64Type,
+		"template_tag":              types.StringType,
+		"field_filter_template_tag": types.StringType,
+	},
+}
+
+// The view data permission which must be set for a group on a table for its sandbox to be applied.
+const sandboxedViewDataPermission = string(metabase.PermissionsGraphDatabasePermissionsViewData0Sandboxed)
+
+func (r *SandboxResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A Metabase data sandbox, restricting the rows of a table members of a group can view based on their user attributes. This requires a Pro or Enterprise edition of Metabase.
+
+The view data permission of the group on the table must be set to ` + "`sandboxed`" + `, e.g. using the ` + "`metabase_permissions_graph`" + ` or ` + "`metabase_database_permissions`" + ` resource. This is checked when the sandbox is created, such that the resource should depend on the permissions.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the sandbox.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the sandboxed group.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"table_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the sandboxed table.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"card_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of a question (card) returning the rows of the table the group can view. If not set, the rows are only filtered using the attribute remappings.",
+				Optional:            true,
+			},
+			"attribute_remappings": schema.MapNestedAttribute{
+				MarkdownDescription: "A map where keys are user attributes, and values are the targets filtered using the values of the attributes. Exactly one of `field_id`, `template_tag`, or `field_filter_template_tag` should be set for each target.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of a field (column) in the table, e.g. from the `fields` of a `metabase_table`.",
+							Optional:            true,
+						},
+						"template_tag": schema.StringAttribute{
+							MarkdownDescription: "The name of a variable template tag in the native query of the question set as `card_id`.",
+							Optional:            true,
+						},
+						"field_filter_template_tag": schema.StringAttribute{
+							MarkdownDescription: "The name of a field filter template tag in the native query of the question set as `card_id`.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SandboxResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SandboxResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AttributeRemappings.IsNull() || data.AttributeRemappings.IsUnknown() {
+		return
+	}
+
+	remappings := make(map[string]SandboxAttributeRemapping, len(data.AttributeRemappings.Elements()))
+	resp.Diagnostics.Append(data.AttributeRemappings.ElementsAs(ctx, &remappings, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attribute, remapping := range remappings {
+		attributePath := path.Root("attribute_remappings").AtMapKey(attribute)
+
+		targets := 0
+		unknown := false
+		for _, value := range []attr.Value{remapping.FieldId, remapping.TemplateTag, remapping.FieldFilterTemplateTag} {
+			unknown = unknown || value.IsUnknown()
+			if !value.IsNull() {
+				targets++
+			}
+		}
+
+		if !unknown && targets != 1 {
+			resp.Diagnostics.AddAttributeError(
+				attributePath,
+				"Invalid attribute remapping target.",
+				"Exactly one of field_id, template_tag, or field_filter_template_tag should be set.",
+			)
+			continue
+		}
+
+		if remapping.FieldId.IsNull() && !remapping.FieldId.IsUnknown() && data.CardId.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				attributePath,
+				"Missing sandbox question.",
+				"Template tags can only be used when the card_id attribute is set.",
+			)
+		}
+	}
+}
+
+// Makes the attribute remappings sent to the Metabase API from the Terraform model.
+func makeSandboxAttributeRemappingsFromModel(ctx context.Context, value types.Map) (*metabase.SandboxAttributeRemappings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	remappings := make(map[string]SandboxAttributeRemapping, len(value.Elements()))
+	diags.Append(value.ElementsAs(ctx, &remappings, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make(metabase.SandboxAttributeRemappings, len(remappings))
+	for attribute, remapping := range remappings {
+		switch {
+		case !remapping.FieldId.IsNull():
+			result[attribute] = []any{"dimension", []any{"field", remapping.FieldId.ValueInt64(), nil}}
+		case !remapping.TemplateTag.IsNull():
+			result[attribute] = []any{"variable", []any{"template-tag", remapping.TemplateTag.ValueString()}}
+		default:
+			result[attribute] = []any{"dimension", []any{"template-tag", remapping.FieldFilterTemplateTag.ValueString()}}
+		}
+	}
+
+	return &result, diags
+}
+
+// Makes the model for the target of a user attribute from its value returned by the Metabase API, e.g.
+// `["dimension", ["field", 1, null]]` or `["variable", ["template-tag", "tag"]]`.
+func makeSandboxAttributeRemappingFromApi(target []any) (*SandboxAttributeRemapping, error) {
+	remapping := SandboxAttributeRemapping{
+		FieldId:                types.Int64Null(),
+		TemplateTag:            types.StringNull(),
+		FieldFilterTemplateTag: types.StringNull(),
+	}
+
+	if len(target) < 2 {
+		return nil, fmt.Errorf("unexpected target: %v", target)
+	}
+	kind, _ := target[0].(string)
+	reference, _ := target[1].([]any)
+	if len(reference) < 2 {
+		return nil, fmt.Errorf("unexpected target: %v", target)
+	}
+	referenceKind, _ := reference[0].(string)
+
+	switch {
+	case kind == "dimension" && (referenceKind == "field" || referenceKind == "field-id"):
+		fieldId, ok := reference[1].(float64)
+		if !ok {
+			return nil, fmt.Errorf("unsupported field reference: %v", reference)
+		}
+		remapping.FieldId = types.Int64Value(int64(fieldId))
+	case kind == "variable" && referenceKind == "template-tag":
+		remapping.TemplateTag = types.StringValue(fmt.Sprint(reference[1]))
+	case kind == "dimension" && referenceKind == "template-tag":
+		remapping.FieldFilterTemplateTag = types.StringValue(fmt.Sprint(reference[1]))
+	default:
+		return nil, fmt.Errorf("unsupported target: %v", target)
+	}
+
+	return &remapping, nil
+}
+
+// Updates the given `SandboxResourceModel` from the `Sandbox` returned by the Metabase API.
+func updateModelFromSandbox(ctx context.Context, s metabase.Sandbox, data *SandboxResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.Int64Value(int64(s.Id))
+	data.GroupId = types.Int64Value(int64(s.GroupId))
+	data.TableId = types.Int64Value(int64(s.TableId))
+	data.CardId = int64ValueOrNull(s.CardId)
+
+	remappings := make(map[string]attr.Value)
+	if s.AttributeRemappings != nil {
+		for attribute, target := range *s.AttributeRemappings {
+			remapping, err := makeSandboxAttributeRemappingFromApi(target)
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Unable to parse the remapping of attribute %q.", attribute), err.Error())
+				return diags
+			}
+
+			value, objectDiags := types.ObjectValueFrom(ctx, sandboxAttributeRemappingObjectType.AttrTypes, remapping)
+			diags.Append(objectDiags...)
+			if diags.HasError() {
+				return diags
+			}
+			remappings[attribute] = value
+		}
+	}
+
+	// An empty map is equivalent to not setting the remappings.
+	if len(remappings) == 0 && data.AttributeRemappings.IsNull() {
+		return diags
+	}
+
+	remappingsValue, mapDiags := types.MapValue(sandboxAttributeRemappingObjectType, remappings)
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.AttributeRemappings = remappingsValue
+
+	return diags
+}
+
+// Checks that the view data permission of the group on the table is `sandboxed` in the permissions graph, as Metabase
+// only applies sandboxes to tables with this permission.
+func checkTableIsSandboxed(ctx context.Context, client *metabase.ClientWithResponses, groupId int, tableId int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tableResp, err := client.GetTableMetadataWithResponse(ctx, tableId, &metabase.GetTableMetadataParams{})
+
+	diags.Append(checkMetabaseResponse(tableResp, err, []int{200}, "get table metadata")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	graphResp, err := client.GetPermissionsGraphWithResponse(ctx)
+
+	diags.Append(checkMetabaseResponse(graphResp, err, []int{200}, "get permissions graph")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var viewData string
+	dbPermissions, ok := graphResp.JSON200.Groups[strconv.Itoa(groupId)][strconv.Itoa(tableResp.JSON200.DbId)]
+	if ok {
+		permissions, err := makeGranularPermissionsFromDatabasePermissions(dbPermissions)
+		if err != nil {
+			diags.AddError("Unexpected permissions value.", err.Error())
+			return diags
+		}
+
+		var schema string
+		if tableResp.JSON200.Schema != nil {
+			schema = *tableResp.JSON200.Schema
+		}
+
+		viewData = permissions[viewDataPermission].valueFor(schema, strconv.Itoa(tableId))
+	}
+
+	if viewData != sandboxedViewDataPermission {
+		diags.AddError(
+			fmt.Sprintf("Table %d is not sandboxed for group %d.", tableId, groupId),
+			fmt.Sprintf("The view data permission of the group on the table is %q. It should be set to %q in the permissions graph before creating the sandbox, e.g. by making this resource depend on the metabase_permissions_graph resource.", viewData, sandboxedViewDataPermission),
+		)
+	}
+
+	return diags
+}
+
+func (r *SandboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SandboxResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupId := int(data.GroupId.ValueInt64())
+	tableId := int(data.TableId.ValueInt64())
+
+	resp.Diagnostics.Append(checkTableIsSandboxed(ctx, r.client, groupId, tableId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remappings, diags := makeSandboxAttributeRemappingsFromModel(ctx, data.AttributeRemappings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, err := r.client.CreateSandboxWithResponse(ctx, metabase.CreateSandboxBody{
+		GroupId:             groupId,
+		TableId:             tableId,
+		CardId:              valueInt64OrNull(data.CardId),
+		AttributeRemappings: remappings,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200}, "create sandbox")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromSandbox(ctx, *createResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SandboxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SandboxResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.GetSandboxWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get sandbox")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Metabase deletes sandboxes when the view data permission of the group on the table is no longer sandboxed.
+	if getResp.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromSandbox(ctx, *getResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SandboxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SandboxResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remappings, diags := makeSandboxAttributeRemappingsFromModel(ctx, data.AttributeRemappings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResp, err := r.client.UpdateSandboxWithResponse(ctx, int(data.Id.ValueInt64()), metabase.UpdateSandboxBody{
+		CardId:              valueInt64OrNull(data.CardId),
+		AttributeRemappings: remappings,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update sandbox")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromSandbox(ctx, *updateResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SandboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SandboxResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResp, err := r.client.DeleteSandboxWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(deleteResp, err, []int{204, 404}, "delete sandbox")...)
+}
+
+func (r *SandboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIntegerId(ctx, req, resp)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSandboxAttributeRemappingsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	remappings, diags := types.MapValueFrom(ctx, sandboxAttributeRemappingObjectType, map[string]SandboxAttributeRemapping{
+		"user_id": {FieldId: types.Int64Value(12), TemplateTag: types.StringNull(), FieldFilterTemplateTag: types.StringNull()},
+		"region":  {FieldId: types.Int64Null(), TemplateTag: types.StringValue("region"), FieldFilterTemplateTag: types.StringNull()},
+		"team":    {FieldId: types.Int64Null(), TemplateTag: types.StringNull(), FieldFilterTemplateTag: types.StringValue("team")},
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	body, diags := makeSandboxAttributeRemappingsFromModel(ctx, remappings)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	bodyJson, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"region":["variable",["template-tag","region"]],"team":["dimension",["template-tag","team"]],"user_id":["dimension",["field",12,null]]}`
+	if string(bodyJson) != expected {
+		t.Errorf("Expected %s, got %s.", expected, bodyJson)
+	}
+
+	// Metabase may return fields with options, or using the legacy reference.
+	var sandbox metabase.Sandbox
+	err = json.Unmarshal([]byte(`{
+		"id": 4,
+		"group_id": 3,
+		"table_id": 10,
+		"card_id": 7,
+		"attribute_remappings": {
+			"user_id": ["dimension", ["field", 12, {"base-type": "type/Integer"}]],
+			"region": ["variable", ["template-tag", "region"]],
+			"team": ["dimension", ["template-tag", "team"]]
+		}
+	}`), &sandbox)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := SandboxResourceModel{AttributeRemappings: types.MapNull(sandboxAttributeRemappingObjectType)}
+	diags = updateModelFromSandbox(ctx, sandbox, &data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !data.AttributeRemappings.Equal(remappings) || data.CardId != types.Int64Value(7) {
+		t.Errorf("Expected remappings %v, got %v.", remappings, data.AttributeRemappings)
+	}
+
+	legacy, err := makeSandboxAttributeRemappingFromApi([]any{"dimension", []any{"field-id", float64(12)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if legacy.FieldId != types.Int64Value(12) {
+		t.Errorf("Expected the legacy field reference to be parsed, got %v.", legacy)
+	}
+
+	if _, err := makeSandboxAttributeRemappingFromApi([]any{"dimension", []any{"expression", "total"}}); err == nil {
+		t.Errorf("Expected an error for an unsupported target.")
+	}
+}
+
+func TestUpdateModelFromSandboxWithoutRemappings(t *testing.T) {
+	ctx := context.Background()
+
+	data := SandboxResourceModel{AttributeRemappings: types.MapNull(sandboxAttributeRemappingObjectType)}
+	diags := updateModelFromSandbox(ctx, metabase.Sandbox{Id: 4, GroupId: 3, TableId: 10}, &data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if !data.AttributeRemappings.IsNull() || !data.CardId.IsNull() {
+		t.Errorf("Expected the unset attributes to be kept null, got %v.", data)
+	}
+}
+
+func TestCheckTableIsSandboxedStandIn(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	s.serveJson("GET", "/table/10/query_metadata", map[string]any{
+		"id": 10, "db_id": 1, "name": "orders", "display_name": "Orders", "entity_type": "entity/TransactionTable", "schema": "public", "fields": []any{},
+	})
+	s.serveJson("GET", "/table/11/query_metadata", map[string]any{
+		"id": 11, "db_id": 1, "name": "products", "display_name": "Products", "entity_type": "entity/GenericTable", "schema": "public", "fields": []any{},
+	})
+	serveStandInPermissionsGraph(s, "/permissions/graph", map[string]map[string]any{
+		"3": {"1": map[string]any{
+			"view-data":      map[string]any{"public": map[string]any{"10": "sandboxed", "11": "unrestricted"}},
+			"create-queries": "query-builder",
+		}},
+	}, 0)
+
+	if diags := checkTableIsSandboxed(ctx, s.client(), 3, 10); diags.HasError() {
+		t.Errorf("Expected table 10 to be sandboxed, got %v.", diags)
+	}
+	if diags := checkTableIsSandboxed(ctx, s.client(), 3, 11); !diags.HasError() {
+		t.Errorf("Expected an error for the unrestricted table 11.")
+	}
+	if diags := checkTableIsSandboxed(ctx, s.client(), 1, 10); !diags.HasError() {
+		t.Errorf("Expected an error for a group without permissions on the database.")
+	}
+}
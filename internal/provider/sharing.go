@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/occam-bci/terraform-provider-metabase/internal/planmodifiers"
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The kind of object that can be embedded or publicly shared.
+type sharedObjectKind string
+
+const (
+	sharedCard      sharedObjectKind = "card"
+	sharedDashboard sharedObjectKind = "dashboard"
+)
+
+// The path segment used in public and embedding links for each kind of object.
+var sharingPathSegments = map[sharedObjectKind]string{
+	sharedCard:      "question",
+	sharedDashboard: "dashboard",
+}
+
+// The modes in which a parameter can be exposed in an embedded card or dashboard.
+var embeddingParamModes = []string{"disabled", "enabled", "locked"}
+
+// Pointers to the embedding and public sharing attributes of a card or dashboard model, such that they can be handled
+// in the same way for both resources.
+type sharingAttributes struct {
+	EnableEmbedding *types.Bool   // Whether signed embedding is enabled.
+	EmbeddingParams *types.Map    // The embedding mode of each parameter.
+	PublicSharing   *types.Bool   // Whether a public link is enabled.
+	PublicUuid      *types.String // The UUID of the public link.
+	PublicUrl       *types.String // The full public link.
+}
+
+// Returns the schema for the embedding and public sharing attributes, for the given kind of object.
+func makeSharingSchemaAttributes(objectName string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"enable_embedding": schema.BoolAttribute{
+			MarkdownDescription: fmt.Sprintf("Whether the %s can be embedded in other applications using signed (JWT) embedding. Embedding should also be enabled in the Metabase admin settings. If not set, the setting is not managed by Terraform.", objectName),
+			Optional:            true,
+		},
+		"embedding_params": schema.MapAttribute{
+			MarkdownDescription: fmt.Sprintf("How each parameter of the embedded %s can be used, keyed by parameter slug. Values are `locked` (set by the signed token), `enabled` (editable by the viewer), or `disabled`. Parameters which are not listed are disabled. If not set, the setting is not managed by Terraform.", objectName),
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"public_sharing": schema.BoolAttribute{
+			MarkdownDescription: fmt.Sprintf("Whether a public link is created for the %s, allowing anyone to view it without logging in. Public sharing should also be enabled in the Metabase admin settings. If not set, the setting is not managed by Terraform.", objectName),
+			Optional:            true,
+		},
+		"public_uuid": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The UUID of the public link, if public sharing is enabled for the %s.", objectName),
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				planmodifiers.UseStateForUnknownIfAttributeUnchanged[types.Bool](path.Root("public_sharing")),
+			},
+		},
+		"public_url": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The public link to the %s, if public sharing is enabled. The URL is derived from the provider `endpoint`.", objectName),
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				planmodifiers.UseStateForUnknownIfAttributeUnchanged[types.Bool](path.Root("public_sharing")),
+			},
+		},
+	}
+}
+
+// Validates the modes in the `embedding_params` attribute. Unknown values are ignored.
+func validateEmbeddingParams(ctx context.Context, embeddingParams types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if embeddingParams.IsNull() || embeddingParams.IsUnknown() {
+		return diags
+	}
+
+	var params map[string]types.String
+	diags.Append(embeddingParams.ElementsAs(ctx, &params, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for slug, mode := range params {
+		if mode.IsNull() || mode.IsUnknown() || slices.Contains(embeddingParamModes, mode.ValueString()) {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root("embedding_params").AtMapKey(slug),
+			"Invalid embedding parameter mode.",
+			fmt.Sprintf("Got %q, expected one of: %s.", mode.ValueString(), strings.Join(embeddingParamModes, ", ")),
+		)
+	}
+
+	return diags
+}
+
+// Returns the embedding settings that should be sent to the Metabase API. Settings which are not managed by Terraform
+// are returned as `nil`.
+func makeEmbeddingSettings(ctx context.Context, attrs sharingAttributes) (*bool, *map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	enableEmbedding := attrs.EnableEmbedding.ValueBoolPointer()
+
+	var embeddingParams *map[string]string
+	if !attrs.EmbeddingParams.IsNull() {
+		params := make(map[string]string)
+		diags.Append(attrs.EmbeddingParams.ElementsAs(ctx, &params, false)...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		embeddingParams = &params
+	}
+
+	return enableEmbedding, embeddingParams, diags
+}
+
+// Creates or deletes the public link of a card or dashboard, such that it matches the `public_sharing` attribute.
+// Returns the resulting public UUID, given the current one.
+func updatePublicLink(ctx context.Context, client *metabase.ClientWithResponses, kind sharedObjectKind, id int, publicSharing types.Bool, publicUuid *string) (*string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if publicSharing.IsNull() || publicSharing.ValueBool() == (publicUuid != nil) {
+		return publicUuid, diags
+	}
+
+	if publicSharing.ValueBool() {
+		var link *metabase.PublicLink
+		switch kind {
+		case sharedCard:
+			createResp, err := client.CreateCardPublicLinkWithResponse(ctx, id)
+			diags.Append(checkMetabaseResponse(createResp, err, []int{200}, "create card public link")...)
+			if !diags.HasError() {
+				link = createResp.JSON200
+			}
+		case sharedDashboard:
+			createResp, err := client.CreateDashboardPublicLinkWithResponse(ctx, id)
+			diags.Append(checkMetabaseResponse(createResp, err, []int{200}, "create dashboard public link")...)
+			if !diags.HasError() {
+				link = createResp.JSON200
+			}
+		}
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &link.Uuid, diags
+	}
+
+	var deleteResp metabase.MetabaseResponse
+	var err error
+	switch kind {
+	case sharedCard:
+		deleteResp, err = client.DeleteCardPublicLinkWithResponse(ctx, id)
+	case sharedDashboard:
+		deleteResp, err = client.DeleteDashboardPublicLinkWithResponse(ctx, id)
+	}
+
+	diags.Append(checkMetabaseResponse(deleteResp, err, []int{204}, fmt.Sprintf("delete %s public link", kind))...)
+
+	return nil, diags
+}
+
+// Updates the embedding and public sharing attributes from the values returned by the Metabase API.
+// Embedding settings and public sharing are left null when they are not managed by Terraform, to avoid diffs.
+func updateSharingAttributesFromApi(ctx context.Context, client *metabase.ClientWithResponses, kind sharedObjectKind, enableEmbedding *bool, embeddingParams *map[string]string, publicUuid *string, attrs sharingAttributes) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !attrs.EnableEmbedding.IsNull() {
+		*attrs.EnableEmbedding = types.BoolValue(enableEmbedding != nil && *enableEmbedding)
+	}
+
+	if !attrs.EmbeddingParams.IsNull() {
+		params := map[string]string{}
+		if embeddingParams != nil {
+			params = *embeddingParams
+		}
+
+		var mapDiags diag.Diagnostics
+		*attrs.EmbeddingParams, mapDiags = types.MapValueFrom(ctx, types.StringType, params)
+		diags.Append(mapDiags...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !attrs.PublicSharing.IsNull() {
+		*attrs.PublicSharing = types.BoolValue(publicUuid != nil)
+	}
+
+	*attrs.PublicUuid = stringValueOrNull(publicUuid)
+	*attrs.PublicUrl = types.StringNull()
+	if publicUuid != nil {
+		*attrs.PublicUrl = types.StringValue(fmt.Sprintf("%s/public/%s/%s", client.SiteUrl(), sharingPathSegments[kind], *publicUuid))
+	}
+
+	return diags
+}
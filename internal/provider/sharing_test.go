@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateEmbeddingParams(t *testing.T) {
+	ctx := context.Background()
+
+	valid, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{
+		"category": "locked",
+		"date":     "enabled",
+		"region":   "disabled",
+	})
+	if diags := validateEmbeddingParams(ctx, valid); diags.HasError() {
+		t.Errorf("Expected valid embedding parameters, got %v.", diags)
+	}
+
+	invalid, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{
+		"category": "hidden",
+	})
+	if diags := validateEmbeddingParams(ctx, invalid); !diags.HasError() {
+		t.Errorf("Expected an error for an invalid embedding parameter mode.")
+	}
+}
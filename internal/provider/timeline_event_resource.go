@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &TimelineEventResource{}
+var _ resource.ResourceWithValidateConfig = &TimelineEventResource{}
+
+// Creates a new timeline event resource.
+func NewTimelineEventResource() resource.Resource {
+	return &TimelineEventResource{
+		MetabaseBaseResource{name: "timeline_event"},
+	}
+}
+
+// A resource handling an event in a Metabase timeline.
+type TimelineEventResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for a timeline event.
+type TimelineEventResourceModel struct {
+	Id          types.Int64  `tfsdk:"id"`           // The ID of the event.
+	TimelineId  types.Int64  `tfsdk:"timeline_id"`  // The ID of the timeline containing the event.
+	Name        types.String `tfsdk:"name"`         // The name of the event.
+	Description types.String `tfsdk:"description"`  // A description for the event.
+	Timestamp   types.String `tfsdk:"timestamp"`    // When the event happened, as an RFC 3339 timestamp or a date.
+	TimeMatters types.Bool   `tfsdk:"time_matters"` // Whether the time of the event is displayed.
+	Timezone    types.String `tfsdk:"timezone"`     // The timezone in which the event is displayed.
+	Icon        types.String `tfsdk:"icon"`         // The icon of the event.
+}
+
+// The layout of timestamps which only define the date of an event.
+const timelineEventDateLayout = "2006-01-02"
+
+// The timezone sent to Metabase when it is not set, as it is required when creating an event.
+const defaultTimelineEventTimezone = "UTC"
+
+// Returns whether the timestamp from the configuration and the timestamp returned by the Metabase API represent the
+// same event time. When the configuration only defines a date, only the date of the returned timestamp is compared, in
+// the timezone of the event if it is known.
+func timelineEventTimestampsEqual(configured string, returned string, timezone *string) bool {
+	returnedTime, err := time.Parse(time.RFC3339Nano, returned)
+	if err != nil {
+		return configured == returned
+	}
+
+	if configuredTime, err := time.Parse(time.RFC3339Nano, configured); err == nil {
+		return configuredTime.Equal(returnedTime)
+	}
+
+	if timezone != nil {
+		if location, err := time.LoadLocation(*timezone); err == nil {
+			returnedTime = returnedTime.In(location)
+		}
+	}
+
+	return returnedTime.Format(timelineEventDateLayout) == configured
+}
+
+func (r *TimelineEventResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An event (e.g. a release or an incident) in a Metabase timeline, displayed on time series charts.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the event.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"timeline_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the `metabase_timeline` containing the event.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the event.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description for the event.",
+				Optional:            true,
+			},
+			"timestamp": schema.StringAttribute{
+				MarkdownDescription: "When the event happened, as an RFC 3339 timestamp (e.g. `2025-01-15T14:30:00Z`), or as a date (e.g. `2025-01-15`).",
+				Required:            true,
+			},
+			"time_matters": schema.BoolAttribute{
+				MarkdownDescription: "Whether the time of the event is displayed, rather than only its date. If not set, the setting is not managed by Terraform.",
+				Optional:            true,
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "The timezone in which the event is displayed, e.g. `Europe/Paris`. If not set, `UTC` is used when creating the event, and the timezone is not managed by Terraform afterwards.",
+				Optional:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "The icon of the event. One of `balloons`, `bell`, `cloud`, `mail`, `star`, or `warning`. If not set, the icon is not managed by Terraform.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *TimelineEventResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TimelineEventResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTimelineIcon(data.Icon)...)
+
+	if data.Timestamp.IsNull() || data.Timestamp.IsUnknown() {
+		return
+	}
+
+	timestamp := data.Timestamp.ValueString()
+	if _, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+		return
+	}
+	if _, err := time.Parse(timelineEventDateLayout, timestamp); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timestamp"),
+			"Invalid event timestamp.",
+			fmt.Sprintf("Expected an RFC 3339 timestamp or a date (YYYY-MM-DD), got %q.", timestamp),
+		)
+	}
+}
+
+// Updates the given `TimelineEventResourceModel` from the `TimelineEvent` returned by the Metabase API.
+func updateModelFromTimelineEvent(e metabase.TimelineEvent, data *TimelineEventResourceModel) {
+	data.Id = types.Int64Value(int64(e.Id))
+	data.TimelineId = types.Int64Value(int64(e.TimelineId))
+	data.Name = types.StringValue(e.Name)
+	data.Description = stringValueOrNull(e.Description)
+
+	// The timestamp from the configuration is kept if it represents the same time, as Metabase normalizes it.
+	if data.Timestamp.IsNull() || !timelineEventTimestampsEqual(data.Timestamp.ValueString(), e.Timestamp, e.Timezone) {
+		data.Timestamp = types.StringValue(e.Timestamp)
+	}
+
+	// Optional settings are only managed if they are set.
+	if !data.TimeMatters.IsNull() && e.TimeMatters != nil {
+		data.TimeMatters = types.BoolValue(*e.TimeMatters)
+	}
+	if !data.Timezone.IsNull() {
+		data.Timezone = stringValueOrNull(e.Timezone)
+	}
+	updateIconFromApi(e.Icon, &data.Icon)
+}
+
+func (r *TimelineEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TimelineEventResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timezone := defaultTimelineEventTimezone
+	if !data.Timezone.IsNull() {
+		timezone = data.Timezone.ValueString()
+	}
+
+	createResp, err := r.client.CreateTimelineEventWithResponse(ctx, metabase.CreateTimelineEventBody{
+		TimelineId:  int(data.TimelineId.ValueInt64()),
+		Name:        data.Name.ValueString(),
+		Description: valueStringOrNull(data.Description),
+		Timestamp:   data.Timestamp.ValueString(),
+		TimeMatters: data.TimeMatters.ValueBoolPointer(),
+		Timezone:    timezone,
+		Icon:        valueApproximateStringOrNull[metabase.TimelineIcon](data.Icon),
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200}, "create timeline event")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateModelFromTimelineEvent(*createResp.JSON200, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TimelineEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TimelineEventResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.GetTimelineEventWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get timeline event")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Archived events are treated as deleted, as this is what the delete operation does.
+	if getResp.StatusCode() == 404 || getResp.JSON200.Archived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	updateModelFromTimelineEvent(*getResp.JSON200, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TimelineEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TimelineEventResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timelineId := int(data.TimelineId.ValueInt64())
+	name := data.Name.ValueString()
+	timestamp := data.Timestamp.ValueString()
+	updateResp, err := r.client.UpdateTimelineEventWithResponse(ctx, int(data.Id.ValueInt64()), metabase.UpdateTimelineEventBody{
+		TimelineId:  &timelineId,
+		Name:        &name,
+		Description: valueStringOrNull(data.Description),
+		Timestamp:   &timestamp,
+		TimeMatters: data.TimeMatters.ValueBoolPointer(),
+		Timezone:    valueStringOrNull(data.Timezone),
+		Icon:        valueApproximateStringOrNull[metabase.TimelineIcon](data.Icon),
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update timeline event")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateModelFromTimelineEvent(*updateResp.JSON200, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TimelineEventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TimelineEventResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Like collections, events are archived rather than deleted. A raw body is used such that nullable attributes (e.g.
+	// the description) are left untouched.
+	updateResp, err := r.client.UpdateTimelineEventWithBodyWithResponse(ctx, int(data.Id.ValueInt64()), "application/json", strings.NewReader(`{"archived":true}`))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "delete (archive) timeline event")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TimelineEventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIntegerId(ctx, req, resp)
+}
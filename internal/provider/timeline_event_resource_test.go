@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestTimelineEventTimestampsEqual(t *testing.T) {
+	paris := "Europe/Paris"
+
+	tests := []struct {
+		configured string
+		returned   string
+		timezone   *string
+		expected   bool
+	}{
+		{configured: "2025-01-15T14:30:00Z", returned: "2025-01-15T14:30:00Z", expected: true},
+		{configured: "2025-01-15T16:30:00+02:00", returned: "2025-01-15T14:30:00.000Z", expected: true},
+		{configured: "2025-01-15T14:30:00Z", returned: "2025-01-15T14:31:00Z", expected: false},
+		{configured: "2025-01-15", returned: "2025-01-15T00:00:00Z", expected: true},
+		{configured: "2025-01-15", returned: "2025-01-16T00:00:00Z", expected: false},
+		{configured: "2025-01-15", returned: "2025-01-14T23:00:00Z", timezone: &paris, expected: true},
+	}
+
+	for _, tt := range tests {
+		if got := timelineEventTimestampsEqual(tt.configured, tt.returned, tt.timezone); got != tt.expected {
+			t.Errorf("timelineEventTimestampsEqual(%q, %q) = %v, want %v", tt.configured, tt.returned, got, tt.expected)
+		}
+	}
+}
+
+func TestUpdateModelFromTimelineEvent(t *testing.T) {
+	description := "Major release."
+	timeMatters := true
+	timezone := "Europe/Paris"
+	icon := metabase.Star
+
+	event := metabase.TimelineEvent{
+		Id:          4,
+		TimelineId:  2,
+		Name:        "v2.0",
+		Description: &description,
+		Timestamp:   "2025-01-15T14:30:00Z",
+		TimeMatters: &timeMatters,
+		Timezone:    &timezone,
+		Icon:        &icon,
+	}
+
+	// Settings which are not set are not managed, and the equivalent timestamp from the configuration is kept.
+	data := TimelineEventResourceModel{
+		Timestamp:   types.StringValue("2025-01-15T15:30:00+01:00"),
+		TimeMatters: types.BoolNull(),
+		Timezone:    types.StringNull(),
+		Icon:        types.StringValue("bell"),
+	}
+	updateModelFromTimelineEvent(event, &data)
+
+	expected := TimelineEventResourceModel{
+		Id:          types.Int64Value(4),
+		TimelineId:  types.Int64Value(2),
+		Name:        types.StringValue("v2.0"),
+		Description: types.StringValue("Major release."),
+		Timestamp:   types.StringValue("2025-01-15T15:30:00+01:00"),
+		TimeMatters: types.BoolNull(),
+		Timezone:    types.StringNull(),
+		Icon:        types.StringValue("star"),
+	}
+	if data != expected {
+		t.Errorf("Expected model %+v, got %+v.", expected, data)
+	}
+}
+
+func testAccTimelineEventResource(name string, eventName string, timestamp string) string {
+	return fmt.Sprintf(`
+resource "metabase_timeline" "%s" {
+  name = "🚀 Releases"
+}
+
+resource "metabase_timeline_event" "%s" {
+  timeline_id  = metabase_timeline.%s.id
+  name         = "%s"
+  description  = "📖 Release notes."
+  timestamp    = "%s"
+  time_matters = true
+  timezone     = "Europe/Paris"
+  icon         = "balloons"
+}
+`,
+		name,
+		name,
+		name,
+		eventName,
+		timestamp,
+	)
+}
+
+func testAccCheckTimelineEventExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state.", resourceName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetTimelineEventWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 200 {
+			return fmt.Errorf("Received unexpected response from the Metabase API when getting timeline event.")
+		}
+
+		if rs.Primary.Attributes["name"] != response.JSON200.Name {
+			return fmt.Errorf("Terraform resource and API response do not match for timeline event name.")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTimelineEventDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "metabase_timeline_event" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetTimelineEventWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 404 && !response.JSON200.Archived {
+			return fmt.Errorf("Timeline event %s still exists.", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestAccTimelineEventResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTimelineEventDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccTimelineEventResource("test", "v1.0", "2025-01-15T14:30:00+01:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTimelineEventExists("metabase_timeline_event.test"),
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "name", "v1.0"),
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "timestamp", "2025-01-15T14:30:00+01:00"),
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "time_matters", "true"),
+				),
+			},
+			{
+				Config: providerApiKeyConfig + testAccTimelineEventResource("test", "v1.1", "2025-02-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "name", "v1.1"),
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "timestamp", "2025-02-01"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &TimelineResource{}
+var _ resource.ResourceWithValidateConfig = &TimelineResource{}
+
+// Creates a new timeline resource.
+func NewTimelineResource() resource.Resource {
+	return &TimelineResource{
+		MetabaseBaseResource{name: "timeline"},
+	}
+}
+
+// A resource handling a Metabase timeline, grouping events displayed on time series charts.
+type TimelineResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for a timeline.
+type TimelineResourceModel struct {
+	Id           types.Int64  `tfsdk:"id"`            // The ID of the timeline.
+	Name         types.String `tfsdk:"name"`          // The name of the timeline.
+	Description  types.String `tfsdk:"description"`   // A description for the timeline.
+	Icon         types.String `tfsdk:"icon"`          // The default icon of events in the timeline.
+	CollectionId types.Int64  `tfsdk:"collection_id"` // The ID of the collection in which the timeline is placed.
+}
+
+// The icons which can be used for timelines and events.
+var timelineIcons = []string{
+	string(metabase.Balloons),
+	string(metabase.Bell),
+	string(metabase.Cloud),
+	string(metabase.Mail),
+	string(metabase.Star),
+	string(metabase.Warning),
+}
+
+// Validates the icon of a timeline or event.
+func validateTimelineIcon(icon types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if icon.IsNull() || icon.IsUnknown() {
+		return diags
+	}
+
+	if !slices.Contains(timelineIcons, icon.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("icon"),
+			"Unsupported icon.",
+			fmt.Sprintf("Got %q, expected one of: %s.", icon.ValueString(), strings.Join(timelineIcons, ", ")),
+		)
+	}
+
+	return diags
+}
+
+// Updates the icon in the model from the Metabase API. Null icons are not managed by Terraform and are left untouched.
+func updateIconFromApi(icon *metabase.TimelineIcon, value *types.String) {
+	if !value.IsNull() {
+		*value = stringValueOrNull(icon)
+	}
+}
+
+func (r *TimelineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A Metabase timeline, grouping events (e.g. releases or incidents) displayed on time series charts.
+
+Events are defined using the ` + "`metabase_timeline_event`" + ` resource.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the timeline.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the timeline.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description for the timeline.",
+				Optional:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "The default icon for events in the timeline. One of `balloons`, `bell`, `cloud`, `mail`, `star`, or `warning`. If not set, the icon is not managed by Terraform.",
+				Optional:            true,
+			},
+			"collection_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the collection in which the timeline is placed. If not set, the timeline is placed in the root collection.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *TimelineResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TimelineResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTimelineIcon(data.Icon)...)
+}
+
+// Updates the given `TimelineResourceModel` from the `Timeline` returned by the Metabase API.
+func updateModelFromTimeline(t metabase.Timeline, data *TimelineResourceModel) {
+	data.Id = types.Int64Value(int64(t.Id))
+	data.Name = types.StringValue(t.Name)
+	data.Description = stringValueOrNull(t.Description)
+	data.CollectionId = int64ValueOrNull(t.CollectionId)
+	updateIconFromApi(t.Icon, &data.Icon)
+}
+
+func (r *TimelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TimelineResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, err := r.client.CreateTimelineWithResponse(ctx, metabase.CreateTimelineBody{
+		Name:         data.Name.ValueString(),
+		Description:  valueStringOrNull(data.Description),
+		Icon:         valueApproximateStringOrNull[metabase.TimelineIcon](data.Icon),
+		CollectionId: valueInt64OrNull(data.CollectionId),
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200}, "create timeline")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateModelFromTimeline(*createResp.JSON200, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TimelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TimelineResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.GetTimelineWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get timeline")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Archived timelines are treated as deleted, as this is what the delete operation does.
+	if getResp.StatusCode() == 404 || getResp.JSON200.Archived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	updateModelFromTimeline(*getResp.JSON200, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TimelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TimelineResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	updateResp, err := r.client.UpdateTimelineWithResponse(ctx, int(data.Id.ValueInt64()), metabase.UpdateTimelineBody{
+		Name:         &name,
+		Description:  valueStringOrNull(data.Description),
+		Icon:         valueApproximateStringOrNull[metabase.TimelineIcon](data.Icon),
+		CollectionId: valueInt64OrNull(data.CollectionId),
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update timeline")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateModelFromTimeline(*updateResp.JSON200, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TimelineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TimelineResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Like collections, timelines are archived rather than deleted. A raw body is used such that nullable attributes
+	// (e.g. the collection) are left untouched.
+	updateResp, err := r.client.UpdateTimelineWithBodyWithResponse(ctx, int(data.Id.ValueInt64()), "application/json", strings.NewReader(`{"archived":true}`))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "delete (archive) timeline")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TimelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIntegerId(ctx, req, resp)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccTimelineResource(name string, timelineName string, icon string) string {
+	return fmt.Sprintf(`
+resource "metabase_collection" "%s" {
+  name = "🗓️ Timelines"
+}
+
+resource "metabase_timeline" "%s" {
+  name          = "%s"
+  description   = "📖 Releases of the product."
+  icon          = "%s"
+  collection_id = metabase_collection.%s.id
+}
+`,
+		name,
+		name,
+		timelineName,
+		icon,
+		name,
+	)
+}
+
+func testAccCheckTimelineExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find resource %s in state.", resourceName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetTimelineWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 200 {
+			return fmt.Errorf("Received unexpected response from the Metabase API when getting timeline.")
+		}
+
+		if rs.Primary.Attributes["name"] != response.JSON200.Name {
+			return fmt.Errorf("Terraform resource and API response do not match for timeline name.")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTimelineDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "metabase_timeline" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := testAccMetabaseClient.GetTimelineWithResponse(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 404 && !response.JSON200.Archived {
+			return fmt.Errorf("Timeline %s still exists.", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestAccTimelineResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTimelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccTimelineResource("test", "🚀 Releases", "star"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTimelineExists("metabase_timeline.test"),
+					resource.TestCheckResourceAttr("metabase_timeline.test", "name", "🚀 Releases"),
+					resource.TestCheckResourceAttr("metabase_timeline.test", "icon", "star"),
+					resource.TestCheckResourceAttrPair("metabase_timeline.test", "collection_id", "metabase_collection.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "metabase_timeline.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"icon"},
+			},
+			{
+				Config: providerApiKeyConfig + testAccTimelineResource("test", "🔥 Incidents", "warning"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_timeline.test", "name", "🔥 Incidents"),
+					resource.TestCheckResourceAttr("metabase_timeline.test", "icon", "warning"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserDataSource{}
+
+// Creates a new user data source.
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+// A data source finding an existing Metabase user by email address.
+type UserDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the user data source.
+type UserDataSourceModel struct {
+	Id          types.Int64  `tfsdk:"id"`           // The ID of the user.
+	Email       types.String `tfsdk:"email"`        // The email address of the user.
+	FirstName   types.String `tfsdk:"first_name"`   // The first name of the user.
+	LastName    types.String `tfsdk:"last_name"`    // The last name of the user.
+	CommonName  types.String `tfsdk:"common_name"`  // The full name of the user.
+	IsActive    types.Bool   `tfsdk:"is_active"`    // Whether the user is active.
+	IsSuperuser types.Bool   `tfsdk:"is_superuser"` // Whether the user is an administrator.
+	SsoSource   types.String `tfsdk:"sso_source"`   // The single sign-on provider through which the user was provisioned.
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `An existing Metabase user, found using their email address.
+
+This data source can be used to reference users who are not managed by Terraform, e.g. as recipients of dashboard subscriptions or as members of permissions groups. Deactivated users are also found.`,
+
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user. The comparison is case-insensitive.",
+				Required:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the user.",
+				Computed:            true,
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "The first name of the user.",
+				Computed:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "The last name of the user.",
+				Computed:            true,
+			},
+			"common_name": schema.StringAttribute{
+				MarkdownDescription: "The full name of the user.",
+				Computed:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is active.",
+				Computed:            true,
+			},
+			"is_superuser": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is an administrator.",
+				Computed:            true,
+			},
+			"sso_source": schema.StringAttribute{
+				MarkdownDescription: "The single sign-on provider through which the user was provisioned, e.g. `google` or `ldap`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Finds a user with the given email address and status. Returns nil if there is no such user.
+func findUserByEmail(ctx context.Context, client *metabase.ClientWithResponses, email string, status metabase.ListUsersParamsStatus) (*metabase.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	listResp, err := client.ListUsersWithResponse(ctx, &metabase.ListUsersParams{
+		Status: &status,
+		Query:  &email,
+	})
+
+	diags.Append(checkMetabaseResponse(listResp, err, []int{200}, "list users")...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Metabase compares email addresses case-insensitively.
+	for _, u := range listResp.JSON200.Data {
+		if strings.EqualFold(u.Email, email) {
+			return &u, diags
+		}
+	}
+
+	return nil, diags
+}
+
+// Updates the given `UserDataSourceModel` from the `User` returned by the Metabase API.
+func updateModelFromUserData(u metabase.User, data *UserDataSourceModel) {
+	data.Id = types.Int64Value(int64(u.Id))
+	data.Email = types.StringValue(u.Email)
+	data.FirstName = types.StringValue(u.FirstName)
+	data.LastName = types.StringValue(u.LastName)
+	data.CommonName = stringValueOrNull(u.CommonName)
+	data.IsActive = types.BoolValue(isUserActive(u))
+	data.IsSuperuser = types.BoolValue(u.IsSuperuser != nil && *u.IsSuperuser)
+	data.SsoSource = stringValueOrNull(u.SsoSource)
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := findUserByEmail(ctx, d.client, data.Email.ValueString(), metabase.All)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if user == nil {
+		resp.Diagnostics.AddError("User not found.", fmt.Sprintf("No Metabase user has the email address %q.", data.Email.ValueString()))
+		return
+	}
+
+	updateModelFromUserData(*user, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccUserDataSource(name string) string {
+	return fmt.Sprintf(`
+resource "metabase_permissions_group" "%s" {
+  name = "🔎 Data source users"
+}
+
+resource "metabase_user" "%s_active" {
+  email      = "active.lookup@example.com"
+  first_name = "Active"
+  last_name  = "Lookup"
+}
+
+resource "metabase_user" "%s_deactivated" {
+  email      = "deactivated.lookup@example.com"
+  first_name = "Deactivated"
+  last_name  = "Lookup"
+  active     = false
+}
+
+resource "metabase_permissions_group_membership" "%s" {
+  user_id  = metabase_user.%s_active.id
+  group_id = metabase_permissions_group.%s.id
+}
+
+# Email addresses are matched regardless of their case.
+data "metabase_user" "%s" {
+  email = upper(metabase_user.%s_deactivated.email)
+}
+
+data "metabase_users" "%s_group" {
+  group_id = metabase_permissions_group.%s.id
+
+  depends_on = [metabase_permissions_group_membership.%s]
+}
+
+data "metabase_users" "%s_deactivated" {
+  status = "deactivated"
+  query  = "lookup"
+
+  depends_on = [metabase_user.%s_deactivated]
+}
+`,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
+	)
+}
+
+func TestAccUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckUserDestroy,
+			testAccCheckPermissionsGroupDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccUserDataSource("test_lookup"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.metabase_user.test_lookup", "id", "metabase_user.test_lookup_deactivated", "id"),
+					resource.TestCheckResourceAttr("data.metabase_user.test_lookup", "first_name", "Deactivated"),
+					resource.TestCheckResourceAttr("data.metabase_user.test_lookup", "is_active", "false"),
+					resource.TestCheckResourceAttr("data.metabase_user.test_lookup", "is_superuser", "false"),
+					resource.TestCheckNoResourceAttr("data.metabase_user.test_lookup", "sso_source"),
+					resource.TestCheckResourceAttr("data.metabase_users.test_lookup_group", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.metabase_users.test_lookup_group", "ids.0", "metabase_user.test_lookup_active", "id"),
+					resource.TestCheckResourceAttr("data.metabase_users.test_lookup_group", "users.0.email", "active.lookup@example.com"),
+					resource.TestCheckResourceAttr("data.metabase_users.test_lookup_deactivated", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.metabase_users.test_lookup_deactivated", "ids.0", "metabase_user.test_lookup_deactivated", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestMakeUsersValues(t *testing.T) {
+	superuser := true
+
+	users, ids, diags := makeUsersValues([]metabase.User{
+		{Id: 8, Email: "b@example.com", FirstName: "B", LastName: "B"},
+		{Id: 3, Email: "a@example.com", FirstName: "A", LastName: "A", IsSuperuser: &superuser},
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	expectedIds := types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(3), types.Int64Value(8)})
+	if !ids.Equal(expectedIds) {
+		t.Errorf("Expected IDs %v, got %v.", expectedIds, ids)
+	}
+
+	first := users.Elements()[0].(types.Object).Attributes()
+	if !first["email"].Equal(types.StringValue("a@example.com")) || !first["is_superuser"].Equal(types.BoolValue(true)) {
+		t.Errorf("Unexpected first user: %v.", first)
+	}
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Creates a new user password reset resource.
+func NewUserPasswordResetResource() resource.Resource {
+	return &UserPasswordResetResource{
+		MetabaseBaseResource{name: "user_password_reset"},
+	}
+}
+
+// A resource sending a password reset email to a user when it is created.
+// This is a one-off operation: reading the resource does not call the Metabase API, and deleting it only removes it from
+// the state.
+type UserPasswordResetResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for a user password reset.
+type UserPasswordResetResourceModel struct {
+	Id       types.Int64 `tfsdk:"id"`       // The ID of the user.
+	UserId   types.Int64 `tfsdk:"user_id"`  // The ID of the user.
+	Triggers types.Map   `tfsdk:"triggers"` // Arbitrary values which send the email again when they change.
+}
+
+func (r *UserPasswordResetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Sends an email to a Metabase user with a link to reset their password.
+
+The email is sent when the resource is created. Changing any attribute (e.g. one of the ` + "`triggers`" + `) sends the email again. Destroying the resource does not affect the user.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the user.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"user_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the user to whom the email is sent.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which send the email again when they change, e.g. a date.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+// Sends a password reset email to the given user. Users provisioned through single sign-on do not have a Metabase
+// password, which is reported as a warning.
+func sendUserPasswordReset(ctx context.Context, client *metabase.ClientWithResponses, userId int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	getResp, err := client.GetUserWithResponse(ctx, userId)
+	diags.Append(checkMetabaseResponse(getResp, err, []int{200}, "get user")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if getResp.JSON200.SsoSource != nil {
+		diags.AddWarning(
+			"User provisioned through single sign-on.",
+			fmt.Sprintf("User %d signs in using %s, and does not use a Metabase password. Metabase may send sign-in instructions instead of a password reset link.", userId, *getResp.JSON200.SsoSource),
+		)
+	}
+
+	resetResp, err := client.ForgotPasswordWithResponse(ctx, metabase.ForgotPasswordBody{
+		Email: getResp.JSON200.Email,
+	})
+	diags.Append(checkMetabaseResponse(resetResp, err, []int{200, 204}, "send password reset email")...)
+
+	return diags
+}
+
+func (r *UserPasswordResetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *UserPasswordResetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(sendUserPasswordReset(ctx, r.client, int(data.UserId.ValueInt64()))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.UserId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPasswordResetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Sending the email is a one-off operation, there is nothing to refresh.
+}
+
+func (r *UserPasswordResetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, updates cannot occur.
+	resp.Diagnostics.AddError("Unexpected update of a user password reset.", "All attributes of a user password reset require replacement. Please report this issue to the provider developers.")
+}
+
+func (r *UserPasswordResetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Emails cannot be unsent, the resource is simply removed from the state.
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Registers the user endpoints on the stand-in server, along with the invitation and password reset endpoints. The
+// returned slice contains the email addresses to which password reset emails were sent.
+func serveStandInUserEmails(s *metabaseStandIn) (map[int]map[string]any, *[]string) {
+	users := serveStandInUsers(s)
+	resetEmails := []string{}
+
+	s.mux.HandleFunc("POST /api/user/{id}/send_invite", func(w http.ResponseWriter, r *http.Request) {
+		writeStandInJson(w, http.StatusOK, map[string]any{"success": true})
+	})
+
+	s.mux.HandleFunc("POST /api/session/forgot_password", func(w http.ResponseWriter, r *http.Request) {
+		var body metabase.ForgotPasswordBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeStandInJson(w, http.StatusBadRequest, map[string]any{"message": err.Error()})
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		resetEmails = append(resetEmails, body.Email)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return users, &resetEmails
+}
+
+func TestSendUserPasswordResetStandIn(t *testing.T) {
+	ctx := context.Background()
+	s := newMetabaseStandIn(t)
+	users, resetEmails := serveStandInUserEmails(s)
+	client := s.client()
+
+	users[1] = map[string]any{"id": 1, "email": "user@example.com", "first_name": "A", "last_name": "B"}
+	users[2] = map[string]any{"id": 2, "email": "sso@example.com", "first_name": "C", "last_name": "D", "sso_source": "google"}
+
+	diags := sendUserPasswordReset(ctx, client, 1)
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("Expected no error or warning, got %v.", diags)
+	}
+
+	diags = sendUserPasswordReset(ctx, client, 2)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("Expected a warning for the single sign-on user, got %v.", diags)
+	}
+
+	if !slices.Equal(*resetEmails, []string{"user@example.com", "sso@example.com"}) {
+		t.Errorf("Unexpected password reset emails: %v.", *resetEmails)
+	}
+
+	diags = sendUserPasswordReset(ctx, client, 3)
+	if !diags.HasError() {
+		t.Errorf("Expected an error for a missing user.")
+	}
+}
+
+func TestMakeCreateUserBodyFromModel(t *testing.T) {
+	ctx := context.Background()
+
+	data := UserResourceModel{
+		Email:           types.StringValue("user@example.com"),
+		FirstName:       types.StringValue("A"),
+		LastName:        types.StringValue("B"),
+		Password:        types.StringNull(),
+		LoginAttributes: types.MapNull(types.StringType),
+		SendInvite:      types.BoolNull(),
+	}
+
+	body, diags := makeCreateUserBodyFromModel(ctx, data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if body.Password != nil {
+		t.Errorf("Expected no password when invitations are not disabled, got %q.", *body.Password)
+	}
+
+	data.SendInvite = types.BoolValue(false)
+	body, diags = makeCreateUserBodyFromModel(ctx, data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if body.Password == nil || len(*body.Password) < randomUserPasswordLength {
+		t.Errorf("Expected a random password when invitations are disabled, got %v.", body.Password)
+	}
+
+	data.Password = types.StringValue("configured")
+	body, diags = makeCreateUserBodyFromModel(ctx, data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if body.Password == nil || *body.Password != "configured" {
+		t.Errorf("Expected the configured password, got %v.", body.Password)
+	}
+}
+
+func TestUserInvitationResourcesWithStandIn(t *testing.T) {
+	skipWithoutTerraformBinary(t)
+
+	s := newMetabaseStandIn(t)
+	_, resetEmails := serveStandInUserEmails(s)
+
+	config := func(trigger string) string {
+		return s.providerConfig() + fmt.Sprintf(`
+resource "metabase_user" "test" {
+  email       = "invited@example.com"
+  first_name  = "Invited"
+  last_name   = "User"
+  password    = "initial-password"
+  send_invite = true
+}
+
+resource "metabase_user_password_reset" "test" {
+  user_id = metabase_user.test.id
+
+  triggers = {
+    date = "%s"
+  }
+}
+`, trigger)
+	}
+
+	countRequests := func(request string) int {
+		count := 0
+		for _, r := range s.receivedRequests() {
+			if r == request {
+				count++
+			}
+		}
+		return count
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("2026-01-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_user.test", "id", "1"),
+					resource.TestCheckNoResourceAttr("metabase_user.test", "sso_source"),
+					resource.TestCheckResourceAttr("metabase_user_password_reset.test", "id", "1"),
+					func(_ *terraform.State) error {
+						if count := countRequests("POST /api/user/1/send_invite"); count != 1 {
+							return fmt.Errorf("Expected the invitation to be sent once, got %d requests.", count)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: config("2026-02-01"),
+				Check: func(_ *terraform.State) error {
+					s.mu.Lock()
+					defer s.mu.Unlock()
+
+					if len(*resetEmails) != 2 {
+						return fmt.Errorf("Expected the password reset email to be sent again, got %v.", *resetEmails)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -2,6 +2,9 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
 
 	"github.com/occam-bci/terraform-provider-metabase/metabase"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -9,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -29,16 +33,25 @@ type UserResource struct {
 
 // The Terraform model for a user.
 type UserResourceModel struct {
-	Id        types.Int64  `tfsdk:"id"`         // The ID of the user.
-	Email     types.String `tfsdk:"email"`      // The email address of the user.
-	FirstName types.String `tfsdk:"first_name"` // The first name of the user.
-	LastName  types.String `tfsdk:"last_name"`  // The last name of the user.
-	Password  types.String `tfsdk:"password"`   // The password for the user (optional).
+	Id                  types.Int64  `tfsdk:"id"`                    // The ID of the user.
+	Email               types.String `tfsdk:"email"`                 // The email address of the user.
+	FirstName           types.String `tfsdk:"first_name"`            // The first name of the user.
+	LastName            types.String `tfsdk:"last_name"`             // The last name of the user.
+	Password            types.String `tfsdk:"password"`              // The password for the user (optional).
+	IsSuperuser         types.Bool   `tfsdk:"is_superuser"`          // Whether the user is an administrator.
+	Locale              types.String `tfsdk:"locale"`                // The locale of the user.
+	LoginAttributes     types.Map    `tfsdk:"login_attributes"`      // Attributes of the user, e.g. used in data sandboxes.
+	Active              types.Bool   `tfsdk:"active"`                // Whether the user is active.
+	DeactivateOnDestroy types.Bool   `tfsdk:"deactivate_on_destroy"` // Whether the user is deactivated when the resource is destroyed.
+	SendInvite          types.Bool   `tfsdk:"send_invite"`           // Whether an invitation email is sent to the user.
+	SsoSource           types.String `tfsdk:"sso_source"`            // The single sign-on provider through which the user was provisioned.
 }
 
 func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "A Metabase user.",
+		MarkdownDescription: `A Metabase user.
+
+Metabase users cannot be deleted, only deactivated. Creating a user with the email address of a deactivated user reactivates and updates the existing user.`,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -63,11 +76,84 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"is_superuser": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is an administrator, i.e. a member of the Administrators group. If not set, this is not managed by Terraform.",
+				Optional:            true,
+			},
+			"locale": schema.StringAttribute{
+				MarkdownDescription: "The locale of the user, e.g. `fr`. If not set, the locale is not managed by Terraform, and the locale of the instance is used for new users.",
+				Optional:            true,
+			},
+			"login_attributes": schema.MapAttribute{
+				MarkdownDescription: "Attributes of the user, e.g. used to filter data in sandboxes. If not set, the attributes are not managed by Terraform.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is active. Setting this to `false` deactivates the user without destroying the resource. If not set, a user deactivated outside of Terraform is considered deleted, and is reactivated when applying.",
+				Optional:            true,
+			},
+			"deactivate_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is deactivated when the resource is destroyed. Defaults to `true`. If `false`, the user is left active in Metabase and only removed from the Terraform state.",
+				Optional:            true,
+			},
+			"send_invite": schema.BoolAttribute{
+				MarkdownDescription: "Whether an invitation email is sent to the user. If not set, Metabase only sends an invitation when the user is created without a `password`. If `false`, no invitation is sent, and a random password is set if `password` is not set (the user can then be sent a password reset email using `metabase_user_password_reset`). If `true`, the invitation is sent when the user is created or reactivated, and sent again when this changes to `true`.",
+				Optional:            true,
+			},
+			"sso_source": schema.StringAttribute{
+				MarkdownDescription: "The single sign-on provider through which the user was provisioned, e.g. `google` or `ldap`. Users provisioned through single sign-on do not use Metabase passwords.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
 		},
 	}
 }
 
-// Updates the given `UserResourceModel` from the API user response.
+// Converts the login attributes returned by the Metabase API to a Terraform map. Values which are not strings are
+// represented using their JSON encoding.
+func makeLoginAttributesValue(ctx context.Context, attributes *map[string]any) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make(map[string]string)
+	if attributes != nil {
+		for k, v := range *attributes {
+			if str, ok := v.(string); ok {
+				values[k] = str
+				continue
+			}
+
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				diags.AddError("Failed to encode login attribute.", err.Error())
+				return types.MapNull(types.StringType), diags
+			}
+			values[k] = string(encoded)
+		}
+	}
+
+	value, mapDiags := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(mapDiags...)
+
+	return value, diags
+}
+
+// Returns the login attributes from the model, or nil if they are not managed by Terraform.
+func makeLoginAttributesFromModel(ctx context.Context, attributes types.Map) (*map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if attributes.IsNull() || attributes.IsUnknown() {
+		return nil, diags
+	}
+
+	values := make(map[string]string)
+	diags.Append(attributes.ElementsAs(ctx, &values, false)...)
+
+	return &values, diags
+}
+
+// Updates the given `UserResourceModel` from the API user response. Optional settings are only updated if they are
+// managed by Terraform.
 func updateModelFromUser(ctx context.Context, u metabase.User, data *UserResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -75,10 +161,182 @@ func updateModelFromUser(ctx context.Context, u metabase.User, data *UserResourc
 	data.Email = types.StringValue(u.Email)
 	data.FirstName = types.StringValue(u.FirstName)
 	data.LastName = types.StringValue(u.LastName)
+	data.SsoSource = stringValueOrNull(u.SsoSource)
+
+	if !data.IsSuperuser.IsNull() {
+		data.IsSuperuser = types.BoolValue(u.IsSuperuser != nil && *u.IsSuperuser)
+	}
+	if !data.Locale.IsNull() {
+		data.Locale = stringValueOrNull(u.Locale)
+	}
+	if !data.LoginAttributes.IsNull() {
+		loginAttributes, loginAttributesDiags := makeLoginAttributesValue(ctx, u.LoginAttributes)
+		diags.Append(loginAttributesDiags...)
+		data.LoginAttributes = loginAttributes
+	}
+	if !data.Active.IsNull() {
+		data.Active = types.BoolValue(isUserActive(u))
+	}
 
 	return diags
 }
 
+// Returns whether the user returned by the Metabase API is active.
+func isUserActive(u metabase.User) bool {
+	return u.IsActive == nil || *u.IsActive
+}
+
+// Reactivates a deactivated user.
+func reactivateUser(ctx context.Context, client *metabase.ClientWithResponses, userId int) diag.Diagnostics {
+	reactivateResp, err := client.ReactivateUserWithResponse(ctx, userId)
+	return checkMetabaseResponse(reactivateResp, err, []int{200}, "reactivate user")
+}
+
+// Deactivates a user. This is what the delete endpoint of the Metabase API does.
+func deactivateUser(ctx context.Context, client *metabase.ClientWithResponses, userId int) diag.Diagnostics {
+	deleteResp, err := client.DeleteUserWithResponse(ctx, userId)
+	return checkMetabaseResponse(deleteResp, err, []int{204}, "deactivate user")
+}
+
+// Updates an active user from the model, and returns the updated user.
+func updateUserFromModel(ctx context.Context, client *metabase.ClientWithResponses, userId int, data UserResourceModel) (*metabase.User, diag.Diagnostics) {
+	loginAttributes, diags := makeLoginAttributesFromModel(ctx, data.LoginAttributes)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	email := data.Email.ValueString()
+	firstName := data.FirstName.ValueString()
+	lastName := data.LastName.ValueString()
+
+	updateResp, err := client.UpdateUserWithResponse(ctx, userId, metabase.UpdateUserBody{
+		Email:           &email,
+		FirstName:       &firstName,
+		LastName:        &lastName,
+		IsSuperuser:     data.IsSuperuser.ValueBoolPointer(),
+		Locale:          valueStringOrNull(data.Locale),
+		LoginAttributes: loginAttributes,
+	})
+
+	diags.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update user")...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return updateResp.JSON200, diags
+}
+
+// Deactivates the user if this is what the model requires, and returns the user as it should be reported.
+func applyUserActiveFromModel(ctx context.Context, client *metabase.ClientWithResponses, u *metabase.User, data UserResourceModel) (*metabase.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.Active.IsNull() || data.Active.ValueBool() || !isUserActive(*u) {
+		return u, diags
+	}
+
+	diags.Append(deactivateUser(ctx, client, u.Id)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	deactivated := *u
+	isActive := false
+	deactivated.IsActive = &isActive
+
+	return &deactivated, diags
+}
+
+// The length of the random passwords set to avoid sending invitations.
+const randomUserPasswordLength = 24
+
+// The character classes of the random passwords, each of them being used at least once such that the password satisfies
+// the complexity requirements Metabase may be configured with.
+var randomUserPasswordCharacterClasses = []string{
+	"abcdefghijklmnopqrstuvwxyz",
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	"0123456789",
+	"!#$%&()*+,-./:;<=>?@[]^_{|}~",
+}
+
+// Returns a random index in `[0, n)`.
+func randomIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(i.Int64()), nil
+}
+
+// Generates a random password containing at least one character of each class.
+func generateRandomUserPassword() (string, error) {
+	var allCharacters string
+	for _, class := range randomUserPasswordCharacterClasses {
+		allCharacters += class
+	}
+
+	password := make([]byte, randomUserPasswordLength)
+	for i := range password {
+		// The first characters are picked from each class, the rest from all of them.
+		characters := allCharacters
+		if i < len(randomUserPasswordCharacterClasses) {
+			characters = randomUserPasswordCharacterClasses[i]
+		}
+
+		index, err := randomIndex(len(characters))
+		if err != nil {
+			return "", err
+		}
+		password[i] = characters[index]
+	}
+
+	// Fisher-Yates shuffle, such that the position of each class is not predictable.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
+
+// Returns the body used to create a user from the model. If invitations should not be sent and no password is
+// configured, a random password is set, as Metabase only sends invitations to users created without a password.
+func makeCreateUserBodyFromModel(ctx context.Context, data UserResourceModel) (*metabase.CreateUserBody, diag.Diagnostics) {
+	loginAttributes, diags := makeLoginAttributesFromModel(ctx, data.LoginAttributes)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	createBody := metabase.CreateUserBody{
+		Email:           data.Email.ValueString(),
+		FirstName:       data.FirstName.ValueString(),
+		LastName:        data.LastName.ValueString(),
+		LoginAttributes: loginAttributes,
+		Password:        valueStringOrNull(data.Password),
+	}
+
+	if createBody.Password == nil && !data.SendInvite.IsNull() && !data.SendInvite.ValueBool() {
+		password, err := generateRandomUserPassword()
+		if err != nil {
+			diags.AddError("Failed to generate a random password.", err.Error())
+			return nil, diags
+		}
+
+		createBody.Password = &password
+	}
+
+	return &createBody, diags
+}
+
+// Sends the invitation email to a user.
+func sendUserInvite(ctx context.Context, client *metabase.ClientWithResponses, userId int) diag.Diagnostics {
+	inviteResp, err := client.SendUserInviteWithResponse(ctx, userId)
+	return checkMetabaseResponse(inviteResp, err, []int{200, 204}, "send user invite")
+}
+
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *UserResourceModel
 
@@ -87,27 +345,60 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	createBody := metabase.CreateUserBody{
-		Email:     data.Email.ValueString(),
-		FirstName: data.FirstName.ValueString(),
-		LastName:  data.LastName.ValueString(),
+	// Metabase refuses to create a user with the email address of a deactivated user, which is reactivated instead.
+	deactivatedUser, diags := findUserByEmail(ctx, r.client, data.Email.ValueString(), metabase.Deactivated)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Add password if provided
-	if !data.Password.IsNull() && !data.Password.IsUnknown() {
-		password := data.Password.ValueString()
-		createBody.Password = &password
+	var userId int
+	if deactivatedUser != nil {
+		userId = deactivatedUser.Id
+
+		resp.Diagnostics.Append(reactivateUser(ctx, r.client, userId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		createBody, diags := makeCreateUserBodyFromModel(ctx, *data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		createResp, err := r.client.CreateUserWithResponse(ctx, *createBody)
+
+		resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200}, "create user")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		userId = createResp.JSON200.Id
+	}
+
+	// Settings which cannot be passed when creating the user (and all settings of a reactivated user) are updated.
+	user, diags := updateUserFromModel(ctx, r.client, userId, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	createResp, err := r.client.CreateUserWithResponse(ctx, createBody)
+	// Metabase already sent the invitation to new users created without a password.
+	if data.SendInvite.ValueBool() && (deactivatedUser != nil || !data.Password.IsNull()) {
+		resp.Diagnostics.Append(sendUserInvite(ctx, r.client, userId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200}, "create user")...)
+	user, diags = applyUserActiveFromModel(ctx, r.client, user, *data)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Update model from created user
-	resp.Diagnostics.Append(updateModelFromUser(ctx, *createResp.JSON200, data)...)
+	resp.Diagnostics.Append(updateModelFromUser(ctx, *user, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -137,6 +428,13 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// Deactivated users are treated as deleted, as this is what the delete operation does, unless the active status
+	// is managed explicitly.
+	if data.Active.IsNull() && !isUserActive(*getUserResp.JSON200) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	resp.Diagnostics.Append(updateModelFromUser(ctx, *getUserResp.JSON200, data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -153,25 +451,43 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	email := data.Email.ValueString()
-	firstName := data.FirstName.ValueString()
-	lastName := data.LastName.ValueString()
+	var state *UserResourceModel
 
-	updateBody := metabase.UpdateUserBody{
-		Email:     &email,
-		FirstName: &firstName,
-		LastName:  &lastName,
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	userId := int(data.Id.ValueInt64())
-	updateResp, err := r.client.UpdateUserWithResponse(ctx, userId, updateBody)
 
-	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update user")...)
+	// Deactivated users cannot be updated, and are reactivated first. They are deactivated again afterwards if needed.
+	if !state.Active.IsNull() && !state.Active.ValueBool() {
+		resp.Diagnostics.Append(reactivateUser(ctx, r.client, userId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	user, diags := updateUserFromModel(ctx, r.client, userId, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SendInvite.ValueBool() && !state.SendInvite.ValueBool() {
+		resp.Diagnostics.Append(sendUserInvite(ctx, r.client, userId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	user, diags = applyUserActiveFromModel(ctx, r.client, user, *data)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromUser(ctx, *updateResp.JSON200, data)...)
+	resp.Diagnostics.Append(updateModelFromUser(ctx, *user, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -187,12 +503,16 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	deleteResp, err := r.client.DeleteUserWithResponse(ctx, int(data.Id.ValueInt64()))
+	if !data.DeactivateOnDestroy.IsNull() && !data.DeactivateOnDestroy.ValueBool() {
+		return
+	}
 
-	resp.Diagnostics.Append(checkMetabaseResponse(deleteResp, err, []int{204}, "delete user")...)
-	if resp.Diagnostics.HasError() {
+	// The user may already have been deactivated using the `active` attribute.
+	if !data.Active.IsNull() && !data.Active.ValueBool() {
 		return
 	}
+
+	resp.Diagnostics.Append(deactivateUser(ctx, r.client, int(data.Id.ValueInt64()))...)
 }
 
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
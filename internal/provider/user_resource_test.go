@@ -3,9 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -76,7 +80,8 @@ func testAccCheckUserDestroy(s *terraform.State) error {
 		if err != nil {
 			return err
 		}
-		if response.StatusCode() == 404 {
+		// Users are deactivated rather than deleted.
+		if response.StatusCode() == 404 || (response.StatusCode() == 200 && !isUserActive(*response.JSON200)) {
 			return nil
 		}
 
@@ -171,3 +176,234 @@ func TestAccUserResourceWithGroups(t *testing.T) {
 		},
 	})
 }
+
+func TestAccUserResourceLifecycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "metabase_user" "test" {
+  email        = "lifecycle.user@example.com"
+  first_name   = "Lifecycle"
+  last_name    = "User"
+  is_superuser = false
+  locale       = "fr"
+
+  login_attributes = {
+    region = "EMEA"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists("metabase_user.test"),
+					resource.TestCheckResourceAttr("metabase_user.test", "locale", "fr"),
+					resource.TestCheckResourceAttr("metabase_user.test", "login_attributes.region", "EMEA"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "metabase_user" "test" {
+  email        = "lifecycle.user@example.com"
+  first_name   = "Lifecycle"
+  last_name    = "User"
+  is_superuser = false
+  active       = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_user.test", "active", "false"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "metabase_user" "test" {
+  email        = "lifecycle.user@example.com"
+  first_name   = "Reactivated"
+  last_name    = "User"
+  is_superuser = false
+  active       = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists("metabase_user.test"),
+					resource.TestCheckResourceAttr("metabase_user.test", "active", "true"),
+					resource.TestCheckResourceAttr("metabase_user.test", "first_name", "Reactivated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserResourceWithSuperuser(name string, email string, isSuperuser bool) string {
+	return fmt.Sprintf(`
+resource "metabase_user" "%s" {
+  email        = "%s"
+  first_name   = "Returning"
+  last_name    = "User"
+  is_superuser = %t
+}
+`,
+		name,
+		email,
+		isSuperuser,
+	)
+}
+
+// Checks whether the user with the given ID is active, e.g. that it has been deactivated rather than deleted.
+func testAccCheckUserActive(userId *int, active bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		response, err := testAccMetabaseClient.GetUserWithResponse(context.Background(), *userId)
+		if err != nil {
+			return err
+		}
+		if response.StatusCode() != 200 {
+			return fmt.Errorf("Received unexpected response from the Metabase API when getting user.")
+		}
+
+		if isUserActive(*response.JSON200) != active {
+			return fmt.Errorf("Expected user %d to have active status %t.", *userId, active)
+		}
+
+		return nil
+	}
+}
+
+func TestAccUserResourceReactivation(t *testing.T) {
+	var userId int
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccUserResourceWithSuperuser("test_returning", "returning.user@example.com", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists("metabase_user.test_returning"),
+					testAccStoreResourceId("metabase_user.test_returning", &userId),
+					resource.TestCheckResourceAttr("metabase_user.test_returning", "is_superuser", "true"),
+				),
+			},
+			{
+				// Destroying the user deactivates it.
+				Config: providerConfig,
+				Check:  testAccCheckUserActive(&userId, false),
+			},
+			{
+				// Creating a user with the email address of the deactivated user reactivates it.
+				Config: providerConfig + testAccUserResourceWithSuperuser("test_returning", "returning.user@example.com", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists("metabase_user.test_returning"),
+					resource.TestCheckResourceAttrWith("metabase_user.test_returning", "id", func(value string) error {
+						if value != strconv.Itoa(userId) {
+							return fmt.Errorf("Expected the deactivated user %d to be reactivated, got user %s.", userId, value)
+						}
+						return nil
+					}),
+					testAccCheckUserActive(&userId, true),
+					resource.TestCheckResourceAttr("metabase_user.test_returning", "is_superuser", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserResourceWithoutInvite(t *testing.T) {
+	// No email is sent, such that this does not require an email server to be configured.
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "metabase_user" "test_no_invite" {
+  email       = "not.invited@example.com"
+  first_name  = "Not"
+  last_name   = "Invited"
+  send_invite = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists("metabase_user.test_no_invite"),
+					resource.TestCheckResourceAttr("metabase_user.test_no_invite", "send_invite", "false"),
+					resource.TestCheckNoResourceAttr("metabase_user.test_no_invite", "password"),
+					resource.TestCheckNoResourceAttr("metabase_user.test_no_invite", "sso_source"),
+				),
+			},
+			{
+				ResourceName: "metabase_user.test_no_invite",
+				ImportState:  true,
+			},
+		},
+	})
+}
+
+// Registers the user endpoints on the stand-in server, including the listing of users by status and reactivation.
+// Unlike Metabase, deleting a user removes it rather than deactivating it.
+func serveStandInUsers(s *metabaseStandIn) map[int]map[string]any {
+	users := s.serveObjects("/user")
+
+	s.mux.HandleFunc("GET /api/user", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		status := r.URL.Query().Get("status")
+		data := []map[string]any{}
+		for _, u := range users {
+			active := u["is_active"] != false
+			if status == "all" || (status == "deactivated") != active {
+				data = append(data, u)
+			}
+		}
+
+		writeStandInJson(w, http.StatusOK, map[string]any{"data": data, "total": len(data)})
+	})
+
+	s.mux.HandleFunc("PUT /api/user/{id}/reactivate", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id, _ := strconv.Atoi(r.PathValue("id"))
+		users[id]["is_active"] = true
+		writeStandInJson(w, http.StatusOK, users[id])
+	})
+
+	return users
+}
+
+func TestMakeLoginAttributesValue(t *testing.T) {
+	ctx := context.Background()
+
+	attributes := map[string]any{"region": "EMEA", "tenant_id": float64(42)}
+	value, diags := makeLoginAttributesValue(ctx, &attributes)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"region":    types.StringValue("EMEA"),
+		"tenant_id": types.StringValue("42"),
+	})
+	if !value.Equal(expected) {
+		t.Errorf("Expected %v, got %v.", expected, value)
+	}
+}
+
+func TestGenerateRandomUserPassword(t *testing.T) {
+	for iteration := 0; iteration < 1000; iteration++ {
+		password, err := generateRandomUserPassword()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(password) != randomUserPasswordLength {
+			t.Fatalf("Expected a password of length %d, got %q.", randomUserPasswordLength, password)
+		}
+		for _, class := range randomUserPasswordCharacterClasses {
+			if !strings.ContainsAny(password, class) {
+				t.Fatalf("Expected password %q to contain one of %q.", password, class)
+			}
+		}
+	}
+}
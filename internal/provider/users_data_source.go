@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/occam-bci/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithValidateConfig = &UsersDataSource{}
+
+// Creates a new users data source.
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// A data source listing Metabase users, optionally filtered by group, status, or search string.
+type UsersDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the users data source.
+type UsersDataSourceModel struct {
+	GroupId types.Int64  `tfsdk:"group_id"` // Only lists the members of this permissions group.
+	Status  types.String `tfsdk:"status"`   // Whether active, deactivated, or all users are listed.
+	Query   types.String `tfsdk:"query"`    // A search string matched against names and email addresses.
+	Users   types.List   `tfsdk:"users"`    // The users matching the filters.
+	Ids     types.List   `tfsdk:"ids"`      // The IDs of the users matching the filters.
+}
+
+// The statuses by which users can be filtered.
+var userStatuses = []string{string(metabase.Active), string(metabase.Deactivated), string(metabase.All)}
+
+// The object type for a single user in the list.
+var userObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":           types.Int64Type,
+		"email":        types.StringType,
+		"first_name":   types.StringType,
+		"last_name":    types.StringType,
+		"common_name":  types.StringType,
+		"is_active":    types.BoolType,
+		"is_superuser": types.BoolType,
+		"sso_source":   types.StringType,
+	},
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The list of Metabase users, optionally filtered by permissions group, status, or search string.",
+
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "Only lists the members of this permissions group.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Whether `active`, `deactivated`, or `all` users are listed. Defaults to `active`.",
+				Optional:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "A search string matched against the names and email addresses of the users.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the users matching the filters, sorted by ID.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "The users matching the filters, sorted by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the user.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the user.",
+							Computed:            true,
+						},
+						"first_name": schema.StringAttribute{
+							MarkdownDescription: "The first name of the user.",
+							Computed:            true,
+						},
+						"last_name": schema.StringAttribute{
+							MarkdownDescription: "The last name of the user.",
+							Computed:            true,
+						},
+						"common_name": schema.StringAttribute{
+							MarkdownDescription: "The full name of the user.",
+							Computed:            true,
+						},
+						"is_active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is active.",
+							Computed:            true,
+						},
+						"is_superuser": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is an administrator.",
+							Computed:            true,
+						},
+						"sso_source": schema.StringAttribute{
+							MarkdownDescription: "The single sign-on provider through which the user was provisioned, e.g. `google` or `ldap`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data UsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Status.IsNull() || data.Status.IsUnknown() || slices.Contains(userStatuses, data.Status.ValueString()) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("status"),
+		"Unsupported user status.",
+		fmt.Sprintf("Got %q, expected one of: %s.", data.Status.ValueString(), strings.Join(userStatuses, ", ")),
+	)
+}
+
+// Converts the users returned by the Metabase API to the list of users and the list of their IDs, both sorted by ID.
+func makeUsersValues(users []metabase.User) (types.List, types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	users = slices.Clone(users)
+	slices.SortFunc(users, func(a, b metabase.User) int { return a.Id - b.Id })
+
+	userValues := make([]attr.Value, 0, len(users))
+	idValues := make([]attr.Value, 0, len(users))
+	for _, u := range users {
+		var data UserDataSourceModel
+		updateModelFromUserData(u, &data)
+
+		userValue, objectDiags := types.ObjectValue(userObjectType.AttrTypes, map[string]attr.Value{
+			"id":           data.Id,
+			"email":        data.Email,
+			"first_name":   data.FirstName,
+			"last_name":    data.LastName,
+			"common_name":  data.CommonName,
+			"is_active":    data.IsActive,
+			"is_superuser": data.IsSuperuser,
+			"sso_source":   data.SsoSource,
+		})
+		diags.Append(objectDiags...)
+
+		userValues = append(userValues, userValue)
+		idValues = append(idValues, data.Id)
+	}
+
+	usersList, listDiags := types.ListValue(userObjectType, userValues)
+	diags.Append(listDiags...)
+	idsList, listDiags := types.ListValue(types.Int64Type, idValues)
+	diags.Append(listDiags...)
+
+	return usersList, idsList, diags
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, err := d.client.ListUsersWithResponse(ctx, &metabase.ListUsersParams{
+		Status:  valueApproximateStringOrNull[metabase.ListUsersParamsStatus](data.Status),
+		Query:   valueStringOrNull(data.Query),
+		GroupId: valueInt64OrNull(data.GroupId),
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(listResp, err, []int{200}, "list users")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, ids, diags := makeUsersValues(listResp.JSON200.Data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Users = users
+	data.Ids = ids
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -108,13 +108,19 @@ func importStatePassthroughIntegerId(ctx context.Context, req resource.ImportSta
 // Returns a map where keys are the IDs of the permissions groups that should be ignored when synchronizing the
 // permissions graph. If the set of ignored groups in the Terraform resource is null, it will default to the
 // administrators group only (the group is automatically granted access to all collections and datasets, and this cannot
-// be changed).
-func getIgnoredPermissionsGroups(ctx context.Context, list types.Set) (map[string]bool, diag.Diagnostics) {
+// be changed). The administrators group is found using its type rather than assuming its ID.
+func getIgnoredPermissionsGroups(ctx context.Context, names *permissionsGraphNames, list types.Set) (map[string]bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if list.IsNull() {
+		administratorsGroupId, groupDiags := names.magicGroupId(ctx, metabase.AdministratorsMagicGroupType, metabase.AdministratorsPermissionsGroupId)
+		diags.Append(groupDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
 		return map[string]bool{
-			fmt.Sprint(metabase.AdministratorsPermissionsGroupId): true,
+			fmt.Sprint(administratorsGroupId): true,
 		}, diags
 	}
 
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VirtualDashcardDataSource{}
+
+// Creates a new virtual dashcard data source.
+func NewVirtualDashcardDataSource() datasource.DataSource {
+	return &VirtualDashcardDataSource{}
+}
+
+// A data source rendering the visualization settings of a virtual dashcard, i.e. a dashcard which is not linked to a
+// card (question), such as a text or a heading.
+// This does not call the Metabase API, it only builds the (undocumented) JSON structure expected by Metabase.
+type VirtualDashcardDataSource struct{}
+
+// The Terraform model for a virtual dashcard.
+type VirtualDashcardDataSourceModel struct {
+	Type                      types.String `tfsdk:"type"`                        // The type of virtual card.
+	Text                      types.String `tfsdk:"text"`                        // The content of text and heading cards.
+	Url                       types.String `tfsdk:"url"`                         // The target of link and iframe cards.
+	Background                types.Bool   `tfsdk:"background"`                  // Whether the card has a background.
+	VisualizationSettingsJson types.String `tfsdk:"visualization_settings_json"` // The rendered visualization settings, as a JSON string.
+}
+
+// The types of virtual cards supported by the data source. The attribute containing the content of the card is
+// listed for each type.
+var virtualDashcardContentAttributes = map[string]string{
+	"action":  "text",
+	"text":    "text",
+	"heading": "text",
+	"link":    "url",
+	"iframe":  "url",
+}
+
+// Returns the sorted list of supported virtual card types.
+func virtualDashcardTypes() []string {
+	cardTypes := make([]string, 0, len(virtualDashcardContentAttributes))
+	for t := range virtualDashcardContentAttributes {
+		cardTypes = append(cardTypes, t)
+	}
+	slices.Sort(cardTypes)
+
+	return cardTypes
+}
+
+func (d *VirtualDashcardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_dashcard"
+}
+
+func (d *VirtualDashcardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The visualization settings of a virtual dashcard, i.e. a card in a dashboard which is not linked to a question.
+
+Text, heading, link, iframe and action (button) cards are defined in Metabase using a ` + "`virtual_card`" + ` structure in the visualization settings of the dashcard. This data source renders this structure, such that it can be used in ` + "`dashcard`" + ` blocks (or decoded and used in ` + "`cards_json`" + `) without writing it by hand.
+
+This data source does not call the Metabase API.`,
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of virtual card. One of `action`, `heading`, `iframe`, `link`, or `text`. Action buttons should also set the `action_id` of the dashcard.",
+				Required:            true,
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The content of the card. This is Markdown for `text` cards, plain text for `heading` cards, and the label of the button for `action` cards. Required for those types.",
+				Optional:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL to which `link` cards point, or the URL (or `<iframe>` embed code) displayed by `iframe` cards. Required for those types.",
+				Optional:            true,
+			},
+			"background": schema.BoolAttribute{
+				MarkdownDescription: "Whether the card is displayed with a background. Only applies to `text` and `heading` cards. If not set, the Metabase default is used.",
+				Optional:            true,
+			},
+			"visualization_settings_json": schema.StringAttribute{
+				MarkdownDescription: "The visualization settings of the dashcard, as a JSON string. This can be used as the `visualization_settings_json` of a `dashcard` block, or decoded and used as the `visualization_settings` in `cards_json`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Makes the visualization settings of a virtual dashcard from the data source model.
+func makeVirtualDashcardVisualizationSettings(data VirtualDashcardDataSourceModel) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	cardType := data.Type.ValueString()
+	contentAttribute, ok := virtualDashcardContentAttributes[cardType]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Unsupported virtual card type.",
+			fmt.Sprintf("Got %q, expected one of: %s.", cardType, strings.Join(virtualDashcardTypes(), ", ")),
+		)
+		return nil, diags
+	}
+
+	content := data.Text
+	if contentAttribute == "url" {
+		content = data.Url
+	}
+	if content.IsNull() {
+		diags.AddAttributeError(path.Root(contentAttribute), "Missing virtual card content.", fmt.Sprintf("The %s attribute is required for %s cards.", contentAttribute, cardType))
+		return nil, diags
+	}
+
+	settings := map[string]any{
+		"virtual_card": map[string]any{
+			"name":                   nil,
+			"display":                cardType,
+			"visualization_settings": map[string]any{},
+			"dataset_query":          map[string]any{},
+			"archived":               false,
+		},
+	}
+
+	switch cardType {
+	case "text", "heading":
+		settings["text"] = content.ValueString()
+		if !data.Background.IsNull() {
+			settings["dashcard.background"] = data.Background.ValueBool()
+		}
+	case "action":
+		settings["button.label"] = content.ValueString()
+		settings["actionDisplayType"] = "button"
+	case "link":
+		settings["link"] = map[string]any{"url": content.ValueString()}
+	case "iframe":
+		settings["iframe"] = content.ValueString()
+	}
+
+	return settings, diags
+}
+
+func (d *VirtualDashcardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtualDashcardDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := makeVirtualDashcardVisualizationSettings(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsBytes, err := json.Marshal(settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Error serializing virtual dashcard visualization settings.", err.Error())
+		return
+	}
+
+	data.VisualizationSettingsJson = types.StringValue(string(settingsBytes))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMakeVirtualDashcardVisualizationSettings(t *testing.T) {
+	virtualCard := func(display string) map[string]any {
+		return map[string]any{
+			"name":                   nil,
+			"display":                display,
+			"visualization_settings": map[string]any{},
+			"dataset_query":          map[string]any{},
+			"archived":               false,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		data     VirtualDashcardDataSourceModel
+		expected map[string]any
+		hasError bool
+	}{
+		{
+			name: "text without background",
+			data: VirtualDashcardDataSourceModel{
+				Type:       types.StringValue("text"),
+				Text:       types.StringValue("# Title"),
+				Url:        types.StringNull(),
+				Background: types.BoolValue(false),
+			},
+			expected: map[string]any{
+				"virtual_card":        virtualCard("text"),
+				"text":                "# Title",
+				"dashcard.background": false,
+			},
+		},
+		{
+			name: "heading",
+			data: VirtualDashcardDataSourceModel{
+				Type:       types.StringValue("heading"),
+				Text:       types.StringValue("Section"),
+				Url:        types.StringNull(),
+				Background: types.BoolNull(),
+			},
+			expected: map[string]any{
+				"virtual_card": virtualCard("heading"),
+				"text":         "Section",
+			},
+		},
+		{
+			name: "link",
+			data: VirtualDashcardDataSourceModel{
+				Type:       types.StringValue("link"),
+				Text:       types.StringNull(),
+				Url:        types.StringValue("https://www.metabase.com"),
+				Background: types.BoolNull(),
+			},
+			expected: map[string]any{
+				"virtual_card": virtualCard("link"),
+				"link":         map[string]any{"url": "https://www.metabase.com"},
+			},
+		},
+		{
+			name: "iframe",
+			data: VirtualDashcardDataSourceModel{
+				Type:       types.StringValue("iframe"),
+				Text:       types.StringNull(),
+				Url:        types.StringValue("https://www.example.com/embed"),
+				Background: types.BoolNull(),
+			},
+			expected: map[string]any{
+				"virtual_card": virtualCard("iframe"),
+				"iframe":       "https://www.example.com/embed",
+			},
+		},
+		{
+			name: "action button",
+			data: VirtualDashcardDataSourceModel{
+				Type:       types.StringValue("action"),
+				Text:       types.StringValue("Approve"),
+				Url:        types.StringNull(),
+				Background: types.BoolNull(),
+			},
+			expected: map[string]any{
+				"virtual_card":      virtualCard("action"),
+				"button.label":      "Approve",
+				"actionDisplayType": "button",
+			},
+		},
+		{
+			name: "missing content",
+			data: VirtualDashcardDataSourceModel{
+				Type:       types.StringValue("link"),
+				Text:       types.StringValue("Not a URL"),
+				Url:        types.StringNull(),
+				Background: types.BoolNull(),
+			},
+			hasError: true,
+		},
+		{
+			name: "unsupported type",
+			data: VirtualDashcardDataSourceModel{
+				Type:       types.StringValue("placeholder"),
+				Text:       types.StringNull(),
+				Url:        types.StringNull(),
+				Background: types.BoolNull(),
+			},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, diags := makeVirtualDashcardVisualizationSettings(tt.data)
+			if diags.HasError() != tt.hasError {
+				t.Fatalf("makeVirtualDashcardVisualizationSettings() errors = %v, want error: %v", diags, tt.hasError)
+			}
+
+			if !tt.hasError && !reflect.DeepEqual(settings, tt.expected) {
+				t.Errorf("makeVirtualDashcardVisualizationSettings() = %v, want %v", settings, tt.expected)
+			}
+		})
+	}
+}
+
+func testAccVirtualDashcardDataSource() string {
+	return `
+data "metabase_virtual_dashcard" "heading" {
+  type = "heading"
+  text = "Section title"
+}
+
+resource "metabase_dashboard" "virtual_cards" {
+  name = "Dashboard with virtual cards"
+
+  dashcard {
+    col                         = 0
+    row                         = 0
+    size_x                      = 24
+    size_y                      = 1
+    visualization_settings_json = data.metabase_virtual_dashcard.heading.visualization_settings_json
+  }
+}
+`
+}
+
+func TestAccVirtualDashcardDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerApiKeyConfig + testAccVirtualDashcardDataSource(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.metabase_virtual_dashcard.heading", "visualization_settings_json"),
+					resource.TestCheckResourceAttrPair(
+						"metabase_dashboard.virtual_cards", "dashcard.0.visualization_settings_json",
+						"data.metabase_virtual_dashcard.heading", "visualization_settings_json",
+					),
+				),
+			},
+		},
+	})
+}
@@ -22,6 +22,35 @@ const (
 	SessionScopes = "Session.Scopes"
 )
 
+// Defines values for ActionType.
+const (
+	Http     ActionType = "http"
+	Implicit ActionType = "implicit"
+	Query    ActionType = "query"
+)
+
+// Defines values for AlertAlertCondition.
+const (
+	AlertAlertConditionGoal AlertAlertCondition = "goal"
+	AlertAlertConditionRows AlertAlertCondition = "rows"
+)
+
+// Defines values for CacheConfigModel.
+const (
+	CacheConfigModelDashboard CacheConfigModel = "dashboard"
+	CacheConfigModelDatabase  CacheConfigModel = "database"
+	CacheConfigModelQuestion  CacheConfigModel = "question"
+	CacheConfigModelRoot      CacheConfigModel = "root"
+)
+
+// Defines values for CacheStrategyType.
+const (
+	Duration CacheStrategyType = "duration"
+	Nocache  CacheStrategyType = "nocache"
+	Schedule CacheStrategyType = "schedule"
+	Ttl      CacheStrategyType = "ttl"
+)
+
 // Defines values for CollectionItemModel.
 const (
 	CollectionItemModelCard       CollectionItemModel = "card"
@@ -41,11 +70,17 @@ const (
 	CollectionPermissionLevelWrite CollectionPermissionLevel = "write"
 )
 
+// Defines values for CreateAlertBodyAlertCondition.
+const (
+	CreateAlertBodyAlertConditionGoal CreateAlertBodyAlertCondition = "goal"
+	CreateAlertBodyAlertConditionRows CreateAlertBodyAlertCondition = "rows"
+)
+
 // Defines values for DatabaseDetailsBigQueryDatasetFiltersType.
 const (
-	All       DatabaseDetailsBigQueryDatasetFiltersType = "all"
-	Exclusion DatabaseDetailsBigQueryDatasetFiltersType = "exclusion"
-	Inclusion DatabaseDetailsBigQueryDatasetFiltersType = "inclusion"
+	DatabaseDetailsBigQueryDatasetFiltersTypeAll       DatabaseDetailsBigQueryDatasetFiltersType = "all"
+	DatabaseDetailsBigQueryDatasetFiltersTypeExclusion DatabaseDetailsBigQueryDatasetFiltersType = "exclusion"
+	DatabaseDetailsBigQueryDatasetFiltersTypeInclusion DatabaseDetailsBigQueryDatasetFiltersType = "inclusion"
 )
 
 // Defines values for DatabaseEngine.
@@ -60,6 +95,22 @@ const (
 	PermissionsGraphDatabaseAccessSchemas0None PermissionsGraphDatabaseAccessSchemas0 = "none"
 )
 
+// Defines values for PermissionsGraphDatabasePermissionsDetails.
+const (
+	PermissionsGraphDatabasePermissionsDetailsNo  PermissionsGraphDatabasePermissionsDetails = "no"
+	PermissionsGraphDatabasePermissionsDetailsYes PermissionsGraphDatabasePermissionsDetails = "yes"
+)
+
+// Defines values for PermissionsGraphDatabasePermissionsViewData0.
+const (
+	PermissionsGraphDatabasePermissionsViewData0Blocked             PermissionsGraphDatabasePermissionsViewData0 = "blocked"
+	PermissionsGraphDatabasePermissionsViewData0Impersonated        PermissionsGraphDatabasePermissionsViewData0 = "impersonated"
+	PermissionsGraphDatabasePermissionsViewData0LegacyNoSelfService PermissionsGraphDatabasePermissionsViewData0 = "legacy-no-self-service"
+	PermissionsGraphDatabasePermissionsViewData0No                  PermissionsGraphDatabasePermissionsViewData0 = "no"
+	PermissionsGraphDatabasePermissionsViewData0Sandboxed           PermissionsGraphDatabasePermissionsViewData0 = "sandboxed"
+	PermissionsGraphDatabasePermissionsViewData0Unrestricted        PermissionsGraphDatabasePermissionsViewData0 = "unrestricted"
+)
+
 // Defines values for PermissionsGraphDatabasePermissionsCreateQueries.
 const (
 	PermissionsGraphDatabasePermissionsCreateQueriesNo                    PermissionsGraphDatabasePermissionsCreateQueries = "no"
@@ -67,19 +118,47 @@ const (
 	PermissionsGraphDatabasePermissionsCreateQueriesQueryBuilderAndNative PermissionsGraphDatabasePermissionsCreateQueries = "query-builder-and-native"
 )
 
-// Defines values for PermissionsGraphDatabasePermissionsDetails.
+// Defines values for PulseChannelChannelType.
 const (
-	PermissionsGraphDatabasePermissionsDetailsNo  PermissionsGraphDatabasePermissionsDetails = "no"
-	PermissionsGraphDatabasePermissionsDetailsYes PermissionsGraphDatabasePermissionsDetails = "yes"
+	Email PulseChannelChannelType = "email"
+	Slack PulseChannelChannelType = "slack"
 )
 
-// Defines values for PermissionsGraphDatabasePermissionsViewData0.
+// Defines values for PulseChannelScheduleType.
+const (
+	Daily   PulseChannelScheduleType = "daily"
+	Hourly  PulseChannelScheduleType = "hourly"
+	Monthly PulseChannelScheduleType = "monthly"
+	Weekly  PulseChannelScheduleType = "weekly"
+)
+
+// Defines values for RevisionEntity.
 const (
-	Blocked             PermissionsGraphDatabasePermissionsViewData0 = "blocked"
-	Impersonated        PermissionsGraphDatabasePermissionsViewData0 = "impersonated"
-	LegacyNoSelfService PermissionsGraphDatabasePermissionsViewData0 = "legacy-no-self-service"
-	No                  PermissionsGraphDatabasePermissionsViewData0 = "no"
-	Unrestricted        PermissionsGraphDatabasePermissionsViewData0 = "unrestricted"
+	RevisionEntityCard      RevisionEntity = "card"
+	RevisionEntityDashboard RevisionEntity = "dashboard"
+)
+
+// Defines values for TimelineIcon.
+const (
+	Balloons TimelineIcon = "balloons"
+	Bell     TimelineIcon = "bell"
+	Cloud    TimelineIcon = "cloud"
+	Mail     TimelineIcon = "mail"
+	Star     TimelineIcon = "star"
+	Warning  TimelineIcon = "warning"
+)
+
+// Defines values for UpdateAlertBodyAlertCondition.
+const (
+	Goal UpdateAlertBodyAlertCondition = "goal"
+	Rows UpdateAlertBodyAlertCondition = "rows"
+)
+
+// Defines values for ListCollectionItemsParamsPinnedState.
+const (
+	ListCollectionItemsParamsPinnedStateAll         ListCollectionItemsParamsPinnedState = "all"
+	ListCollectionItemsParamsPinnedStateIsNotPinned ListCollectionItemsParamsPinnedState = "is_not_pinned"
+	ListCollectionItemsParamsPinnedStateIsPinned    ListCollectionItemsParamsPinnedState = "is_pinned"
 )
 
 // Defines values for ListDatabasesParamsInclude.
@@ -87,16 +166,176 @@ const (
 	Tables ListDatabasesParamsInclude = "tables"
 )
 
+// Defines values for ListUsersParamsStatus.
+const (
+	Active      ListUsersParamsStatus = "active"
+	All         ListUsersParamsStatus = "all"
+	Deactivated ListUsersParamsStatus = "deactivated"
+)
+
+// Action An action, which writes to the database of a model.
+type Action struct {
+	// Archived Whether the action has been archived.
+	Archived *bool `json:"archived,omitempty"`
+
+	// DatabaseId For query actions, the ID of the database on which the query is run.
+	DatabaseId *int `json:"database_id"`
+
+	// DatasetQuery For query actions, the query which is run.
+	DatasetQuery *map[string]interface{} `json:"dataset_query"`
+
+	// Description A description for the action.
+	Description *string `json:"description"`
+
+	// Id The ID of the action.
+	Id int `json:"id"`
+
+	// Kind For implicit actions, the kind of action, e.g. `row/create`.
+	Kind *string `json:"kind"`
+
+	// ModelId The ID of the model (card) on which the action is defined.
+	ModelId int `json:"model_id"`
+
+	// Name The name of the action.
+	Name string `json:"name"`
+
+	// Parameters The parameters of the action.
+	Parameters *[]ActionParameter `json:"parameters,omitempty"`
+
+	// Type The type of action.
+	Type ActionType `json:"type"`
+
+	// VisualizationSettings The settings of the action form.
+	VisualizationSettings *map[string]interface{} `json:"visualization_settings"`
+}
+
+// ActionParameter A parameter of an action.
+type ActionParameter struct {
+	// Id The ID of the parameter.
+	Id string `json:"id"`
+
+	// Name The displayed name of the parameter.
+	Name *string `json:"name,omitempty"`
+
+	// Required Whether the parameter is required.
+	Required *bool `json:"required,omitempty"`
+
+	// Slug The slug of the parameter.
+	Slug *string `json:"slug,omitempty"`
+
+	// Target The target of the parameter, e.g. a template tag of the query.
+	Target *[]interface{} `json:"target,omitempty"`
+
+	// Type The type of parameter, e.g. `string/=`.
+	Type                 string                 `json:"type"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// ActionType The type of action.
+type ActionType string
+
+// Alert An alert, which notifies recipients when a card returns results or reaches its goal line.
+type Alert struct {
+	// AlertAboveGoal For goal alerts, whether the alert is triggered when the result goes above (rather than below) the goal.
+	AlertAboveGoal *bool `json:"alert_above_goal"`
+
+	// AlertCondition The condition triggering the alert.
+	AlertCondition AlertAlertCondition `json:"alert_condition"`
+
+	// AlertFirstOnly Whether the alert is deleted after being sent once.
+	AlertFirstOnly bool `json:"alert_first_only"`
+
+	// Archived Whether the alert has been archived.
+	Archived *bool `json:"archived,omitempty"`
+
+	// Card The card on which an alert is defined.
+	Card AlertCard `json:"card"`
+
+	// Channels The channels through which the alert is sent.
+	Channels []PulseChannel `json:"channels"`
+
+	// Id The ID of the alert.
+	Id int `json:"id"`
+}
+
+// AlertAlertCondition The condition triggering the alert.
+type AlertAlertCondition string
+
+// AlertCard The card on which an alert is defined.
+type AlertCard struct {
+	// Id The ID of the card.
+	Id int `json:"id"`
+
+	// IncludeCsv Whether the results of the card are attached as a CSV file.
+	IncludeCsv *bool `json:"include_csv,omitempty"`
+
+	// IncludeXls Whether the results of the card are attached as an XLSX file.
+	IncludeXls *bool `json:"include_xls,omitempty"`
+}
+
+// CacheConfig The cache configuration of a model.
+type CacheConfig struct {
+	// Model The type of model to which a cache configuration applies.
+	Model CacheConfigModel `json:"model"`
+
+	// ModelId The ID of the model. This is 0 for the root configuration.
+	ModelId int `json:"model_id"`
+
+	// Strategy The caching strategy for a model. Fields other than `type` depend on the strategy.
+	Strategy CacheStrategy `json:"strategy"`
+}
+
+// CacheConfigList A list of cache configurations.
+type CacheConfigList struct {
+	Data []CacheConfig `json:"data"`
+}
+
+// CacheConfigModel The type of model to which a cache configuration applies.
+type CacheConfigModel string
+
+// CacheStrategy The caching strategy for a model. Fields other than `type` depend on the strategy.
+type CacheStrategy struct {
+	// Duration For the `duration` strategy, how long results are cached.
+	Duration *int `json:"duration,omitempty"`
+
+	// MinDurationMs For the `ttl` strategy, the minimum query duration for results to be cached.
+	MinDurationMs *int `json:"min_duration_ms,omitempty"`
+
+	// Multiplier For the `ttl` strategy, the multiplier applied to the average query duration.
+	Multiplier *int `json:"multiplier,omitempty"`
+
+	// Schedule For the `schedule` strategy, the cron expression at which the cache is invalidated.
+	Schedule *string `json:"schedule,omitempty"`
+
+	// Type The type of strategy.
+	Type CacheStrategyType `json:"type"`
+
+	// Unit For the `duration` strategy, the unit of the duration.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// CacheStrategyType The type of strategy.
+type CacheStrategyType string
+
 // Card A card (or question).
 type Card struct {
 	// Archived Whether the card has been archived.
 	Archived bool `json:"archived"`
 
+	// EmbeddingParams The embedding mode for each parameter, keyed by parameter slug.
+	EmbeddingParams *map[string]string `json:"embedding_params"`
+
+	// EnableEmbedding Whether signed embedding is enabled.
+	EnableEmbedding *bool `json:"enable_embedding,omitempty"`
+
 	// Id The ID of the card.
 	Id int `json:"id"`
 
 	// Name The name of the card.
-	Name                 string                 `json:"name"`
+	Name string `json:"name"`
+
+	// PublicUuid The UUID used in the public link, if public sharing is enabled.
+	PublicUuid           *string                `json:"public_uuid"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
@@ -146,6 +385,9 @@ type Collection_Id struct {
 
 // CollectionItem An item (dashboard, dataset, timeline, etc) in a collection.
 type CollectionItem struct {
+	// CollectionPosition The position of the item among the pinned items of the collection, if it is pinned.
+	CollectionPosition *int `json:"collection_position"`
+
 	// Description A description for the item.
 	Description *string `json:"description"`
 
@@ -198,6 +440,90 @@ type CollectionPermissionsGraph struct {
 // CollectionPermissionsGraphCollectionPermissionsMap A map where keys are collection IDs and values are permission levels.
 type CollectionPermissionsGraphCollectionPermissionsMap map[string]CollectionPermissionLevel
 
+// ConnectionImpersonation The database role used to run the queries of a group on a database, set from a user attribute.
+type ConnectionImpersonation struct {
+	// Attribute The user attribute containing the database role.
+	Attribute string `json:"attribute"`
+
+	// DbId The ID of the database.
+	DbId int `json:"db_id"`
+
+	// GroupId The ID of the impersonated group.
+	GroupId int `json:"group_id"`
+
+	// Id The ID of the impersonation.
+	Id *int `json:"id,omitempty"`
+}
+
+// CopyDashboardBody The body of the payload when copying a dashboard.
+type CopyDashboardBody struct {
+	// CollectionId The ID of the collection in which the new dashboard is placed.
+	CollectionId *int `json:"collection_id"`
+
+	// CollectionPosition The position of the new dashboard in the collection.
+	CollectionPosition *int `json:"collection_position"`
+
+	// Description A description for the new dashboard.
+	Description *string `json:"description"`
+
+	// IsDeepCopy Whether the cards in the dashboard are also copied. Otherwise, the new dashboard references the same cards.
+	IsDeepCopy *bool `json:"is_deep_copy,omitempty"`
+
+	// Name The name of the new dashboard.
+	Name string `json:"name"`
+}
+
+// CreateActionBody The payload when creating a new action.
+type CreateActionBody struct {
+	// DatabaseId For query actions, the ID of the database on which the query is run.
+	DatabaseId *int `json:"database_id,omitempty"`
+
+	// DatasetQuery For query actions, the query which is run.
+	DatasetQuery *map[string]interface{} `json:"dataset_query,omitempty"`
+
+	// Description A description for the action.
+	Description *string `json:"description"`
+
+	// Kind For implicit actions, the kind of action, e.g. `row/create`.
+	Kind *string `json:"kind,omitempty"`
+
+	// ModelId The ID of the model (card) on which the action is defined.
+	ModelId int `json:"model_id"`
+
+	// Name The name of the action.
+	Name string `json:"name"`
+
+	// Parameters The parameters of the action.
+	Parameters *[]ActionParameter `json:"parameters,omitempty"`
+
+	// Type The type of action.
+	Type ActionType `json:"type"`
+
+	// VisualizationSettings The settings of the action form.
+	VisualizationSettings *map[string]interface{} `json:"visualization_settings,omitempty"`
+}
+
+// CreateAlertBody The payload used to create a new alert.
+type CreateAlertBody struct {
+	// AlertAboveGoal For goal alerts, whether the alert is triggered when the result goes above (rather than below) the goal.
+	AlertAboveGoal *bool `json:"alert_above_goal"`
+
+	// AlertCondition The condition triggering the alert.
+	AlertCondition CreateAlertBodyAlertCondition `json:"alert_condition"`
+
+	// AlertFirstOnly Whether the alert is deleted after being sent once.
+	AlertFirstOnly bool `json:"alert_first_only"`
+
+	// Card The card on which an alert is defined.
+	Card AlertCard `json:"card"`
+
+	// Channels The channels through which the alert is sent.
+	Channels []PulseChannel `json:"channels"`
+}
+
+// CreateAlertBodyAlertCondition The condition triggering the alert.
+type CreateAlertBodyAlertCondition string
+
 // CreateCardBody The payload when creating a new card.
 type CreateCardBody map[string]interface{}
 
@@ -252,6 +578,59 @@ type CreatePermissionsGroupBody struct {
 	Name string `json:"name"`
 }
 
+// CreatePermissionsMembershipBody The payload used to add a user to a permissions group.
+type CreatePermissionsMembershipBody struct {
+	// GroupId The ID of the permissions group.
+	GroupId int `json:"group_id"`
+
+	// IsGroupManager Whether the user is a manager of the group. This requires advanced permissions.
+	IsGroupManager *bool `json:"is_group_manager,omitempty"`
+
+	// UserId The ID of the user.
+	UserId int `json:"user_id"`
+}
+
+// CreatePulseBody The payload used to create a new pulse.
+type CreatePulseBody struct {
+	// Cards The cards sent by the pulse.
+	Cards []PulseCard `json:"cards"`
+
+	// Channels The channels through which the pulse is sent.
+	Channels []PulseChannel `json:"channels"`
+
+	// CollectionId The ID of the collection in which the pulse is placed.
+	CollectionId *int `json:"collection_id"`
+
+	// DashboardId The ID of the dashboard, for dashboard subscriptions.
+	DashboardId *int `json:"dashboard_id"`
+
+	// Name The name of the pulse.
+	Name string `json:"name"`
+
+	// Parameters The values of the dashboard parameters used when sending the pulse.
+	Parameters *[]PulseParameter `json:"parameters,omitempty"`
+
+	// SkipIfEmpty Whether the pulse is not sent when all cards have no results.
+	SkipIfEmpty *bool `json:"skip_if_empty,omitempty"`
+}
+
+// CreateSandboxBody The payload when creating a new sandbox.
+type CreateSandboxBody struct {
+	// AttributeRemappings A map where keys are user attributes and values are the targets they filter on. A target is either a field of the
+	// table (`["dimension", ["field", 1, null]]`), or a template tag of the sandbox question (`["variable",
+	// ["template-tag", "tag"]]` or `["dimension", ["template-tag", "tag"]]` for field filters).
+	AttributeRemappings *SandboxAttributeRemappings `json:"attribute_remappings,omitempty"`
+
+	// CardId The ID of the question used to filter the table, if any.
+	CardId *int `json:"card_id"`
+
+	// GroupId The ID of the sandboxed group.
+	GroupId int `json:"group_id"`
+
+	// TableId The ID of the sandboxed table.
+	TableId int `json:"table_id"`
+}
+
 // CreateSessionBody The credentials required to create a session.
 type CreateSessionBody struct {
 	// Password The password for the account.
@@ -261,6 +640,45 @@ type CreateSessionBody struct {
 	Username string `json:"username"`
 }
 
+// CreateTimelineBody The payload when creating a new timeline.
+type CreateTimelineBody struct {
+	// CollectionId The ID of the collection in which the timeline is placed, or null for the root collection.
+	CollectionId *int `json:"collection_id"`
+
+	// Description A description for the timeline.
+	Description *string `json:"description"`
+
+	// Icon The icon of a timeline or event.
+	Icon *TimelineIcon `json:"icon,omitempty"`
+
+	// Name The name of the timeline.
+	Name string `json:"name"`
+}
+
+// CreateTimelineEventBody The payload when creating a new timeline event.
+type CreateTimelineEventBody struct {
+	// Description A description for the event.
+	Description *string `json:"description"`
+
+	// Icon The icon of a timeline or event.
+	Icon *TimelineIcon `json:"icon,omitempty"`
+
+	// Name The name of the event.
+	Name string `json:"name"`
+
+	// TimeMatters Whether the time of the event is displayed, or only its date.
+	TimeMatters *bool `json:"time_matters,omitempty"`
+
+	// TimelineId The ID of the timeline containing the event.
+	TimelineId int `json:"timeline_id"`
+
+	// Timestamp When the event happened.
+	Timestamp string `json:"timestamp"`
+
+	// Timezone The timezone in which the event is displayed.
+	Timezone string `json:"timezone"`
+}
+
 // CreateUserBody The payload when creating a new user.
 type CreateUserBody struct {
 	// Email The email address of the user.
@@ -272,6 +690,9 @@ type CreateUserBody struct {
 	// LastName The last name of the user.
 	LastName string `json:"last_name"`
 
+	// LoginAttributes Attributes of the user, e.g. used in data sandboxes.
+	LoginAttributes *map[string]string `json:"login_attributes,omitempty"`
+
 	// Password The password for the user (optional, can be set later).
 	Password *string `json:"password,omitempty"`
 }
@@ -296,6 +717,12 @@ type Dashboard struct {
 	// Description A description for the dashboard.
 	Description *string `json:"description"`
 
+	// EmbeddingParams The embedding mode for each parameter, keyed by parameter slug.
+	EmbeddingParams *map[string]string `json:"embedding_params"`
+
+	// EnableEmbedding Whether signed embedding is enabled.
+	EnableEmbedding *bool `json:"enable_embedding,omitempty"`
+
 	// Id The ID of the dashboard.
 	Id int `json:"id"`
 
@@ -305,18 +732,27 @@ type Dashboard struct {
 	// Parameters A list of parameters for the dashboard, that the user can tweak.
 	Parameters []DashboardParameter `json:"parameters"`
 
+	// PublicUuid The UUID used in the public link, if public sharing is enabled.
+	PublicUuid *string `json:"public_uuid"`
+
 	// Tabs The list of tabs in the dashboard.
 	Tabs []DashboardTab `json:"tabs"`
 }
 
 // DashboardCard A card within a dashboard.
 type DashboardCard struct {
+	// ActionId For action buttons, the ID of the action run when the button is clicked.
+	ActionId *int `json:"action_id"`
+
 	// CardId The ID of the card.
 	CardId *int `json:"card_id"`
 
 	// Col The index of the column at which the card is placed.
 	Col int `json:"col"`
 
+	// DashboardTabId The ID of the tab in which the card is placed.
+	DashboardTabId *int `json:"dashboard_tab_id"`
+
 	// Id The ID of the dashboard card.
 	Id int `json:"id"`
 
@@ -440,6 +876,18 @@ type DatabaseList struct {
 	Total int `json:"total"`
 }
 
+// DeleteCacheConfigBody The body of the payload when deleting cache configurations.
+type DeleteCacheConfigBody struct {
+	// Model The type of model to which a cache configuration applies.
+	Model CacheConfigModel `json:"model"`
+
+	// ModelId The IDs of the models for which the configuration is deleted.
+	ModelId []int `json:"model_id"`
+}
+
+// EmbeddingParams The embedding mode for each parameter, keyed by parameter slug. Values are `locked`, `enabled`, or `disabled`.
+type EmbeddingParams map[string]string
+
 // Field A field in a database.
 type Field struct {
 	// Description The description of the field.
@@ -461,11 +909,20 @@ type Field struct {
 	TableId int `json:"table_id"`
 }
 
+// ForgotPasswordBody The payload used to request a password reset email.
+type ForgotPasswordBody struct {
+	// Email The email address of the user.
+	Email string `json:"email"`
+}
+
 // PermissionsGraph The entire permission graph for databases.
 type PermissionsGraph struct {
 	// Groups A map where keys are group IDs and values are permissions for this group.
 	Groups map[string]PermissionsGraphDatabasePermissionsMap `json:"groups"`
 
+	// Impersonations The connection impersonations to create or update along with the graph. This is only sent to the API.
+	Impersonations *[]ConnectionImpersonation `json:"impersonations,omitempty"`
+
 	// Revision The revision of the permissions graph.
 	Revision int `json:"revision"`
 }
@@ -478,6 +935,9 @@ type PermissionsGraphDatabaseAccess struct {
 // PermissionsGraphDatabaseAccessSchemas0 Whether "Data access" is allowed.
 type PermissionsGraphDatabaseAccessSchemas0 string
 
+// PermissionsGraphDatabaseAccessSchemas1 An object containing granular permissions, for each schema and table.
+type PermissionsGraphDatabaseAccessSchemas1 map[string]interface{}
+
 // PermissionsGraphDatabaseAccess_Schemas defines model for PermissionsGraphDatabaseAccess.Schemas.
 type PermissionsGraphDatabaseAccess_Schemas struct {
 	union json.RawMessage
@@ -485,8 +945,7 @@ type PermissionsGraphDatabaseAccess_Schemas struct {
 
 // PermissionsGraphDatabasePermissions The permissions related to a single database.
 type PermissionsGraphDatabasePermissions struct {
-	// CreateQueries The permission definition for creating queries.
-	CreateQueries *PermissionsGraphDatabasePermissionsCreateQueries `json:"create-queries,omitempty"`
+	CreateQueries *PermissionsGraphDatabasePermissions_CreateQueries `json:"create-queries,omitempty"`
 
 	// DataModel The permissions for a single access type.
 	DataModel *PermissionsGraphDatabaseAccess `json:"data-model,omitempty"`
@@ -499,8 +958,13 @@ type PermissionsGraphDatabasePermissions struct {
 	ViewData PermissionsGraphDatabasePermissions_ViewData `json:"view-data"`
 }
 
-// PermissionsGraphDatabasePermissionsCreateQueries The permission definition for creating queries.
-type PermissionsGraphDatabasePermissionsCreateQueries string
+// PermissionsGraphDatabasePermissionsCreateQueries1 An object containing granular permissions, for each schema and table.
+type PermissionsGraphDatabasePermissionsCreateQueries1 map[string]interface{}
+
+// PermissionsGraphDatabasePermissions_CreateQueries defines model for PermissionsGraphDatabasePermissions.CreateQueries.
+type PermissionsGraphDatabasePermissions_CreateQueries struct {
+	union json.RawMessage
+}
 
 // PermissionsGraphDatabasePermissionsDetails The permission definition for accessing details.
 type PermissionsGraphDatabasePermissionsDetails string
@@ -516,6 +980,9 @@ type PermissionsGraphDatabasePermissions_ViewData struct {
 	union json.RawMessage
 }
 
+// PermissionsGraphDatabasePermissionsCreateQueries The permission definition for creating queries.
+type PermissionsGraphDatabasePermissionsCreateQueries string
+
 // PermissionsGraphDatabasePermissionsMap A map where keys are database IDs and values are permissions related to the database.
 type PermissionsGraphDatabasePermissionsMap map[string]PermissionsGraphDatabasePermissions
 
@@ -524,55 +991,271 @@ type PermissionsGroup struct {
 	// Id The ID of the permissions group.
 	Id int `json:"id"`
 
+	// MagicGroupType The type of the group if it was created automatically by Metabase, e.g. `admin` or `all-internal-users`.
+	// This is not returned by older versions of Metabase.
+	MagicGroupType *string `json:"magic_group_type"`
+
+	// MemberCount The number of members in the group. This is only returned when listing groups.
+	MemberCount *int `json:"member_count,omitempty"`
+
+	// Members The members of the group. This is only returned when retrieving a single group.
+	Members *[]PermissionsGroupMember `json:"members,omitempty"`
+
 	// Name A user-displayable name for the group.
 	Name string `json:"name"`
 }
 
-// Session A session that can be used to perform authenticated requests to the API.
-type Session struct {
-	Id string `json:"id"`
-}
+// PermissionsGroupMember A user who is a member of a permissions group.
+type PermissionsGroupMember struct {
+	// Email The email address of the user.
+	Email *string `json:"email,omitempty"`
 
-// Table A table in a database.
-type Table struct {
-	// DbId The ID of the parent database.
-	DbId int `json:"db_id"`
+	// FirstName The first name of the user.
+	FirstName *string `json:"first_name"`
 
-	// Description A description for the table.
-	Description *string `json:"description"`
+	// IsGroupManager Whether the user is a manager of the group. This is only returned when advanced permissions are enabled.
+	IsGroupManager *bool `json:"is_group_manager,omitempty"`
 
-	// DisplayName The name displayed in the interface for the table.
-	DisplayName string `json:"display_name"`
+	// LastName The last name of the user.
+	LastName *string `json:"last_name"`
 
-	// EntityType The type of table.
-	EntityType string `json:"entity_type"`
+	// MembershipId The ID of the membership.
+	MembershipId *int `json:"membership_id,omitempty"`
 
-	// Id The ID of the table.
-	Id int `json:"id"`
+	// UserId The ID of the user.
+	UserId int `json:"user_id"`
+}
 
-	// Name The name of the table.
-	Name string `json:"name"`
+// PermissionsMembership The membership of a user in a permissions group.
+type PermissionsMembership struct {
+	// GroupId The ID of the permissions group.
+	GroupId int `json:"group_id"`
 
-	// Schema The database schema in which the table is located.
-	// For BigQuery, this is the dataset name.
-	Schema *string `json:"schema"`
+	// IsGroupManager Whether the user is a manager of the group.
+	IsGroupManager *bool `json:"is_group_manager,omitempty"`
+
+	// MembershipId The ID of the membership.
+	MembershipId int `json:"membership_id"`
+
+	// UserId The ID of the user.
+	UserId int `json:"user_id"`
 }
 
-// TableMetadata defines model for TableMetadata.
-type TableMetadata struct {
-	// DbId The ID of the parent database.
-	DbId int `json:"db_id"`
+// PublicLink The public link for a card or dashboard.
+type PublicLink struct {
+	// Uuid The UUID used in the public link.
+	Uuid string `json:"uuid"`
+}
 
-	// Description A description for the table.
-	Description *string `json:"description"`
+// Pulse A pulse, which sends the results of cards through channels (email, Slack) on a schedule.
+type Pulse struct {
+	// Archived Whether the pulse has been archived.
+	Archived bool `json:"archived"`
 
-	// DisplayName The name displayed in the interface for the table.
-	DisplayName string `json:"display_name"`
+	// Cards The cards sent by the pulse.
+	Cards []PulseCard `json:"cards"`
 
-	// EntityType The type of table.
-	EntityType string `json:"entity_type"`
+	// Channels The channels through which the pulse is sent.
+	Channels []PulseChannel `json:"channels"`
 
-	// Fields The list of fields in the table.
+	// CollectionId The ID of the collection in which the pulse is placed.
+	CollectionId *int `json:"collection_id"`
+
+	// DashboardId The ID of the dashboard, for dashboard subscriptions.
+	DashboardId *int `json:"dashboard_id"`
+
+	// Id The ID of the pulse.
+	Id int `json:"id"`
+
+	// Name The name of the pulse.
+	Name *string `json:"name"`
+
+	// Parameters The values of the dashboard parameters used when sending the pulse.
+	Parameters *[]PulseParameter `json:"parameters,omitempty"`
+
+	// SkipIfEmpty Whether the pulse is not sent when all cards have no results.
+	SkipIfEmpty *bool `json:"skip_if_empty,omitempty"`
+}
+
+// PulseCard A card sent by a pulse.
+type PulseCard struct {
+	// DashboardCardId The ID of the dashboard card, for dashboard subscriptions.
+	DashboardCardId *int `json:"dashboard_card_id"`
+
+	// Id The ID of the card.
+	Id int `json:"id"`
+
+	// IncludeCsv Whether the results of the card are attached as a CSV file.
+	IncludeCsv *bool `json:"include_csv,omitempty"`
+
+	// IncludeXls Whether the results of the card are attached as an XLSX file.
+	IncludeXls *bool `json:"include_xls,omitempty"`
+}
+
+// PulseChannel A channel through which a pulse is sent.
+type PulseChannel struct {
+	// ChannelType The type of channel.
+	ChannelType PulseChannelChannelType `json:"channel_type"`
+
+	// Details Channel-specific details, e.g. the Slack channel.
+	Details *map[string]interface{} `json:"details"`
+
+	// Enabled Whether the channel is enabled.
+	Enabled bool `json:"enabled"`
+
+	// Id The ID of the channel.
+	Id *int `json:"id,omitempty"`
+
+	// Recipients The recipients of emails.
+	Recipients *[]PulseChannelRecipient `json:"recipients,omitempty"`
+
+	// ScheduleDay The day of the week at which the pulse is sent (e.g. `mon`).
+	ScheduleDay *string `json:"schedule_day"`
+
+	// ScheduleFrame The time of the month at which the pulse is sent (`first`, `mid`, or `last`).
+	ScheduleFrame *string `json:"schedule_frame"`
+
+	// ScheduleHour The hour of the day at which the pulse is sent.
+	ScheduleHour *int `json:"schedule_hour"`
+
+	// ScheduleType How often the pulse is sent.
+	ScheduleType PulseChannelScheduleType `json:"schedule_type"`
+}
+
+// PulseChannelChannelType The type of channel.
+type PulseChannelChannelType string
+
+// PulseChannelScheduleType How often the pulse is sent.
+type PulseChannelScheduleType string
+
+// PulseChannelRecipient The recipient of an email pulse. Metabase users are referenced using their ID, other recipients using their email address.
+type PulseChannelRecipient struct {
+	// Email The email address of the recipient.
+	Email *string `json:"email,omitempty"`
+
+	// Id The ID of the Metabase user.
+	Id *int `json:"id,omitempty"`
+}
+
+// PulseParameter The value of a dashboard parameter in a pulse.
+type PulseParameter struct {
+	// Id The ID of the dashboard parameter.
+	Id string `json:"id"`
+
+	// Value The value of the parameter.
+	Value                interface{}            `json:"value,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// RevertRevisionBody The body of the payload when reverting an entity to a previous revision.
+type RevertRevisionBody struct {
+	// Entity The type of entity for which revisions are stored.
+	Entity RevisionEntity `json:"entity"`
+
+	// Id The ID of the entity.
+	Id int `json:"id"`
+
+	// RevisionId The ID of the revision to revert to.
+	RevisionId int `json:"revision_id"`
+}
+
+// Revision A revision of a card or dashboard.
+type Revision struct {
+	// Description A human-readable description of the changes.
+	Description *string `json:"description"`
+
+	// Id The ID of the revision.
+	Id int `json:"id"`
+
+	// IsCreation Whether the revision corresponds to the creation of the entity.
+	IsCreation bool `json:"is_creation"`
+
+	// IsReversion Whether the revision results from reverting to a previous revision.
+	IsReversion bool `json:"is_reversion"`
+
+	// Timestamp When the revision was created.
+	Timestamp string `json:"timestamp"`
+
+	// User The user who made the changes.
+	User *struct {
+		// CommonName The full name of the user.
+		CommonName *string `json:"common_name"`
+
+		// Id The ID of the user.
+		Id int `json:"id"`
+	} `json:"user"`
+}
+
+// RevisionEntity The type of entity for which revisions are stored.
+type RevisionEntity string
+
+// Sandbox A data sandbox, restricting the rows of a table a group can view based on user attributes.
+type Sandbox struct {
+	AttributeRemappings *SandboxAttributeRemappings `json:"attribute_remappings"`
+
+	// CardId The ID of the question used to filter the table, if any.
+	CardId *int `json:"card_id"`
+
+	// GroupId The ID of the sandboxed group.
+	GroupId int `json:"group_id"`
+
+	// Id The ID of the sandbox.
+	Id int `json:"id"`
+
+	// TableId The ID of the sandboxed table.
+	TableId int `json:"table_id"`
+}
+
+// SandboxAttributeRemappings A map where keys are user attributes and values are the targets they filter on. A target is either a field of the
+// table (`["dimension", ["field", 1, null]]`), or a template tag of the sandbox question (`["variable",
+// ["template-tag", "tag"]]` or `["dimension", ["template-tag", "tag"]]` for field filters).
+type SandboxAttributeRemappings map[string][]interface{}
+
+// Session A session that can be used to perform authenticated requests to the API.
+type Session struct {
+	Id string `json:"id"`
+}
+
+// Table A table in a database.
+type Table struct {
+	// DbId The ID of the parent database.
+	DbId int `json:"db_id"`
+
+	// Description A description for the table.
+	Description *string `json:"description"`
+
+	// DisplayName The name displayed in the interface for the table.
+	DisplayName string `json:"display_name"`
+
+	// EntityType The type of table.
+	EntityType string `json:"entity_type"`
+
+	// Id The ID of the table.
+	Id int `json:"id"`
+
+	// Name The name of the table.
+	Name string `json:"name"`
+
+	// Schema The database schema in which the table is located.
+	// For BigQuery, this is the dataset name.
+	Schema *string `json:"schema"`
+}
+
+// TableMetadata defines model for TableMetadata.
+type TableMetadata struct {
+	// DbId The ID of the parent database.
+	DbId int `json:"db_id"`
+
+	// Description A description for the table.
+	Description *string `json:"description"`
+
+	// DisplayName The name displayed in the interface for the table.
+	DisplayName string `json:"display_name"`
+
+	// EntityType The type of table.
+	EntityType string `json:"entity_type"`
+
+	// Fields The list of fields in the table.
 	Fields []Field `json:"fields"`
 
 	// Id The ID of the table.
@@ -586,10 +1269,130 @@ type TableMetadata struct {
 	Schema *string `json:"schema"`
 }
 
+// Timeline A timeline, grouping events displayed on time series charts.
+type Timeline struct {
+	// Archived Whether the timeline has been archived.
+	Archived bool `json:"archived"`
+
+	// CollectionId The ID of the collection in which the timeline is placed, or null for the root collection.
+	CollectionId *int `json:"collection_id"`
+
+	// Description A description for the timeline.
+	Description *string `json:"description"`
+
+	// Icon The icon of a timeline or event.
+	Icon *TimelineIcon `json:"icon,omitempty"`
+
+	// Id The ID of the timeline.
+	Id int `json:"id"`
+
+	// Name The name of the timeline.
+	Name string `json:"name"`
+}
+
+// TimelineEvent An event in a timeline.
+type TimelineEvent struct {
+	// Archived Whether the event has been archived.
+	Archived bool `json:"archived"`
+
+	// Description A description for the event.
+	Description *string `json:"description"`
+
+	// Icon The icon of a timeline or event.
+	Icon *TimelineIcon `json:"icon,omitempty"`
+
+	// Id The ID of the event.
+	Id int `json:"id"`
+
+	// Name The name of the event.
+	Name string `json:"name"`
+
+	// TimeMatters Whether the time of the event is displayed, or only its date.
+	TimeMatters *bool `json:"time_matters,omitempty"`
+
+	// TimelineId The ID of the timeline containing the event.
+	TimelineId int `json:"timeline_id"`
+
+	// Timestamp When the event happened.
+	Timestamp string `json:"timestamp"`
+
+	// Timezone The timezone in which the event is displayed.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// TimelineIcon The icon of a timeline or event.
+type TimelineIcon string
+
+// UpdateActionBody The payload when updating an existing action.
+type UpdateActionBody struct {
+	// DatabaseId For query actions, the ID of the database on which the query is run.
+	DatabaseId *int `json:"database_id,omitempty"`
+
+	// DatasetQuery For query actions, the query which is run.
+	DatasetQuery *map[string]interface{} `json:"dataset_query,omitempty"`
+
+	// Description A description for the action.
+	Description *string `json:"description"`
+
+	// Kind For implicit actions, the kind of action, e.g. `row/create`.
+	Kind *string `json:"kind,omitempty"`
+
+	// ModelId The ID of the model (card) on which the action is defined.
+	ModelId *int `json:"model_id,omitempty"`
+
+	// Name The name of the action.
+	Name *string `json:"name,omitempty"`
+
+	// Parameters The parameters of the action.
+	Parameters *[]ActionParameter `json:"parameters,omitempty"`
+
+	// Type The type of action.
+	Type *ActionType `json:"type,omitempty"`
+
+	// VisualizationSettings The settings of the action form.
+	VisualizationSettings *map[string]interface{} `json:"visualization_settings,omitempty"`
+}
+
+// UpdateAlertBody The payload used to update an existing alert.
+type UpdateAlertBody struct {
+	// AlertAboveGoal For goal alerts, whether the alert is triggered when the result goes above (rather than below) the goal.
+	AlertAboveGoal *bool `json:"alert_above_goal"`
+
+	// AlertCondition The condition triggering the alert.
+	AlertCondition *UpdateAlertBodyAlertCondition `json:"alert_condition,omitempty"`
+
+	// AlertFirstOnly Whether the alert is deleted after being sent once.
+	AlertFirstOnly *bool `json:"alert_first_only,omitempty"`
+
+	// Archived Set to `true` to archive the alert.
+	Archived *bool `json:"archived,omitempty"`
+
+	// Card The card on which an alert is defined.
+	Card *AlertCard `json:"card,omitempty"`
+
+	// Channels The channels through which the alert is sent.
+	Channels *[]PulseChannel `json:"channels,omitempty"`
+}
+
+// UpdateAlertBodyAlertCondition The condition triggering the alert.
+type UpdateAlertBodyAlertCondition string
+
+// UpdateCacheConfigResult The result of updating a cache configuration.
+type UpdateCacheConfigResult struct {
+	// Id The ID of the cache configuration.
+	Id int `json:"id"`
+}
+
 // UpdateCardBody The payload when updating an existing card.
 type UpdateCardBody struct {
 	// Archived Set to `true` to archive the card.
-	Archived             *bool                  `json:"archived,omitempty"`
+	Archived *bool `json:"archived,omitempty"`
+
+	// EmbeddingParams The embedding mode for each parameter, keyed by parameter slug. Values are `locked`, `enabled`, or `disabled`.
+	EmbeddingParams *EmbeddingParams `json:"embedding_params,omitempty"`
+
+	// EnableEmbedding Whether signed embedding is enabled.
+	EnableEmbedding      *bool                  `json:"enable_embedding,omitempty"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
@@ -629,6 +1432,12 @@ type UpdateDashboardBody struct {
 	// Description A description for the dashboard.
 	Description *string `json:"description"`
 
+	// EmbeddingParams The embedding mode for each parameter, keyed by parameter slug. Values are `locked`, `enabled`, or `disabled`.
+	EmbeddingParams *EmbeddingParams `json:"embedding_params,omitempty"`
+
+	// EnableEmbedding Whether signed embedding is enabled.
+	EnableEmbedding *bool `json:"enable_embedding,omitempty"`
+
 	// Name The name of the dashboard.
 	Name *string `json:"name,omitempty"`
 
@@ -669,6 +1478,44 @@ type UpdatePermissionsGroupBody struct {
 	Name string `json:"name"`
 }
 
+// UpdatePermissionsMembershipBody The payload used to update a permissions group membership.
+type UpdatePermissionsMembershipBody struct {
+	// IsGroupManager Whether the user is a manager of the group. This requires advanced permissions.
+	IsGroupManager bool `json:"is_group_manager"`
+}
+
+// UpdatePulseBody The payload used to update an existing pulse.
+type UpdatePulseBody struct {
+	// Archived Set to `true` to archive the pulse.
+	Archived *bool `json:"archived,omitempty"`
+
+	// Cards The cards sent by the pulse.
+	Cards *[]PulseCard `json:"cards,omitempty"`
+
+	// Channels The channels through which the pulse is sent.
+	Channels *[]PulseChannel `json:"channels,omitempty"`
+
+	// Name The name of the pulse.
+	Name *string `json:"name,omitempty"`
+
+	// Parameters The values of the dashboard parameters used when sending the pulse.
+	Parameters *[]PulseParameter `json:"parameters,omitempty"`
+
+	// SkipIfEmpty Whether the pulse is not sent when all cards have no results.
+	SkipIfEmpty *bool `json:"skip_if_empty,omitempty"`
+}
+
+// UpdateSandboxBody The payload when updating a sandbox.
+type UpdateSandboxBody struct {
+	// AttributeRemappings A map where keys are user attributes and values are the targets they filter on. A target is either a field of the
+	// table (`["dimension", ["field", 1, null]]`), or a template tag of the sandbox question (`["variable",
+	// ["template-tag", "tag"]]` or `["dimension", ["template-tag", "tag"]]` for field filters).
+	AttributeRemappings *SandboxAttributeRemappings `json:"attribute_remappings,omitempty"`
+
+	// CardId The ID of the question used to filter the table, if any.
+	CardId *int `json:"card_id"`
+}
+
 // UpdateTableBody The payload used to update a table.
 type UpdateTableBody struct {
 	// Description A description for the table.
@@ -681,6 +1528,51 @@ type UpdateTableBody struct {
 	EntityType *string `json:"entity_type,omitempty"`
 }
 
+// UpdateTimelineBody The payload when updating an existing timeline.
+type UpdateTimelineBody struct {
+	// Archived Set to `true` to archive the timeline.
+	Archived *bool `json:"archived,omitempty"`
+
+	// CollectionId The ID of the collection in which the timeline is placed, or null for the root collection.
+	CollectionId *int `json:"collection_id"`
+
+	// Description A description for the timeline.
+	Description *string `json:"description"`
+
+	// Icon The icon of a timeline or event.
+	Icon *TimelineIcon `json:"icon,omitempty"`
+
+	// Name The name of the timeline.
+	Name *string `json:"name,omitempty"`
+}
+
+// UpdateTimelineEventBody The payload when updating an existing timeline event.
+type UpdateTimelineEventBody struct {
+	// Archived Set to `true` to archive the event.
+	Archived *bool `json:"archived,omitempty"`
+
+	// Description A description for the event.
+	Description *string `json:"description"`
+
+	// Icon The icon of a timeline or event.
+	Icon *TimelineIcon `json:"icon,omitempty"`
+
+	// Name The name of the event.
+	Name *string `json:"name,omitempty"`
+
+	// TimeMatters Whether the time of the event is displayed, or only its date.
+	TimeMatters *bool `json:"time_matters,omitempty"`
+
+	// TimelineId The ID of the timeline containing the event.
+	TimelineId *int `json:"timeline_id,omitempty"`
+
+	// Timestamp When the event happened.
+	Timestamp *string `json:"timestamp,omitempty"`
+
+	// Timezone The timezone in which the event is displayed.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
 // UpdateUserBody The payload when updating an existing user.
 type UpdateUserBody struct {
 	// Email The email address of the user.
@@ -694,6 +1586,12 @@ type UpdateUserBody struct {
 
 	// LastName The last name of the user.
 	LastName *string `json:"last_name,omitempty"`
+
+	// Locale The locale of the user, e.g. `fr`.
+	Locale *string `json:"locale,omitempty"`
+
+	// LoginAttributes Attributes of the user, e.g. used in data sandboxes.
+	LoginAttributes *map[string]string `json:"login_attributes,omitempty"`
 }
 
 // User A Metabase user.
@@ -718,6 +1616,33 @@ type User struct {
 
 	// LastName The last name of the user.
 	LastName string `json:"last_name"`
+
+	// Locale The locale of the user, e.g. `fr`. If null, the locale of the instance is used.
+	Locale *string `json:"locale"`
+
+	// LoginAttributes Attributes of the user, e.g. used in data sandboxes.
+	LoginAttributes *map[string]interface{} `json:"login_attributes"`
+
+	// SsoSource The single sign-on provider through which the user was provisioned, e.g. `google` or `ldap`.
+	SsoSource *string `json:"sso_source"`
+}
+
+// UserList A list of users.
+type UserList struct {
+	// Data The users.
+	Data []User `json:"data"`
+
+	// Total The total number of users matching the filters.
+	Total *int `json:"total,omitempty"`
+}
+
+// ListCacheConfigsParams defines parameters for ListCacheConfigs.
+type ListCacheConfigsParams struct {
+	// Model The types of models for which configurations are returned.
+	Model []CacheConfigModel `form:"model" json:"model"`
+
+	// Id The ID of the model for which the configuration is returned.
+	Id *int `form:"id,omitempty" json:"id,omitempty"`
 }
 
 // ListCollectionsParams defines parameters for ListCollections.
@@ -734,6 +1659,9 @@ type ListCollectionItemsParams struct {
 	// Archived Whether archived items should be returned instead of active ones.
 	Archived *bool `form:"archived,omitempty" json:"archived,omitempty"`
 
+	// PinnedState Whether only pinned (or unpinned) items should be returned.
+	PinnedState *ListCollectionItemsParamsPinnedState `form:"pinned_state,omitempty" json:"pinned_state,omitempty"`
+
 	// Limit The maximum number of items to return.
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
@@ -741,6 +1669,9 @@ type ListCollectionItemsParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// ListCollectionItemsParamsPinnedState defines parameters for ListCollectionItems.
+type ListCollectionItemsParamsPinnedState string
+
 // ListDatabasesParams defines parameters for ListDatabases.
 type ListDatabasesParams struct {
 	// Include Whether the returned databases should include the list of tables for each database.
@@ -753,18 +1684,69 @@ type ListDatabasesParams struct {
 // ListDatabasesParamsInclude defines parameters for ListDatabases.
 type ListDatabasesParamsInclude string
 
+// ListImpersonationsParams defines parameters for ListImpersonations.
+type ListImpersonationsParams struct {
+	// GroupId Only returns the impersonations of this group.
+	GroupId *int `form:"group_id,omitempty" json:"group_id,omitempty"`
+
+	// DbId Only returns the impersonations on this database.
+	DbId *int `form:"db_id,omitempty" json:"db_id,omitempty"`
+}
+
 // UploadContentTranslationDictionaryMultipartBody defines parameters for UploadContentTranslationDictionary.
 type UploadContentTranslationDictionaryMultipartBody struct {
 	// File CSV file containing translations
 	File openapi_types.File `json:"file"`
 }
 
+// ListRevisionsParams defines parameters for ListRevisions.
+type ListRevisionsParams struct {
+	// Entity The type of entity.
+	Entity RevisionEntity `form:"entity" json:"entity"`
+
+	// Id The ID of the entity.
+	Id int `form:"id" json:"id"`
+}
+
 // GetTableMetadataParams defines parameters for GetTableMetadata.
 type GetTableMetadataParams struct {
 	// IncludeHiddenFields Whether the query should return hidden fields.
 	IncludeHiddenFields *bool `form:"include_hidden_fields,omitempty" json:"include_hidden_fields,omitempty"`
 }
 
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	// Status Whether active users, deactivated users, or all users should be returned.
+	Status *ListUsersParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Query A search string matched against the names and email addresses of the users.
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// GroupId Only returns the members of the given permissions group.
+	GroupId *int `form:"group_id,omitempty" json:"group_id,omitempty"`
+}
+
+// ListUsersParamsStatus defines parameters for ListUsers.
+type ListUsersParamsStatus string
+
+// CreateActionJSONRequestBody defines body for CreateAction for application/json ContentType.
+type CreateActionJSONRequestBody = CreateActionBody
+
+// UpdateActionJSONRequestBody defines body for UpdateAction for application/json ContentType.
+type UpdateActionJSONRequestBody = UpdateActionBody
+
+// CreateAlertJSONRequestBody defines body for CreateAlert for application/json ContentType.
+type CreateAlertJSONRequestBody = CreateAlertBody
+
+// UpdateAlertJSONRequestBody defines body for UpdateAlert for application/json ContentType.
+type UpdateAlertJSONRequestBody = UpdateAlertBody
+
+// DeleteCacheConfigJSONRequestBody defines body for DeleteCacheConfig for application/json ContentType.
+type DeleteCacheConfigJSONRequestBody = DeleteCacheConfigBody
+
+// UpdateCacheConfigJSONRequestBody defines body for UpdateCacheConfig for application/json ContentType.
+type UpdateCacheConfigJSONRequestBody = CacheConfig
+
 // CreateCardJSONRequestBody defines body for CreateCard for application/json ContentType.
 type CreateCardJSONRequestBody = CreateCardBody
 
@@ -786,6 +1768,9 @@ type CreateDashboardJSONRequestBody = CreateDashboardBody
 // UpdateDashboardJSONRequestBody defines body for UpdateDashboard for application/json ContentType.
 type UpdateDashboardJSONRequestBody = UpdateDashboardBody
 
+// CopyDashboardJSONRequestBody defines body for CopyDashboard for application/json ContentType.
+type CopyDashboardJSONRequestBody = CopyDashboardBody
+
 // CreateDatabaseJSONRequestBody defines body for CreateDatabase for application/json ContentType.
 type CreateDatabaseJSONRequestBody = CreateDatabaseBody
 
@@ -798,6 +1783,12 @@ type UploadContentTranslationDictionaryMultipartRequestBody UploadContentTransla
 // UpdateFieldJSONRequestBody defines body for UpdateField for application/json ContentType.
 type UpdateFieldJSONRequestBody = UpdateFieldBody
 
+// CreateSandboxJSONRequestBody defines body for CreateSandbox for application/json ContentType.
+type CreateSandboxJSONRequestBody = CreateSandboxBody
+
+// UpdateSandboxJSONRequestBody defines body for UpdateSandbox for application/json ContentType.
+type UpdateSandboxJSONRequestBody = UpdateSandboxBody
+
 // ReplacePermissionsGraphJSONRequestBody defines body for ReplacePermissionsGraph for application/json ContentType.
 type ReplacePermissionsGraphJSONRequestBody = PermissionsGraph
 
@@ -807,51 +1798,236 @@ type CreatePermissionsGroupJSONRequestBody = CreatePermissionsGroupBody
 // UpdatePermissionsGroupJSONRequestBody defines body for UpdatePermissionsGroup for application/json ContentType.
 type UpdatePermissionsGroupJSONRequestBody = UpdatePermissionsGroupBody
 
+// CreatePermissionsMembershipJSONRequestBody defines body for CreatePermissionsMembership for application/json ContentType.
+type CreatePermissionsMembershipJSONRequestBody = CreatePermissionsMembershipBody
+
+// UpdatePermissionsMembershipJSONRequestBody defines body for UpdatePermissionsMembership for application/json ContentType.
+type UpdatePermissionsMembershipJSONRequestBody = UpdatePermissionsMembershipBody
+
+// CreatePulseJSONRequestBody defines body for CreatePulse for application/json ContentType.
+type CreatePulseJSONRequestBody = CreatePulseBody
+
+// UpdatePulseJSONRequestBody defines body for UpdatePulse for application/json ContentType.
+type UpdatePulseJSONRequestBody = UpdatePulseBody
+
+// RevertRevisionJSONRequestBody defines body for RevertRevision for application/json ContentType.
+type RevertRevisionJSONRequestBody = RevertRevisionBody
+
 // CreateSessionJSONRequestBody defines body for CreateSession for application/json ContentType.
 type CreateSessionJSONRequestBody = CreateSessionBody
 
+// ForgotPasswordJSONRequestBody defines body for ForgotPassword for application/json ContentType.
+type ForgotPasswordJSONRequestBody = ForgotPasswordBody
+
 // UpdateTableJSONRequestBody defines body for UpdateTable for application/json ContentType.
 type UpdateTableJSONRequestBody = UpdateTableBody
 
+// CreateTimelineJSONRequestBody defines body for CreateTimeline for application/json ContentType.
+type CreateTimelineJSONRequestBody = CreateTimelineBody
+
+// CreateTimelineEventJSONRequestBody defines body for CreateTimelineEvent for application/json ContentType.
+type CreateTimelineEventJSONRequestBody = CreateTimelineEventBody
+
+// UpdateTimelineEventJSONRequestBody defines body for UpdateTimelineEvent for application/json ContentType.
+type UpdateTimelineEventJSONRequestBody = UpdateTimelineEventBody
+
+// UpdateTimelineJSONRequestBody defines body for UpdateTimeline for application/json ContentType.
+type UpdateTimelineJSONRequestBody = UpdateTimelineBody
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = CreateUserBody
 
 // UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
 type UpdateUserJSONRequestBody = UpdateUserBody
 
-// Getter for additional properties for Card. Returns the specified
+// Getter for additional properties for ActionParameter. Returns the specified
 // element and whether it was found
-func (a Card) Get(fieldName string) (value interface{}, found bool) {
+func (a ActionParameter) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for Card
-func (a *Card) Set(fieldName string, value interface{}) {
+// Setter for additional properties for ActionParameter
+func (a *ActionParameter) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for Card to handle AdditionalProperties
-func (a *Card) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ActionParameter to handle AdditionalProperties
+func (a *ActionParameter) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["archived"]; found {
-		err = json.Unmarshal(raw, &a.Archived)
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
 		if err != nil {
-			return fmt.Errorf("error reading 'archived': %w", err)
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+		delete(object, "id")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["required"]; found {
+		err = json.Unmarshal(raw, &a.Required)
+		if err != nil {
+			return fmt.Errorf("error reading 'required': %w", err)
+		}
+		delete(object, "required")
+	}
+
+	if raw, found := object["slug"]; found {
+		err = json.Unmarshal(raw, &a.Slug)
+		if err != nil {
+			return fmt.Errorf("error reading 'slug': %w", err)
+		}
+		delete(object, "slug")
+	}
+
+	if raw, found := object["target"]; found {
+		err = json.Unmarshal(raw, &a.Target)
+		if err != nil {
+			return fmt.Errorf("error reading 'target': %w", err)
+		}
+		delete(object, "target")
+	}
+
+	if raw, found := object["type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading 'type': %w", err)
+		}
+		delete(object, "type")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ActionParameter to handle AdditionalProperties
+func (a ActionParameter) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["id"], err = json.Marshal(a.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+
+	if a.Name != nil {
+		object["name"], err = json.Marshal(a.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'name': %w", err)
+		}
+	}
+
+	if a.Required != nil {
+		object["required"], err = json.Marshal(a.Required)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'required': %w", err)
+		}
+	}
+
+	if a.Slug != nil {
+		object["slug"], err = json.Marshal(a.Slug)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'slug': %w", err)
+		}
+	}
+
+	if a.Target != nil {
+		object["target"], err = json.Marshal(a.Target)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'target': %w", err)
+		}
+	}
+
+	object["type"], err = json.Marshal(a.Type)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'type': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for Card. Returns the specified
+// element and whether it was found
+func (a Card) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Card
+func (a *Card) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Card to handle AdditionalProperties
+func (a *Card) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["archived"]; found {
+		err = json.Unmarshal(raw, &a.Archived)
+		if err != nil {
+			return fmt.Errorf("error reading 'archived': %w", err)
 		}
 		delete(object, "archived")
 	}
 
+	if raw, found := object["embedding_params"]; found {
+		err = json.Unmarshal(raw, &a.EmbeddingParams)
+		if err != nil {
+			return fmt.Errorf("error reading 'embedding_params': %w", err)
+		}
+		delete(object, "embedding_params")
+	}
+
+	if raw, found := object["enable_embedding"]; found {
+		err = json.Unmarshal(raw, &a.EnableEmbedding)
+		if err != nil {
+			return fmt.Errorf("error reading 'enable_embedding': %w", err)
+		}
+		delete(object, "enable_embedding")
+	}
+
 	if raw, found := object["id"]; found {
 		err = json.Unmarshal(raw, &a.Id)
 		if err != nil {
@@ -868,6 +2044,14 @@ func (a *Card) UnmarshalJSON(b []byte) error {
 		delete(object, "name")
 	}
 
+	if raw, found := object["public_uuid"]; found {
+		err = json.Unmarshal(raw, &a.PublicUuid)
+		if err != nil {
+			return fmt.Errorf("error reading 'public_uuid': %w", err)
+		}
+		delete(object, "public_uuid")
+	}
+
 	if len(object) != 0 {
 		a.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
@@ -892,6 +2076,20 @@ func (a Card) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'archived': %w", err)
 	}
 
+	if a.EmbeddingParams != nil {
+		object["embedding_params"], err = json.Marshal(a.EmbeddingParams)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'embedding_params': %w", err)
+		}
+	}
+
+	if a.EnableEmbedding != nil {
+		object["enable_embedding"], err = json.Marshal(a.EnableEmbedding)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'enable_embedding': %w", err)
+		}
+	}
+
 	object["id"], err = json.Marshal(a.Id)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'id': %w", err)
@@ -902,6 +2100,13 @@ func (a Card) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
+	if a.PublicUuid != nil {
+		object["public_uuid"], err = json.Marshal(a.PublicUuid)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'public_uuid': %w", err)
+		}
+	}
+
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -1074,6 +2279,85 @@ func (a DashboardParameter) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
+// Getter for additional properties for PulseParameter. Returns the specified
+// element and whether it was found
+func (a PulseParameter) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for PulseParameter
+func (a *PulseParameter) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for PulseParameter to handle AdditionalProperties
+func (a *PulseParameter) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+		delete(object, "id")
+	}
+
+	if raw, found := object["value"]; found {
+		err = json.Unmarshal(raw, &a.Value)
+		if err != nil {
+			return fmt.Errorf("error reading 'value': %w", err)
+		}
+		delete(object, "value")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for PulseParameter to handle AdditionalProperties
+func (a PulseParameter) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["id"], err = json.Marshal(a.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+
+	object["value"], err = json.Marshal(a.Value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'value': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // Getter for additional properties for UpdateCardBody. Returns the specified
 // element and whether it was found
 func (a UpdateCardBody) Get(fieldName string) (value interface{}, found bool) {
@@ -1107,6 +2391,22 @@ func (a *UpdateCardBody) UnmarshalJSON(b []byte) error {
 		delete(object, "archived")
 	}
 
+	if raw, found := object["embedding_params"]; found {
+		err = json.Unmarshal(raw, &a.EmbeddingParams)
+		if err != nil {
+			return fmt.Errorf("error reading 'embedding_params': %w", err)
+		}
+		delete(object, "embedding_params")
+	}
+
+	if raw, found := object["enable_embedding"]; found {
+		err = json.Unmarshal(raw, &a.EnableEmbedding)
+		if err != nil {
+			return fmt.Errorf("error reading 'enable_embedding': %w", err)
+		}
+		delete(object, "enable_embedding")
+	}
+
 	if len(object) != 0 {
 		a.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
@@ -1133,6 +2433,20 @@ func (a UpdateCardBody) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if a.EmbeddingParams != nil {
+		object["embedding_params"], err = json.Marshal(a.EmbeddingParams)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'embedding_params': %w", err)
+		}
+	}
+
+	if a.EnableEmbedding != nil {
+		object["enable_embedding"], err = json.Marshal(a.EnableEmbedding)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'enable_embedding': %w", err)
+		}
+	}
+
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -1354,6 +2668,32 @@ func (t *PermissionsGraphDatabaseAccess_Schemas) MergePermissionsGraphDatabaseAc
 	return err
 }
 
+// AsPermissionsGraphDatabaseAccessSchemas1 returns the union data inside the PermissionsGraphDatabaseAccess_Schemas as a PermissionsGraphDatabaseAccessSchemas1
+func (t PermissionsGraphDatabaseAccess_Schemas) AsPermissionsGraphDatabaseAccessSchemas1() (PermissionsGraphDatabaseAccessSchemas1, error) {
+	var body PermissionsGraphDatabaseAccessSchemas1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPermissionsGraphDatabaseAccessSchemas1 overwrites any union data inside the PermissionsGraphDatabaseAccess_Schemas as the provided PermissionsGraphDatabaseAccessSchemas1
+func (t *PermissionsGraphDatabaseAccess_Schemas) FromPermissionsGraphDatabaseAccessSchemas1(v PermissionsGraphDatabaseAccessSchemas1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePermissionsGraphDatabaseAccessSchemas1 performs a merge with any union data inside the PermissionsGraphDatabaseAccess_Schemas, using the provided PermissionsGraphDatabaseAccessSchemas1
+func (t *PermissionsGraphDatabaseAccess_Schemas) MergePermissionsGraphDatabaseAccessSchemas1(v PermissionsGraphDatabaseAccessSchemas1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t PermissionsGraphDatabaseAccess_Schemas) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1364,22 +2704,22 @@ func (t *PermissionsGraphDatabaseAccess_Schemas) UnmarshalJSON(b []byte) error {
 	return err
 }
 
-// AsPermissionsGraphDatabasePermissionsViewData0 returns the union data inside the PermissionsGraphDatabasePermissions_ViewData as a PermissionsGraphDatabasePermissionsViewData0
-func (t PermissionsGraphDatabasePermissions_ViewData) AsPermissionsGraphDatabasePermissionsViewData0() (PermissionsGraphDatabasePermissionsViewData0, error) {
-	var body PermissionsGraphDatabasePermissionsViewData0
+// AsPermissionsGraphDatabasePermissionsCreateQueries returns the union data inside the PermissionsGraphDatabasePermissions_CreateQueries as a PermissionsGraphDatabasePermissionsCreateQueries
+func (t PermissionsGraphDatabasePermissions_CreateQueries) AsPermissionsGraphDatabasePermissionsCreateQueries() (PermissionsGraphDatabasePermissionsCreateQueries, error) {
+	var body PermissionsGraphDatabasePermissionsCreateQueries
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromPermissionsGraphDatabasePermissionsViewData0 overwrites any union data inside the PermissionsGraphDatabasePermissions_ViewData as the provided PermissionsGraphDatabasePermissionsViewData0
-func (t *PermissionsGraphDatabasePermissions_ViewData) FromPermissionsGraphDatabasePermissionsViewData0(v PermissionsGraphDatabasePermissionsViewData0) error {
+// FromPermissionsGraphDatabasePermissionsCreateQueries overwrites any union data inside the PermissionsGraphDatabasePermissions_CreateQueries as the provided PermissionsGraphDatabasePermissionsCreateQueries
+func (t *PermissionsGraphDatabasePermissions_CreateQueries) FromPermissionsGraphDatabasePermissionsCreateQueries(v PermissionsGraphDatabasePermissionsCreateQueries) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergePermissionsGraphDatabasePermissionsViewData0 performs a merge with any union data inside the PermissionsGraphDatabasePermissions_ViewData, using the provided PermissionsGraphDatabasePermissionsViewData0
-func (t *PermissionsGraphDatabasePermissions_ViewData) MergePermissionsGraphDatabasePermissionsViewData0(v PermissionsGraphDatabasePermissionsViewData0) error {
+// MergePermissionsGraphDatabasePermissionsCreateQueries performs a merge with any union data inside the PermissionsGraphDatabasePermissions_CreateQueries, using the provided PermissionsGraphDatabasePermissionsCreateQueries
+func (t *PermissionsGraphDatabasePermissions_CreateQueries) MergePermissionsGraphDatabasePermissionsCreateQueries(v PermissionsGraphDatabasePermissionsCreateQueries) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1390,7 +2730,69 @@ func (t *PermissionsGraphDatabasePermissions_ViewData) MergePermissionsGraphData
 	return err
 }
 
-// AsPermissionsGraphDatabasePermissionsViewData1 returns the union data inside the PermissionsGraphDatabasePermissions_ViewData as a PermissionsGraphDatabasePermissionsViewData1
+// AsPermissionsGraphDatabasePermissionsCreateQueries1 returns the union data inside the PermissionsGraphDatabasePermissions_CreateQueries as a PermissionsGraphDatabasePermissionsCreateQueries1
+func (t PermissionsGraphDatabasePermissions_CreateQueries) AsPermissionsGraphDatabasePermissionsCreateQueries1() (PermissionsGraphDatabasePermissionsCreateQueries1, error) {
+	var body PermissionsGraphDatabasePermissionsCreateQueries1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPermissionsGraphDatabasePermissionsCreateQueries1 overwrites any union data inside the PermissionsGraphDatabasePermissions_CreateQueries as the provided PermissionsGraphDatabasePermissionsCreateQueries1
+func (t *PermissionsGraphDatabasePermissions_CreateQueries) FromPermissionsGraphDatabasePermissionsCreateQueries1(v PermissionsGraphDatabasePermissionsCreateQueries1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePermissionsGraphDatabasePermissionsCreateQueries1 performs a merge with any union data inside the PermissionsGraphDatabasePermissions_CreateQueries, using the provided PermissionsGraphDatabasePermissionsCreateQueries1
+func (t *PermissionsGraphDatabasePermissions_CreateQueries) MergePermissionsGraphDatabasePermissionsCreateQueries1(v PermissionsGraphDatabasePermissionsCreateQueries1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t PermissionsGraphDatabasePermissions_CreateQueries) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *PermissionsGraphDatabasePermissions_CreateQueries) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsPermissionsGraphDatabasePermissionsViewData0 returns the union data inside the PermissionsGraphDatabasePermissions_ViewData as a PermissionsGraphDatabasePermissionsViewData0
+func (t PermissionsGraphDatabasePermissions_ViewData) AsPermissionsGraphDatabasePermissionsViewData0() (PermissionsGraphDatabasePermissionsViewData0, error) {
+	var body PermissionsGraphDatabasePermissionsViewData0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPermissionsGraphDatabasePermissionsViewData0 overwrites any union data inside the PermissionsGraphDatabasePermissions_ViewData as the provided PermissionsGraphDatabasePermissionsViewData0
+func (t *PermissionsGraphDatabasePermissions_ViewData) FromPermissionsGraphDatabasePermissionsViewData0(v PermissionsGraphDatabasePermissionsViewData0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePermissionsGraphDatabasePermissionsViewData0 performs a merge with any union data inside the PermissionsGraphDatabasePermissions_ViewData, using the provided PermissionsGraphDatabasePermissionsViewData0
+func (t *PermissionsGraphDatabasePermissions_ViewData) MergePermissionsGraphDatabasePermissionsViewData0(v PermissionsGraphDatabasePermissionsViewData0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsPermissionsGraphDatabasePermissionsViewData1 returns the union data inside the PermissionsGraphDatabasePermissions_ViewData as a PermissionsGraphDatabasePermissionsViewData1
 func (t PermissionsGraphDatabasePermissions_ViewData) AsPermissionsGraphDatabasePermissionsViewData1() (PermissionsGraphDatabasePermissionsViewData1, error) {
 	var body PermissionsGraphDatabasePermissionsViewData1
 	err := json.Unmarshal(t.union, &body)
@@ -1499,6 +2901,48 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// CreateActionWithBody request with any body
+	CreateActionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAction(ctx context.Context, body CreateActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAction request
+	DeleteAction(ctx context.Context, actionId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAction request
+	GetAction(ctx context.Context, actionId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateActionWithBody request with any body
+	UpdateActionWithBody(ctx context.Context, actionId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAction(ctx context.Context, actionId int, body UpdateActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAlertWithBody request with any body
+	CreateAlertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAlert(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAlert request
+	GetAlert(ctx context.Context, alertId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAlertWithBody request with any body
+	UpdateAlertWithBody(ctx context.Context, alertId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAlert(ctx context.Context, alertId int, body UpdateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCacheConfigWithBody request with any body
+	DeleteCacheConfigWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteCacheConfig(ctx context.Context, body DeleteCacheConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCacheConfigs request
+	ListCacheConfigs(ctx context.Context, params *ListCacheConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCacheConfigWithBody request with any body
+	UpdateCacheConfigWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCacheConfig(ctx context.Context, body UpdateCacheConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateCardWithBody request with any body
 	CreateCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1512,6 +2956,12 @@ type ClientInterface interface {
 
 	UpdateCard(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteCardPublicLink request
+	DeleteCardPublicLink(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCardPublicLink request
+	CreateCardPublicLink(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListCollections request
 	ListCollections(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1555,6 +3005,17 @@ type ClientInterface interface {
 
 	UpdateDashboard(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CopyDashboardWithBody request with any body
+	CopyDashboardWithBody(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CopyDashboard(ctx context.Context, dashboardId int, body CopyDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDashboardPublicLink request
+	DeleteDashboardPublicLink(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDashboardPublicLink request
+	CreateDashboardPublicLink(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListDatabases request
 	ListDatabases(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1574,6 +3035,12 @@ type ClientInterface interface {
 
 	UpdateDatabase(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListImpersonations request
+	ListImpersonations(ctx context.Context, params *ListImpersonationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteImpersonation request
+	DeleteImpersonation(ctx context.Context, impersonationId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetContentTranslationCsv request
 	GetContentTranslationCsv(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1591,6 +3058,22 @@ type ClientInterface interface {
 
 	UpdateField(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateSandboxWithBody request with any body
+	CreateSandboxWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSandbox(ctx context.Context, body CreateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSandbox request
+	DeleteSandbox(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSandbox request
+	GetSandbox(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSandboxWithBody request with any body
+	UpdateSandboxWithBody(ctx context.Context, sandboxId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSandbox(ctx context.Context, sandboxId int, body UpdateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetPermissionsGraph request
 	GetPermissionsGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1599,6 +3082,9 @@ type ClientInterface interface {
 
 	ReplacePermissionsGraph(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListPermissionsGroups request
+	ListPermissionsGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreatePermissionsGroupWithBody request with any body
 	CreatePermissionsGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1615,11 +3101,50 @@ type ClientInterface interface {
 
 	UpdatePermissionsGroup(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreatePermissionsMembershipWithBody request with any body
+	CreatePermissionsMembershipWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePermissionsMembership(ctx context.Context, body CreatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePermissionsMembership request
+	DeletePermissionsMembership(ctx context.Context, membershipId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePermissionsMembershipWithBody request with any body
+	UpdatePermissionsMembershipWithBody(ctx context.Context, membershipId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePermissionsMembership(ctx context.Context, membershipId int, body UpdatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePulseWithBody request with any body
+	CreatePulseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePulse(ctx context.Context, body CreatePulseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPulse request
+	GetPulse(ctx context.Context, pulseId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePulseWithBody request with any body
+	UpdatePulseWithBody(ctx context.Context, pulseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePulse(ctx context.Context, pulseId int, body UpdatePulseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRevisions request
+	ListRevisions(ctx context.Context, params *ListRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevertRevisionWithBody request with any body
+	RevertRevisionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RevertRevision(ctx context.Context, body RevertRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateSessionWithBody request with any body
 	CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ForgotPasswordWithBody request with any body
+	ForgotPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ForgotPassword(ctx context.Context, body ForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListTables request
 	ListTables(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1631,8 +3156,34 @@ type ClientInterface interface {
 	// GetTableMetadata request
 	GetTableMetadata(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateTimelineWithBody request with any body
+	CreateTimelineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTimeline(ctx context.Context, body CreateTimelineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTimelineEventWithBody request with any body
+	CreateTimelineEventWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTimelineEvent(ctx context.Context, body CreateTimelineEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTimelineEvent request
+	GetTimelineEvent(ctx context.Context, timelineEventId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTimelineEventWithBody request with any body
+	UpdateTimelineEventWithBody(ctx context.Context, timelineEventId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTimelineEvent(ctx context.Context, timelineEventId int, body UpdateTimelineEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTimeline request
+	GetTimeline(ctx context.Context, timelineId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTimelineWithBody request with any body
+	UpdateTimelineWithBody(ctx context.Context, timelineId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTimeline(ctx context.Context, timelineId int, body UpdateTimelineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListUsers request
-	ListUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListUsers(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateUserWithBody request with any body
 	CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -1649,10 +3200,16 @@ type ClientInterface interface {
 	UpdateUserWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateUser(ctx context.Context, userId int, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReactivateUser request
+	ReactivateUser(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SendUserInvite request
+	SendUserInvite(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) CreateCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCardRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateActionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateActionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1663,8 +3220,8 @@ func (c *Client) CreateCardWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCard(ctx context.Context, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCardRequest(c.Server, body)
+func (c *Client) CreateAction(ctx context.Context, body CreateActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateActionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1675,8 +3232,8 @@ func (c *Client) CreateCard(ctx context.Context, body CreateCardJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCard(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCardRequest(c.Server, cardId)
+func (c *Client) DeleteAction(ctx context.Context, actionId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteActionRequest(c.Server, actionId)
 	if err != nil {
 		return nil, err
 	}
@@ -1687,8 +3244,8 @@ func (c *Client) GetCard(ctx context.Context, cardId int, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCardWithBody(ctx context.Context, cardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCardRequestWithBody(c.Server, cardId, contentType, body)
+func (c *Client) GetAction(ctx context.Context, actionId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetActionRequest(c.Server, actionId)
 	if err != nil {
 		return nil, err
 	}
@@ -1699,8 +3256,8 @@ func (c *Client) UpdateCardWithBody(ctx context.Context, cardId int, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCard(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCardRequest(c.Server, cardId, body)
+func (c *Client) UpdateActionWithBody(ctx context.Context, actionId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateActionRequestWithBody(c.Server, actionId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1711,8 +3268,8 @@ func (c *Client) UpdateCard(ctx context.Context, cardId int, body UpdateCardJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListCollections(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListCollectionsRequest(c.Server, params)
+func (c *Client) UpdateAction(ctx context.Context, actionId int, body UpdateActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateActionRequest(c.Server, actionId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1723,8 +3280,8 @@ func (c *Client) ListCollections(ctx context.Context, params *ListCollectionsPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCollectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCollectionRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateAlertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAlertRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1735,8 +3292,8 @@ func (c *Client) CreateCollectionWithBody(ctx context.Context, contentType strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCollection(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCollectionRequest(c.Server, body)
+func (c *Client) CreateAlert(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAlertRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1747,8 +3304,8 @@ func (c *Client) CreateCollection(ctx context.Context, body CreateCollectionJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCollectionPermissionsGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCollectionPermissionsGraphRequest(c.Server)
+func (c *Client) GetAlert(ctx context.Context, alertId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAlertRequest(c.Server, alertId)
 	if err != nil {
 		return nil, err
 	}
@@ -1759,8 +3316,8 @@ func (c *Client) GetCollectionPermissionsGraph(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReplaceCollectionPermissionsGraphWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReplaceCollectionPermissionsGraphRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateAlertWithBody(ctx context.Context, alertId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAlertRequestWithBody(c.Server, alertId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1771,8 +3328,8 @@ func (c *Client) ReplaceCollectionPermissionsGraphWithBody(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReplaceCollectionPermissionsGraph(ctx context.Context, body ReplaceCollectionPermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReplaceCollectionPermissionsGraphRequest(c.Server, body)
+func (c *Client) UpdateAlert(ctx context.Context, alertId int, body UpdateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAlertRequest(c.Server, alertId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1783,8 +3340,8 @@ func (c *Client) ReplaceCollectionPermissionsGraph(ctx context.Context, body Rep
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCollection(ctx context.Context, collectionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCollectionRequest(c.Server, collectionId)
+func (c *Client) DeleteCacheConfigWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCacheConfigRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1795,8 +3352,8 @@ func (c *Client) GetCollection(ctx context.Context, collectionId string, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCollectionWithBody(ctx context.Context, collectionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCollectionRequestWithBody(c.Server, collectionId, contentType, body)
+func (c *Client) DeleteCacheConfig(ctx context.Context, body DeleteCacheConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCacheConfigRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1807,8 +3364,8 @@ func (c *Client) UpdateCollectionWithBody(ctx context.Context, collectionId stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCollection(ctx context.Context, collectionId string, body UpdateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCollectionRequest(c.Server, collectionId, body)
+func (c *Client) ListCacheConfigs(ctx context.Context, params *ListCacheConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCacheConfigsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1819,8 +3376,8 @@ func (c *Client) UpdateCollection(ctx context.Context, collectionId string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListCollectionItems(ctx context.Context, collectionId string, params *ListCollectionItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListCollectionItemsRequest(c.Server, collectionId, params)
+func (c *Client) UpdateCacheConfigWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCacheConfigRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1831,8 +3388,8 @@ func (c *Client) ListCollectionItems(ctx context.Context, collectionId string, p
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDashboardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDashboardRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateCacheConfig(ctx context.Context, body UpdateCacheConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCacheConfigRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1843,8 +3400,8 @@ func (c *Client) CreateDashboardWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDashboard(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDashboardRequest(c.Server, body)
+func (c *Client) CreateCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCardRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1855,8 +3412,8 @@ func (c *Client) CreateDashboard(ctx context.Context, body CreateDashboardJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDashboard(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDashboardRequest(c.Server, dashboardId)
+func (c *Client) CreateCard(ctx context.Context, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCardRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1867,8 +3424,8 @@ func (c *Client) DeleteDashboard(ctx context.Context, dashboardId int, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDashboard(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDashboardRequest(c.Server, dashboardId)
+func (c *Client) GetCard(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCardRequest(c.Server, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -1879,8 +3436,8 @@ func (c *Client) GetDashboard(ctx context.Context, dashboardId int, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDashboardWithBody(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDashboardRequestWithBody(c.Server, dashboardId, contentType, body)
+func (c *Client) UpdateCardWithBody(ctx context.Context, cardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCardRequestWithBody(c.Server, cardId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1891,8 +3448,8 @@ func (c *Client) UpdateDashboardWithBody(ctx context.Context, dashboardId int, c
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDashboard(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDashboardRequest(c.Server, dashboardId, body)
+func (c *Client) UpdateCard(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCardRequest(c.Server, cardId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1903,8 +3460,8 @@ func (c *Client) UpdateDashboard(ctx context.Context, dashboardId int, body Upda
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabases(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabasesRequest(c.Server, params)
+func (c *Client) DeleteCardPublicLink(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCardPublicLinkRequest(c.Server, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -1915,8 +3472,8 @@ func (c *Client) ListDatabases(ctx context.Context, params *ListDatabasesParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateCardPublicLink(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCardPublicLinkRequest(c.Server, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -1927,8 +3484,8 @@ func (c *Client) CreateDatabaseWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabase(ctx context.Context, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseRequest(c.Server, body)
+func (c *Client) ListCollections(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCollectionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1939,8 +3496,8 @@ func (c *Client) CreateDatabase(ctx context.Context, body CreateDatabaseJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDatabase(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDatabaseRequest(c.Server, databaseId)
+func (c *Client) CreateCollectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCollectionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1951,8 +3508,8 @@ func (c *Client) DeleteDatabase(ctx context.Context, databaseId int, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabase(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseRequest(c.Server, databaseId)
+func (c *Client) CreateCollection(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCollectionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1963,8 +3520,8 @@ func (c *Client) GetDatabase(ctx context.Context, databaseId int, reqEditors ...
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseWithBody(ctx context.Context, databaseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseRequestWithBody(c.Server, databaseId, contentType, body)
+func (c *Client) GetCollectionPermissionsGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCollectionPermissionsGraphRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1975,8 +3532,8 @@ func (c *Client) UpdateDatabaseWithBody(ctx context.Context, databaseId int, con
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabase(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseRequest(c.Server, databaseId, body)
+func (c *Client) ReplaceCollectionPermissionsGraphWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceCollectionPermissionsGraphRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1987,8 +3544,8 @@ func (c *Client) UpdateDatabase(ctx context.Context, databaseId int, body Update
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetContentTranslationCsv(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetContentTranslationCsvRequest(c.Server)
+func (c *Client) ReplaceCollectionPermissionsGraph(ctx context.Context, body ReplaceCollectionPermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceCollectionPermissionsGraphRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1999,8 +3556,8 @@ func (c *Client) GetContentTranslationCsv(ctx context.Context, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetContentTranslationDictionary(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetContentTranslationDictionaryRequest(c.Server, token)
+func (c *Client) GetCollection(ctx context.Context, collectionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCollectionRequest(c.Server, collectionId)
 	if err != nil {
 		return nil, err
 	}
@@ -2011,8 +3568,8 @@ func (c *Client) GetContentTranslationDictionary(ctx context.Context, token stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) UploadContentTranslationDictionaryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUploadContentTranslationDictionaryRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateCollectionWithBody(ctx context.Context, collectionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCollectionRequestWithBody(c.Server, collectionId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2023,8 +3580,8 @@ func (c *Client) UploadContentTranslationDictionaryWithBody(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetField(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFieldRequest(c.Server, fieldId)
+func (c *Client) UpdateCollection(ctx context.Context, collectionId string, body UpdateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCollectionRequest(c.Server, collectionId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2035,8 +3592,8 @@ func (c *Client) GetField(ctx context.Context, fieldId int, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateFieldWithBody(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFieldRequestWithBody(c.Server, fieldId, contentType, body)
+func (c *Client) ListCollectionItems(ctx context.Context, collectionId string, params *ListCollectionItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCollectionItemsRequest(c.Server, collectionId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2047,8 +3604,8 @@ func (c *Client) UpdateFieldWithBody(ctx context.Context, fieldId int, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateField(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFieldRequest(c.Server, fieldId, body)
+func (c *Client) CreateDashboardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDashboardRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2059,8 +3616,8 @@ func (c *Client) UpdateField(ctx context.Context, fieldId int, body UpdateFieldJ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPermissionsGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPermissionsGraphRequest(c.Server)
+func (c *Client) CreateDashboard(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDashboardRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2071,8 +3628,8 @@ func (c *Client) GetPermissionsGraph(ctx context.Context, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReplacePermissionsGraphWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReplacePermissionsGraphRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeleteDashboard(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDashboardRequest(c.Server, dashboardId)
 	if err != nil {
 		return nil, err
 	}
@@ -2083,8 +3640,8 @@ func (c *Client) ReplacePermissionsGraphWithBody(ctx context.Context, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReplacePermissionsGraph(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReplacePermissionsGraphRequest(c.Server, body)
+func (c *Client) GetDashboard(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardRequest(c.Server, dashboardId)
 	if err != nil {
 		return nil, err
 	}
@@ -2095,8 +3652,8 @@ func (c *Client) ReplacePermissionsGraph(ctx context.Context, body ReplacePermis
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePermissionsGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePermissionsGroupRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateDashboardWithBody(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDashboardRequestWithBody(c.Server, dashboardId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2107,8 +3664,8 @@ func (c *Client) CreatePermissionsGroupWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePermissionsGroup(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePermissionsGroupRequest(c.Server, body)
+func (c *Client) UpdateDashboard(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDashboardRequest(c.Server, dashboardId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2119,8 +3676,8 @@ func (c *Client) CreatePermissionsGroup(ctx context.Context, body CreatePermissi
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePermissionsGroup(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePermissionsGroupRequest(c.Server, groupId)
+func (c *Client) CopyDashboardWithBody(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCopyDashboardRequestWithBody(c.Server, dashboardId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2131,8 +3688,8 @@ func (c *Client) DeletePermissionsGroup(ctx context.Context, groupId int, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPermissionsGroup(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPermissionsGroupRequest(c.Server, groupId)
+func (c *Client) CopyDashboard(ctx context.Context, dashboardId int, body CopyDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCopyDashboardRequest(c.Server, dashboardId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2143,8 +3700,8 @@ func (c *Client) GetPermissionsGroup(ctx context.Context, groupId int, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePermissionsGroupWithBody(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePermissionsGroupRequestWithBody(c.Server, groupId, contentType, body)
+func (c *Client) DeleteDashboardPublicLink(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDashboardPublicLinkRequest(c.Server, dashboardId)
 	if err != nil {
 		return nil, err
 	}
@@ -2155,8 +3712,8 @@ func (c *Client) UpdatePermissionsGroupWithBody(ctx context.Context, groupId int
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePermissionsGroup(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePermissionsGroupRequest(c.Server, groupId, body)
+func (c *Client) CreateDashboardPublicLink(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDashboardPublicLinkRequest(c.Server, dashboardId)
 	if err != nil {
 		return nil, err
 	}
@@ -2167,8 +3724,8 @@ func (c *Client) UpdatePermissionsGroup(ctx context.Context, groupId int, body U
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSessionRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListDatabases(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabasesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2179,8 +3736,8 @@ func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSessionRequest(c.Server, body)
+func (c *Client) CreateDatabaseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2191,8 +3748,8 @@ func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTables(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTablesRequest(c.Server)
+func (c *Client) CreateDatabase(ctx context.Context, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2203,8 +3760,8 @@ func (c *Client) ListTables(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTableWithBody(ctx context.Context, tableId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTableRequestWithBody(c.Server, tableId, contentType, body)
+func (c *Client) DeleteDatabase(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseRequest(c.Server, databaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -2215,8 +3772,8 @@ func (c *Client) UpdateTableWithBody(ctx context.Context, tableId int, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTable(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTableRequest(c.Server, tableId, body)
+func (c *Client) GetDatabase(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseRequest(c.Server, databaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -2227,8 +3784,8 @@ func (c *Client) UpdateTable(ctx context.Context, tableId int, body UpdateTableJ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTableMetadata(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTableMetadataRequest(c.Server, tableId, params)
+func (c *Client) UpdateDatabaseWithBody(ctx context.Context, databaseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseRequestWithBody(c.Server, databaseId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2239,8 +3796,8 @@ func (c *Client) GetTableMetadata(ctx context.Context, tableId int, params *GetT
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListUsersRequest(c.Server)
+func (c *Client) UpdateDatabase(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseRequest(c.Server, databaseId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2251,8 +3808,8 @@ func (c *Client) ListUsers(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListImpersonations(ctx context.Context, params *ListImpersonationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListImpersonationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2263,8 +3820,8 @@ func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateUserRequest(c.Server, body)
+func (c *Client) DeleteImpersonation(ctx context.Context, impersonationId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteImpersonationRequest(c.Server, impersonationId)
 	if err != nil {
 		return nil, err
 	}
@@ -2275,8 +3832,8 @@ func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteUser(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteUserRequest(c.Server, userId)
+func (c *Client) GetContentTranslationCsv(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetContentTranslationCsvRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2287,8 +3844,8 @@ func (c *Client) DeleteUser(ctx context.Context, userId int, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUser(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserRequest(c.Server, userId)
+func (c *Client) GetContentTranslationDictionary(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetContentTranslationDictionaryRequest(c.Server, token)
 	if err != nil {
 		return nil, err
 	}
@@ -2299,8 +3856,8 @@ func (c *Client) GetUser(ctx context.Context, userId int, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateUserWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateUserRequestWithBody(c.Server, userId, contentType, body)
+func (c *Client) UploadContentTranslationDictionaryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadContentTranslationDictionaryRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2311,8 +3868,8 @@ func (c *Client) UpdateUserWithBody(ctx context.Context, userId int, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateUser(ctx context.Context, userId int, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateUserRequest(c.Server, userId, body)
+func (c *Client) GetField(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFieldRequest(c.Server, fieldId)
 	if err != nil {
 		return nil, err
 	}
@@ -2323,845 +3880,715 @@ func (c *Client) UpdateUser(ctx context.Context, userId int, body UpdateUserJSON
 	return c.Client.Do(req)
 }
 
-// NewCreateCardRequest calls the generic CreateCard builder with application/json body
-func NewCreateCardRequest(server string, body CreateCardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateFieldWithBody(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFieldRequestWithBody(c.Server, fieldId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateCardRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateCardRequestWithBody generates requests for CreateCard with any type of body
-func NewCreateCardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateField(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFieldRequest(c.Server, fieldId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/card")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateSandboxWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSandboxRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetCardRequest generates requests for GetCard
-func NewGetCardRequest(server string, cardId int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
+func (c *Client) CreateSandbox(ctx context.Context, body CreateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSandboxRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/card/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteSandbox(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSandboxRequest(c.Server, sandboxId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateCardRequest calls the generic UpdateCard builder with application/json body
-func NewUpdateCardRequest(server string, cardId int, body UpdateCardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetSandbox(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSandboxRequest(c.Server, sandboxId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCardRequestWithBody(server, cardId, "application/json", bodyReader)
-}
-
-// NewUpdateCardRequestWithBody generates requests for UpdateCard with any type of body
-func NewUpdateCardRequestWithBody(server string, cardId int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateSandboxWithBody(ctx context.Context, sandboxId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSandboxRequestWithBody(c.Server, sandboxId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/card/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateSandbox(ctx context.Context, sandboxId int, body UpdateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSandboxRequest(c.Server, sandboxId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListCollectionsRequest generates requests for ListCollections
-func NewListCollectionsRequest(server string, params *ListCollectionsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetPermissionsGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPermissionsGraphRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/collection")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ReplacePermissionsGraphWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplacePermissionsGraphRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Archived != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "archived", runtime.ParamLocationQuery, *params.Archived); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateCollectionRequest calls the generic CreateCollection builder with application/json body
-func NewCreateCollectionRequest(server string, body CreateCollectionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ReplacePermissionsGraph(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplacePermissionsGraphRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateCollectionRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateCollectionRequestWithBody generates requests for CreateCollection with any type of body
-func NewCreateCollectionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListPermissionsGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPermissionsGroupsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/collection")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreatePermissionsGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePermissionsGroupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetCollectionPermissionsGraphRequest generates requests for GetCollectionPermissionsGraph
-func NewGetCollectionPermissionsGraphRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreatePermissionsGroup(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePermissionsGroupRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/collection/graph")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeletePermissionsGroup(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePermissionsGroupRequest(c.Server, groupId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewReplaceCollectionPermissionsGraphRequest calls the generic ReplaceCollectionPermissionsGraph builder with application/json body
-func NewReplaceCollectionPermissionsGraphRequest(server string, body ReplaceCollectionPermissionsGraphJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetPermissionsGroup(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPermissionsGroupRequest(c.Server, groupId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewReplaceCollectionPermissionsGraphRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewReplaceCollectionPermissionsGraphRequestWithBody generates requests for ReplaceCollectionPermissionsGraph with any type of body
-func NewReplaceCollectionPermissionsGraphRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdatePermissionsGroupWithBody(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePermissionsGroupRequestWithBody(c.Server, groupId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/collection/graph")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdatePermissionsGroup(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePermissionsGroupRequest(c.Server, groupId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetCollectionRequest generates requests for GetCollection
-func NewGetCollectionRequest(server string, collectionId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
+func (c *Client) CreatePermissionsMembershipWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePermissionsMembershipRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/collection/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreatePermissionsMembership(ctx context.Context, body CreatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePermissionsMembershipRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateCollectionRequest calls the generic UpdateCollection builder with application/json body
-func NewUpdateCollectionRequest(server string, collectionId string, body UpdateCollectionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeletePermissionsMembership(ctx context.Context, membershipId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePermissionsMembershipRequest(c.Server, membershipId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCollectionRequestWithBody(server, collectionId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateCollectionRequestWithBody generates requests for UpdateCollection with any type of body
-func NewUpdateCollectionRequestWithBody(server string, collectionId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
+func (c *Client) UpdatePermissionsMembershipWithBody(ctx context.Context, membershipId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePermissionsMembershipRequestWithBody(c.Server, membershipId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdatePermissionsMembership(ctx context.Context, membershipId int, body UpdatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePermissionsMembershipRequest(c.Server, membershipId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/collection/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreatePulseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePulseRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListCollectionItemsRequest generates requests for ListCollectionItems
-func NewListCollectionItemsRequest(server string, collectionId string, params *ListCollectionItemsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
+func (c *Client) CreatePulse(ctx context.Context, body CreatePulseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePulseRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetPulse(ctx context.Context, pulseId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPulseRequest(c.Server, pulseId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/collection/%s/items", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdatePulseWithBody(ctx context.Context, pulseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePulseRequestWithBody(c.Server, pulseId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Models != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "models", runtime.ParamLocationQuery, *params.Models); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Archived != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "archived", runtime.ParamLocationQuery, *params.Archived); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UpdatePulse(ctx context.Context, pulseId int, body UpdatePulseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePulseRequest(c.Server, pulseId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateDashboardRequest calls the generic CreateDashboard builder with application/json body
-func NewCreateDashboardRequest(server string, body CreateDashboardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListRevisions(ctx context.Context, params *ListRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRevisionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDashboardRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateDashboardRequestWithBody generates requests for CreateDashboard with any type of body
-func NewCreateDashboardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RevertRevisionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevertRevisionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dashboard")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RevertRevision(ctx context.Context, body RevertRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevertRevisionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteDashboardRequest generates requests for DeleteDashboard
-func NewDeleteDashboardRequest(server string, dashboardId int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSessionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSessionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ForgotPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewForgotPasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDashboardRequest generates requests for GetDashboard
-func NewGetDashboardRequest(server string, dashboardId int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+func (c *Client) ForgotPassword(ctx context.Context, body ForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewForgotPasswordRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListTables(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTablesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateTableWithBody(ctx context.Context, tableId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTableRequestWithBody(c.Server, tableId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateDashboardRequest calls the generic UpdateDashboard builder with application/json body
-func NewUpdateDashboardRequest(server string, dashboardId int, body UpdateDashboardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateTable(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTableRequest(c.Server, tableId, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDashboardRequestWithBody(server, dashboardId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateDashboardRequestWithBody generates requests for UpdateDashboard with any type of body
-func NewUpdateDashboardRequestWithBody(server string, dashboardId int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+func (c *Client) GetTableMetadata(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTableMetadataRequest(c.Server, tableId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateTimelineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTimelineRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateTimeline(ctx context.Context, body CreateTimelineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTimelineRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListDatabasesRequest generates requests for ListDatabases
-func NewListDatabasesRequest(server string, params *ListDatabasesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateTimelineEventWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTimelineEventRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/database")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateTimelineEvent(ctx context.Context, body CreateTimelineEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTimelineEventRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Include != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include", runtime.ParamLocationQuery, *params.Include); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Saved != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "saved", runtime.ParamLocationQuery, *params.Saved); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetTimelineEvent(ctx context.Context, timelineEventId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTimelineEventRequest(c.Server, timelineEventId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateDatabaseRequest calls the generic CreateDatabase builder with application/json body
-func NewCreateDatabaseRequest(server string, body CreateDatabaseJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateTimelineEventWithBody(ctx context.Context, timelineEventId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTimelineEventRequestWithBody(c.Server, timelineEventId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateDatabaseRequestWithBody generates requests for CreateDatabase with any type of body
-func NewCreateDatabaseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateTimelineEvent(ctx context.Context, timelineEventId int, body UpdateTimelineEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTimelineEventRequest(c.Server, timelineEventId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/database")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetTimeline(ctx context.Context, timelineId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTimelineRequest(c.Server, timelineId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteDatabaseRequest generates requests for DeleteDatabase
-func NewDeleteDatabaseRequest(server string, databaseId int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
+func (c *Client) UpdateTimelineWithBody(ctx context.Context, timelineId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTimelineRequestWithBody(c.Server, timelineId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateTimeline(ctx context.Context, timelineId int, body UpdateTimelineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTimelineRequest(c.Server, timelineId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/database/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListUsers(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUsersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDatabaseRequest generates requests for GetDatabase
-func NewGetDatabaseRequest(server string, databaseId int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
+func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/database/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteUser(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserRequest(c.Server, userId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetUser(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRequest(c.Server, userId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateDatabaseRequest calls the generic UpdateDatabase builder with application/json body
-func NewUpdateDatabaseRequest(server string, databaseId int, body UpdateDatabaseJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateUserWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserRequestWithBody(c.Server, userId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseRequestWithBody(server, databaseId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateDatabaseRequestWithBody generates requests for UpdateDatabase with any type of body
-func NewUpdateDatabaseRequestWithBody(server string, databaseId int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
+func (c *Client) UpdateUser(ctx context.Context, userId int, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserRequest(c.Server, userId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ReactivateUser(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReactivateUserRequest(c.Server, userId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/database/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SendUserInvite(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSendUserInviteRequest(c.Server, userId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+// NewCreateActionRequest calls the generic CreateAction builder with application/json body
+func NewCreateActionRequest(server string, body CreateActionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateActionRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewGetContentTranslationCsvRequest generates requests for GetContentTranslationCsv
-func NewGetContentTranslationCsvRequest(server string) (*http.Request, error) {
+// NewCreateActionRequestWithBody generates requests for CreateAction with any type of body
+func NewCreateActionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3169,7 +4596,7 @@ func NewGetContentTranslationCsvRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ee/content-translation/csv")
+	operationPath := fmt.Sprintf("/action")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3179,21 +4606,23 @@ func NewGetContentTranslationCsvRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetContentTranslationDictionaryRequest generates requests for GetContentTranslationDictionary
-func NewGetContentTranslationDictionaryRequest(server string, token string) (*http.Request, error) {
+// NewDeleteActionRequest generates requests for DeleteAction
+func NewDeleteActionRequest(server string, actionId int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "actionId", runtime.ParamLocationPath, actionId)
 	if err != nil {
 		return nil, err
 	}
@@ -3203,7 +4632,7 @@ func NewGetContentTranslationDictionaryRequest(server string, token string) (*ht
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ee/content-translation/dictionary/%s", pathParam0)
+	operationPath := fmt.Sprintf("/action/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3213,7 +4642,7 @@ func NewGetContentTranslationDictionaryRequest(server string, token string) (*ht
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3221,16 +4650,23 @@ func NewGetContentTranslationDictionaryRequest(server string, token string) (*ht
 	return req, nil
 }
 
-// NewUploadContentTranslationDictionaryRequestWithBody generates requests for UploadContentTranslationDictionary with any type of body
-func NewUploadContentTranslationDictionaryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetActionRequest generates requests for GetAction
+func NewGetActionRequest(server string, actionId int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "actionId", runtime.ParamLocationPath, actionId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ee/content-translation/upload-dictionary")
+	operationPath := fmt.Sprintf("/action/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3240,23 +4676,32 @@ func NewUploadContentTranslationDictionaryRequestWithBody(server string, content
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetFieldRequest generates requests for GetField
-func NewGetFieldRequest(server string, fieldId int) (*http.Request, error) {
+// NewUpdateActionRequest calls the generic UpdateAction builder with application/json body
+func NewUpdateActionRequest(server string, actionId int, body UpdateActionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateActionRequestWithBody(server, actionId, "application/json", bodyReader)
+}
+
+// NewUpdateActionRequestWithBody generates requests for UpdateAction with any type of body
+func NewUpdateActionRequestWithBody(server string, actionId int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "actionId", runtime.ParamLocationPath, actionId)
 	if err != nil {
 		return nil, err
 	}
@@ -3266,7 +4711,7 @@ func NewGetFieldRequest(server string, fieldId int) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/field/%s", pathParam0)
+	operationPath := fmt.Sprintf("/action/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3276,42 +4721,37 @@ func NewGetFieldRequest(server string, fieldId int) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUpdateFieldRequest calls the generic UpdateField builder with application/json body
-func NewUpdateFieldRequest(server string, fieldId int, body UpdateFieldJSONRequestBody) (*http.Request, error) {
+// NewCreateAlertRequest calls the generic CreateAlert builder with application/json body
+func NewCreateAlertRequest(server string, body CreateAlertJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateFieldRequestWithBody(server, fieldId, "application/json", bodyReader)
+	return NewCreateAlertRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateFieldRequestWithBody generates requests for UpdateField with any type of body
-func NewUpdateFieldRequestWithBody(server string, fieldId int, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAlertRequestWithBody generates requests for CreateAlert with any type of body
+func NewCreateAlertRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/field/%s", pathParam0)
+	operationPath := fmt.Sprintf("/alert")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3321,7 +4761,7 @@ func NewUpdateFieldRequestWithBody(server string, fieldId int, contentType strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3331,16 +4771,23 @@ func NewUpdateFieldRequestWithBody(server string, fieldId int, contentType strin
 	return req, nil
 }
 
-// NewGetPermissionsGraphRequest generates requests for GetPermissionsGraph
-func NewGetPermissionsGraphRequest(server string) (*http.Request, error) {
+// NewGetAlertRequest generates requests for GetAlert
+func NewGetAlertRequest(server string, alertId int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alertId", runtime.ParamLocationPath, alertId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions/graph")
+	operationPath := fmt.Sprintf("/alert/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3358,27 +4805,34 @@ func NewGetPermissionsGraphRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewReplacePermissionsGraphRequest calls the generic ReplacePermissionsGraph builder with application/json body
-func NewReplacePermissionsGraphRequest(server string, body ReplacePermissionsGraphJSONRequestBody) (*http.Request, error) {
+// NewUpdateAlertRequest calls the generic UpdateAlert builder with application/json body
+func NewUpdateAlertRequest(server string, alertId int, body UpdateAlertJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewReplacePermissionsGraphRequestWithBody(server, "application/json", bodyReader)
+	return NewUpdateAlertRequestWithBody(server, alertId, "application/json", bodyReader)
 }
 
-// NewReplacePermissionsGraphRequestWithBody generates requests for ReplacePermissionsGraph with any type of body
-func NewReplacePermissionsGraphRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAlertRequestWithBody generates requests for UpdateAlert with any type of body
+func NewUpdateAlertRequestWithBody(server string, alertId int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alertId", runtime.ParamLocationPath, alertId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions/graph")
+	operationPath := fmt.Sprintf("/alert/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3398,19 +4852,19 @@ func NewReplacePermissionsGraphRequestWithBody(server string, contentType string
 	return req, nil
 }
 
-// NewCreatePermissionsGroupRequest calls the generic CreatePermissionsGroup builder with application/json body
-func NewCreatePermissionsGroupRequest(server string, body CreatePermissionsGroupJSONRequestBody) (*http.Request, error) {
+// NewDeleteCacheConfigRequest calls the generic DeleteCacheConfig builder with application/json body
+func NewDeleteCacheConfigRequest(server string, body DeleteCacheConfigJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreatePermissionsGroupRequestWithBody(server, "application/json", bodyReader)
+	return NewDeleteCacheConfigRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreatePermissionsGroupRequestWithBody generates requests for CreatePermissionsGroup with any type of body
-func NewCreatePermissionsGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteCacheConfigRequestWithBody generates requests for DeleteCacheConfig with any type of body
+func NewDeleteCacheConfigRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3418,7 +4872,7 @@ func NewCreatePermissionsGroupRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions/group")
+	operationPath := fmt.Sprintf("/cache")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3428,7 +4882,7 @@ func NewCreatePermissionsGroupRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3438,23 +4892,16 @@ func NewCreatePermissionsGroupRequestWithBody(server string, contentType string,
 	return req, nil
 }
 
-// NewDeletePermissionsGroupRequest generates requests for DeletePermissionsGroup
-func NewDeletePermissionsGroupRequest(server string, groupId int) (*http.Request, error) {
+// NewListCacheConfigsRequest generates requests for ListCacheConfigs
+func NewListCacheConfigsRequest(server string, params *ListCacheConfigsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/cache")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3464,38 +4911,38 @@ func NewDeletePermissionsGroupRequest(server string, groupId int) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewGetPermissionsGroupRequest generates requests for GetPermissionsGroup
-func NewGetPermissionsGroupRequest(server string, groupId int) (*http.Request, error) {
-	var err error
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	var pathParam0 string
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "model", runtime.ParamLocationQuery, params.Model); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
-	if err != nil {
-		return nil, err
-	}
+		if params.Id != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -3506,34 +4953,27 @@ func NewGetPermissionsGroupRequest(server string, groupId int) (*http.Request, e
 	return req, nil
 }
 
-// NewUpdatePermissionsGroupRequest calls the generic UpdatePermissionsGroup builder with application/json body
-func NewUpdatePermissionsGroupRequest(server string, groupId int, body UpdatePermissionsGroupJSONRequestBody) (*http.Request, error) {
+// NewUpdateCacheConfigRequest calls the generic UpdateCacheConfig builder with application/json body
+func NewUpdateCacheConfigRequest(server string, body UpdateCacheConfigJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePermissionsGroupRequestWithBody(server, groupId, "application/json", bodyReader)
+	return NewUpdateCacheConfigRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdatePermissionsGroupRequestWithBody generates requests for UpdatePermissionsGroup with any type of body
-func NewUpdatePermissionsGroupRequestWithBody(server string, groupId int, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateCacheConfigRequestWithBody generates requests for UpdateCacheConfig with any type of body
+func NewUpdateCacheConfigRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/cache")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3553,19 +4993,19 @@ func NewUpdatePermissionsGroupRequestWithBody(server string, groupId int, conten
 	return req, nil
 }
 
-// NewCreateSessionRequest calls the generic CreateSession builder with application/json body
-func NewCreateSessionRequest(server string, body CreateSessionJSONRequestBody) (*http.Request, error) {
+// NewCreateCardRequest calls the generic CreateCard builder with application/json body
+func NewCreateCardRequest(server string, body CreateCardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateSessionRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateCardRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateSessionRequestWithBody generates requests for CreateSession with any type of body
-func NewCreateSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateCardRequestWithBody generates requests for CreateCard with any type of body
+func NewCreateCardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3573,7 +5013,7 @@ func NewCreateSessionRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/session")
+	operationPath := fmt.Sprintf("/card")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3593,16 +5033,23 @@ func NewCreateSessionRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewListTablesRequest generates requests for ListTables
-func NewListTablesRequest(server string) (*http.Request, error) {
+// NewGetCardRequest generates requests for GetCard
+func NewGetCardRequest(server string, cardId int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/table")
+	operationPath := fmt.Sprintf("/card/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3620,24 +5067,24 @@ func NewListTablesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateTableRequest calls the generic UpdateTable builder with application/json body
-func NewUpdateTableRequest(server string, tableId int, body UpdateTableJSONRequestBody) (*http.Request, error) {
+// NewUpdateCardRequest calls the generic UpdateCard builder with application/json body
+func NewUpdateCardRequest(server string, cardId int, body UpdateCardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTableRequestWithBody(server, tableId, "application/json", bodyReader)
+	return NewUpdateCardRequestWithBody(server, cardId, "application/json", bodyReader)
 }
 
-// NewUpdateTableRequestWithBody generates requests for UpdateTable with any type of body
-func NewUpdateTableRequestWithBody(server string, tableId int, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateCardRequestWithBody generates requests for UpdateCard with any type of body
+func NewUpdateCardRequestWithBody(server string, cardId int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableId", runtime.ParamLocationPath, tableId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -3647,7 +5094,7 @@ func NewUpdateTableRequestWithBody(server string, tableId int, contentType strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/table/%s", pathParam0)
+	operationPath := fmt.Sprintf("/card/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3667,13 +5114,13 @@ func NewUpdateTableRequestWithBody(server string, tableId int, contentType strin
 	return req, nil
 }
 
-// NewGetTableMetadataRequest generates requests for GetTableMetadata
-func NewGetTableMetadataRequest(server string, tableId int, params *GetTableMetadataParams) (*http.Request, error) {
+// NewDeleteCardPublicLinkRequest generates requests for DeleteCardPublicLink
+func NewDeleteCardPublicLinkRequest(server string, cardId int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableId", runtime.ParamLocationPath, tableId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -3683,7 +5130,7 @@ func NewGetTableMetadataRequest(server string, tableId int, params *GetTableMeta
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/table/%s/query_metadata", pathParam0)
+	operationPath := fmt.Sprintf("/card/%s/public_link", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3693,29 +5140,41 @@ func NewGetTableMetadataRequest(server string, tableId int, params *GetTableMeta
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.IncludeHiddenFields != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_hidden_fields", runtime.ParamLocationQuery, *params.IncludeHiddenFields); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewCreateCardPublicLinkRequest generates requests for CreateCardPublicLink
+func NewCreateCardPublicLinkRequest(server string, cardId int) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		queryURL.RawQuery = queryValues.Encode()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/card/%s/public_link", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3723,8 +5182,8 @@ func NewGetTableMetadataRequest(server string, tableId int, params *GetTableMeta
 	return req, nil
 }
 
-// NewListUsersRequest generates requests for ListUsers
-func NewListUsersRequest(server string) (*http.Request, error) {
+// NewListCollectionsRequest generates requests for ListCollections
+func NewListCollectionsRequest(server string, params *ListCollectionsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3732,7 +5191,7 @@ func NewListUsersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/user")
+	operationPath := fmt.Sprintf("/collection")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3742,6 +5201,28 @@ func NewListUsersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Archived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "archived", runtime.ParamLocationQuery, *params.Archived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3750,19 +5231,19 @@ func NewListUsersRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateUserRequest calls the generic CreateUser builder with application/json body
-func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+// NewCreateCollectionRequest calls the generic CreateCollection builder with application/json body
+func NewCreateCollectionRequest(server string, body CreateCollectionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateCollectionRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
-func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateCollectionRequestWithBody generates requests for CreateCollection with any type of body
+func NewCreateCollectionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3770,7 +5251,7 @@ func NewCreateUserRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/user")
+	operationPath := fmt.Sprintf("/collection")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3790,23 +5271,54 @@ func NewCreateUserRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewDeleteUserRequest generates requests for DeleteUser
-func NewDeleteUserRequest(server string, userId int) (*http.Request, error) {
+// NewGetCollectionPermissionsGraphRequest generates requests for GetCollectionPermissionsGraph
+func NewGetCollectionPermissionsGraphRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	operationPath := fmt.Sprintf("/collection/graph")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReplaceCollectionPermissionsGraphRequest calls the generic ReplaceCollectionPermissionsGraph builder with application/json body
+func NewReplaceCollectionPermissionsGraphRequest(server string, body ReplaceCollectionPermissionsGraphJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplaceCollectionPermissionsGraphRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReplaceCollectionPermissionsGraphRequestWithBody generates requests for ReplaceCollectionPermissionsGraph with any type of body
+func NewReplaceCollectionPermissionsGraphRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/user/%s", pathParam0)
+	operationPath := fmt.Sprintf("/collection/graph")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3816,21 +5328,23 @@ func NewDeleteUserRequest(server string, userId int) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetUserRequest generates requests for GetUser
-func NewGetUserRequest(server string, userId int) (*http.Request, error) {
+// NewGetCollectionRequest generates requests for GetCollection
+func NewGetCollectionRequest(server string, collectionId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
 	if err != nil {
 		return nil, err
 	}
@@ -3840,7 +5354,7 @@ func NewGetUserRequest(server string, userId int) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/user/%s", pathParam0)
+	operationPath := fmt.Sprintf("/collection/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3858,24 +5372,24 @@ func NewGetUserRequest(server string, userId int) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateUserRequest calls the generic UpdateUser builder with application/json body
-func NewUpdateUserRequest(server string, userId int, body UpdateUserJSONRequestBody) (*http.Request, error) {
+// NewUpdateCollectionRequest calls the generic UpdateCollection builder with application/json body
+func NewUpdateCollectionRequest(server string, collectionId string, body UpdateCollectionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateUserRequestWithBody(server, userId, "application/json", bodyReader)
+	return NewUpdateCollectionRequestWithBody(server, collectionId, "application/json", bodyReader)
 }
 
-// NewUpdateUserRequestWithBody generates requests for UpdateUser with any type of body
-func NewUpdateUserRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateCollectionRequestWithBody generates requests for UpdateCollection with any type of body
+func NewUpdateCollectionRequestWithBody(server string, collectionId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
 	if err != nil {
 		return nil, err
 	}
@@ -3885,7 +5399,7 @@ func NewUpdateUserRequestWithBody(server string, userId int, contentType string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/user/%s", pathParam0)
+	operationPath := fmt.Sprintf("/collection/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3905,1561 +5419,6329 @@ func NewUpdateUserRequestWithBody(server string, userId int, contentType string,
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewListCollectionItemsRequest generates requests for ListCollectionItems
+func NewListCollectionItemsRequest(server string, collectionId string, params *ListCollectionItemsParams) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// CreateCardWithBodyWithResponse request with any body
-	CreateCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
-
-	CreateCardWithResponse(ctx context.Context, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
-
-	// GetCardWithResponse request
-	GetCardWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardResponse, error)
 
-	// UpdateCardWithBodyWithResponse request with any body
-	UpdateCardWithBodyWithResponse(ctx context.Context, cardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error)
+	operationPath := fmt.Sprintf("/collection/%s/items", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	UpdateCardWithResponse(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListCollectionsWithResponse request
-	ListCollectionsWithResponse(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*ListCollectionsResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// CreateCollectionWithBodyWithResponse request with any body
-	CreateCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error)
+		if params.Models != nil {
 
-	CreateCollectionWithResponse(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "models", runtime.ParamLocationQuery, *params.Models); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetCollectionPermissionsGraphWithResponse request
-	GetCollectionPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCollectionPermissionsGraphResponse, error)
+		}
 
-	// ReplaceCollectionPermissionsGraphWithBodyWithResponse request with any body
-	ReplaceCollectionPermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error)
+		if params.Archived != nil {
 
-	ReplaceCollectionPermissionsGraphWithResponse(ctx context.Context, body ReplaceCollectionPermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "archived", runtime.ParamLocationQuery, *params.Archived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetCollectionWithResponse request
-	GetCollectionWithResponse(ctx context.Context, collectionId string, reqEditors ...RequestEditorFn) (*GetCollectionResponse, error)
+		}
 
-	// UpdateCollectionWithBodyWithResponse request with any body
-	UpdateCollectionWithBodyWithResponse(ctx context.Context, collectionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error)
+		if params.PinnedState != nil {
 
-	UpdateCollectionWithResponse(ctx context.Context, collectionId string, body UpdateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pinned_state", runtime.ParamLocationQuery, *params.PinnedState); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// ListCollectionItemsWithResponse request
-	ListCollectionItemsWithResponse(ctx context.Context, collectionId string, params *ListCollectionItemsParams, reqEditors ...RequestEditorFn) (*ListCollectionItemsResponse, error)
+		}
 
-	// CreateDashboardWithBodyWithResponse request with any body
-	CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error)
+		if params.Limit != nil {
 
-	CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// DeleteDashboardWithResponse request
-	DeleteDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*DeleteDashboardResponse, error)
+		}
 
-	// GetDashboardWithResponse request
-	GetDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error)
+		if params.Offset != nil {
 
-	// UpdateDashboardWithBodyWithResponse request with any body
-	UpdateDashboardWithBodyWithResponse(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	UpdateDashboardWithResponse(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error)
+		}
 
-	// ListDatabasesWithResponse request
-	ListDatabasesWithResponse(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// CreateDatabaseWithBodyWithResponse request with any body
-	CreateDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateDatabaseWithResponse(ctx context.Context, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+	return req, nil
+}
 
-	// DeleteDatabaseWithResponse request
-	DeleteDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error)
+// NewCreateDashboardRequest calls the generic CreateDashboard builder with application/json body
+func NewCreateDashboardRequest(server string, body CreateDashboardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDashboardRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// GetDatabaseWithResponse request
-	GetDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error)
+// NewCreateDashboardRequestWithBody generates requests for CreateDashboard with any type of body
+func NewCreateDashboardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// UpdateDatabaseWithBodyWithResponse request with any body
-	UpdateDatabaseWithBodyWithResponse(ctx context.Context, databaseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateDatabaseWithResponse(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+	operationPath := fmt.Sprintf("/dashboard")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetContentTranslationCsvWithResponse request
-	GetContentTranslationCsvWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetContentTranslationCsvResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetContentTranslationDictionaryWithResponse request
-	GetContentTranslationDictionaryWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetContentTranslationDictionaryResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// UploadContentTranslationDictionaryWithBodyWithResponse request with any body
-	UploadContentTranslationDictionaryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadContentTranslationDictionaryResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetFieldWithResponse request
-	GetFieldWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*GetFieldResponse, error)
+	return req, nil
+}
 
-	// UpdateFieldWithBodyWithResponse request with any body
-	UpdateFieldWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error)
+// NewDeleteDashboardRequest generates requests for DeleteDashboard
+func NewDeleteDashboardRequest(server string, dashboardId int) (*http.Request, error) {
+	var err error
 
-	UpdateFieldWithResponse(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error)
+	var pathParam0 string
 
-	// GetPermissionsGraphWithResponse request
-	GetPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPermissionsGraphResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+	if err != nil {
+		return nil, err
+	}
 
-	// ReplacePermissionsGraphWithBodyWithResponse request with any body
-	ReplacePermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	ReplacePermissionsGraphWithResponse(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error)
+	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreatePermissionsGroupWithBodyWithResponse request with any body
-	CreatePermissionsGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreatePermissionsGroupWithResponse(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeletePermissionsGroupWithResponse request
-	DeletePermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*DeletePermissionsGroupResponse, error)
+	return req, nil
+}
 
-	// GetPermissionsGroupWithResponse request
-	GetPermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*GetPermissionsGroupResponse, error)
+// NewGetDashboardRequest generates requests for GetDashboard
+func NewGetDashboardRequest(server string, dashboardId int) (*http.Request, error) {
+	var err error
 
-	// UpdatePermissionsGroupWithBodyWithResponse request with any body
-	UpdatePermissionsGroupWithBodyWithResponse(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error)
+	var pathParam0 string
 
-	UpdatePermissionsGroupWithResponse(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateSessionWithBodyWithResponse request with any body
-	CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDashboardRequest calls the generic UpdateDashboard builder with application/json body
+func NewUpdateDashboardRequest(server string, dashboardId int, body UpdateDashboardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDashboardRequestWithBody(server, dashboardId, "application/json", bodyReader)
+}
+
+// NewUpdateDashboardRequestWithBody generates requests for UpdateDashboard with any type of body
+func NewUpdateDashboardRequestWithBody(server string, dashboardId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCopyDashboardRequest calls the generic CopyDashboard builder with application/json body
+func NewCopyDashboardRequest(server string, dashboardId int, body CopyDashboardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCopyDashboardRequestWithBody(server, dashboardId, "application/json", bodyReader)
+}
+
+// NewCopyDashboardRequestWithBody generates requests for CopyDashboard with any type of body
+func NewCopyDashboardRequestWithBody(server string, dashboardId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard/%s/copy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDashboardPublicLinkRequest generates requests for DeleteDashboardPublicLink
+func NewDeleteDashboardPublicLinkRequest(server string, dashboardId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard/%s/public_link", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDashboardPublicLinkRequest generates requests for CreateDashboardPublicLink
+func NewCreateDashboardPublicLinkRequest(server string, dashboardId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard/%s/public_link", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDatabasesRequest generates requests for ListDatabases
+func NewListDatabasesRequest(server string, params *ListDatabasesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/database")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Include != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include", runtime.ParamLocationQuery, *params.Include); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Saved != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "saved", runtime.ParamLocationQuery, *params.Saved); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDatabaseRequest calls the generic CreateDatabase builder with application/json body
+func NewCreateDatabaseRequest(server string, body CreateDatabaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateDatabaseRequestWithBody generates requests for CreateDatabase with any type of body
+func NewCreateDatabaseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/database")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDatabaseRequest generates requests for DeleteDatabase
+func NewDeleteDatabaseRequest(server string, databaseId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/database/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseRequest generates requests for GetDatabase
+func NewGetDatabaseRequest(server string, databaseId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/database/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDatabaseRequest calls the generic UpdateDatabase builder with application/json body
+func NewUpdateDatabaseRequest(server string, databaseId int, body UpdateDatabaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseRequestWithBody(server, databaseId, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseRequestWithBody generates requests for UpdateDatabase with any type of body
+func NewUpdateDatabaseRequestWithBody(server string, databaseId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/database/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListImpersonationsRequest generates requests for ListImpersonations
+func NewListImpersonationsRequest(server string, params *ListImpersonationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ee/advanced-permissions/impersonation")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.GroupId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_id", runtime.ParamLocationQuery, *params.GroupId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DbId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "db_id", runtime.ParamLocationQuery, *params.DbId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteImpersonationRequest generates requests for DeleteImpersonation
+func NewDeleteImpersonationRequest(server string, impersonationId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "impersonationId", runtime.ParamLocationPath, impersonationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ee/advanced-permissions/impersonation/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetContentTranslationCsvRequest generates requests for GetContentTranslationCsv
+func NewGetContentTranslationCsvRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ee/content-translation/csv")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetContentTranslationDictionaryRequest generates requests for GetContentTranslationDictionary
+func NewGetContentTranslationDictionaryRequest(server string, token string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ee/content-translation/dictionary/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadContentTranslationDictionaryRequestWithBody generates requests for UploadContentTranslationDictionary with any type of body
+func NewUploadContentTranslationDictionaryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ee/content-translation/upload-dictionary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFieldRequest generates requests for GetField
+func NewGetFieldRequest(server string, fieldId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/field/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateFieldRequest calls the generic UpdateField builder with application/json body
+func NewUpdateFieldRequest(server string, fieldId int, body UpdateFieldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateFieldRequestWithBody(server, fieldId, "application/json", bodyReader)
+}
+
+// NewUpdateFieldRequestWithBody generates requests for UpdateField with any type of body
+func NewUpdateFieldRequestWithBody(server string, fieldId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/field/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateSandboxRequest calls the generic CreateSandbox builder with application/json body
+func NewCreateSandboxRequest(server string, body CreateSandboxJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSandboxRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSandboxRequestWithBody generates requests for CreateSandbox with any type of body
+func NewCreateSandboxRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mt/gtap")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSandboxRequest generates requests for DeleteSandbox
+func NewDeleteSandboxRequest(server string, sandboxId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sandboxId", runtime.ParamLocationPath, sandboxId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mt/gtap/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSandboxRequest generates requests for GetSandbox
+func NewGetSandboxRequest(server string, sandboxId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sandboxId", runtime.ParamLocationPath, sandboxId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mt/gtap/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSandboxRequest calls the generic UpdateSandbox builder with application/json body
+func NewUpdateSandboxRequest(server string, sandboxId int, body UpdateSandboxJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSandboxRequestWithBody(server, sandboxId, "application/json", bodyReader)
+}
+
+// NewUpdateSandboxRequestWithBody generates requests for UpdateSandbox with any type of body
+func NewUpdateSandboxRequestWithBody(server string, sandboxId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sandboxId", runtime.ParamLocationPath, sandboxId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mt/gtap/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPermissionsGraphRequest generates requests for GetPermissionsGraph
+func NewGetPermissionsGraphRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/graph")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReplacePermissionsGraphRequest calls the generic ReplacePermissionsGraph builder with application/json body
+func NewReplacePermissionsGraphRequest(server string, body ReplacePermissionsGraphJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplacePermissionsGraphRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReplacePermissionsGraphRequestWithBody generates requests for ReplacePermissionsGraph with any type of body
+func NewReplacePermissionsGraphRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/graph")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPermissionsGroupsRequest generates requests for ListPermissionsGroups
+func NewListPermissionsGroupsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/group")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePermissionsGroupRequest calls the generic CreatePermissionsGroup builder with application/json body
+func NewCreatePermissionsGroupRequest(server string, body CreatePermissionsGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePermissionsGroupRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePermissionsGroupRequestWithBody generates requests for CreatePermissionsGroup with any type of body
+func NewCreatePermissionsGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/group")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePermissionsGroupRequest generates requests for DeletePermissionsGroup
+func NewDeletePermissionsGroupRequest(server string, groupId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPermissionsGroupRequest generates requests for GetPermissionsGroup
+func NewGetPermissionsGroupRequest(server string, groupId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePermissionsGroupRequest calls the generic UpdatePermissionsGroup builder with application/json body
+func NewUpdatePermissionsGroupRequest(server string, groupId int, body UpdatePermissionsGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePermissionsGroupRequestWithBody(server, groupId, "application/json", bodyReader)
+}
+
+// NewUpdatePermissionsGroupRequestWithBody generates requests for UpdatePermissionsGroup with any type of body
+func NewUpdatePermissionsGroupRequestWithBody(server string, groupId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreatePermissionsMembershipRequest calls the generic CreatePermissionsMembership builder with application/json body
+func NewCreatePermissionsMembershipRequest(server string, body CreatePermissionsMembershipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePermissionsMembershipRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePermissionsMembershipRequestWithBody generates requests for CreatePermissionsMembership with any type of body
+func NewCreatePermissionsMembershipRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/membership")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePermissionsMembershipRequest generates requests for DeletePermissionsMembership
+func NewDeletePermissionsMembershipRequest(server string, membershipId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "membershipId", runtime.ParamLocationPath, membershipId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/membership/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePermissionsMembershipRequest calls the generic UpdatePermissionsMembership builder with application/json body
+func NewUpdatePermissionsMembershipRequest(server string, membershipId int, body UpdatePermissionsMembershipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePermissionsMembershipRequestWithBody(server, membershipId, "application/json", bodyReader)
+}
+
+// NewUpdatePermissionsMembershipRequestWithBody generates requests for UpdatePermissionsMembership with any type of body
+func NewUpdatePermissionsMembershipRequestWithBody(server string, membershipId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "membershipId", runtime.ParamLocationPath, membershipId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/membership/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreatePulseRequest calls the generic CreatePulse builder with application/json body
+func NewCreatePulseRequest(server string, body CreatePulseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePulseRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePulseRequestWithBody generates requests for CreatePulse with any type of body
+func NewCreatePulseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pulse")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPulseRequest generates requests for GetPulse
+func NewGetPulseRequest(server string, pulseId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pulseId", runtime.ParamLocationPath, pulseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pulse/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePulseRequest calls the generic UpdatePulse builder with application/json body
+func NewUpdatePulseRequest(server string, pulseId int, body UpdatePulseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePulseRequestWithBody(server, pulseId, "application/json", bodyReader)
+}
+
+// NewUpdatePulseRequestWithBody generates requests for UpdatePulse with any type of body
+func NewUpdatePulseRequestWithBody(server string, pulseId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pulseId", runtime.ParamLocationPath, pulseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pulse/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListRevisionsRequest generates requests for ListRevisions
+func NewListRevisionsRequest(server string, params *ListRevisionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/revision")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity", runtime.ParamLocationQuery, params.Entity); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevertRevisionRequest calls the generic RevertRevision builder with application/json body
+func NewRevertRevisionRequest(server string, body RevertRevisionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRevertRevisionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRevertRevisionRequestWithBody generates requests for RevertRevision with any type of body
+func NewRevertRevisionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/revision/revert")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateSessionRequest calls the generic CreateSession builder with application/json body
+func NewCreateSessionRequest(server string, body CreateSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSessionRequestWithBody generates requests for CreateSession with any type of body
+func NewCreateSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewForgotPasswordRequest calls the generic ForgotPassword builder with application/json body
+func NewForgotPasswordRequest(server string, body ForgotPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewForgotPasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewForgotPasswordRequestWithBody generates requests for ForgotPassword with any type of body
+func NewForgotPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session/forgot_password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTablesRequest generates requests for ListTables
+func NewListTablesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/table")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTableRequest calls the generic UpdateTable builder with application/json body
+func NewUpdateTableRequest(server string, tableId int, body UpdateTableJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTableRequestWithBody(server, tableId, "application/json", bodyReader)
+}
+
+// NewUpdateTableRequestWithBody generates requests for UpdateTable with any type of body
+func NewUpdateTableRequestWithBody(server string, tableId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableId", runtime.ParamLocationPath, tableId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/table/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTableMetadataRequest generates requests for GetTableMetadata
+func NewGetTableMetadataRequest(server string, tableId int, params *GetTableMetadataParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableId", runtime.ParamLocationPath, tableId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/table/%s/query_metadata", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeHiddenFields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_hidden_fields", runtime.ParamLocationQuery, *params.IncludeHiddenFields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTimelineRequest calls the generic CreateTimeline builder with application/json body
+func NewCreateTimelineRequest(server string, body CreateTimelineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTimelineRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTimelineRequestWithBody generates requests for CreateTimeline with any type of body
+func NewCreateTimelineRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/timeline")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateTimelineEventRequest calls the generic CreateTimelineEvent builder with application/json body
+func NewCreateTimelineEventRequest(server string, body CreateTimelineEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTimelineEventRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTimelineEventRequestWithBody generates requests for CreateTimelineEvent with any type of body
+func NewCreateTimelineEventRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/timeline-event")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTimelineEventRequest generates requests for GetTimelineEvent
+func NewGetTimelineEventRequest(server string, timelineEventId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "timelineEventId", runtime.ParamLocationPath, timelineEventId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/timeline-event/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTimelineEventRequest calls the generic UpdateTimelineEvent builder with application/json body
+func NewUpdateTimelineEventRequest(server string, timelineEventId int, body UpdateTimelineEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTimelineEventRequestWithBody(server, timelineEventId, "application/json", bodyReader)
+}
+
+// NewUpdateTimelineEventRequestWithBody generates requests for UpdateTimelineEvent with any type of body
+func NewUpdateTimelineEventRequestWithBody(server string, timelineEventId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "timelineEventId", runtime.ParamLocationPath, timelineEventId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/timeline-event/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTimelineRequest generates requests for GetTimeline
+func NewGetTimelineRequest(server string, timelineId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "timelineId", runtime.ParamLocationPath, timelineId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/timeline/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTimelineRequest calls the generic UpdateTimeline builder with application/json body
+func NewUpdateTimelineRequest(server string, timelineId int, body UpdateTimelineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTimelineRequestWithBody(server, timelineId, "application/json", bodyReader)
+}
+
+// NewUpdateTimelineRequestWithBody generates requests for UpdateTimeline with any type of body
+func NewUpdateTimelineRequestWithBody(server string, timelineId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "timelineId", runtime.ParamLocationPath, timelineId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/timeline/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListUsersRequest generates requests for ListUsers
+func NewListUsersRequest(server string, params *ListUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query", runtime.ParamLocationQuery, *params.Query); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GroupId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_id", runtime.ParamLocationQuery, *params.GroupId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
+func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUserRequest generates requests for DeleteUser
+func NewDeleteUserRequest(server string, userId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserRequest generates requests for GetUser
+func NewGetUserRequest(server string, userId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserRequest calls the generic UpdateUser builder with application/json body
+func NewUpdateUserRequest(server string, userId int, body UpdateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUpdateUserRequestWithBody generates requests for UpdateUser with any type of body
+func NewUpdateUserRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReactivateUserRequest generates requests for ReactivateUser
+func NewReactivateUserRequest(server string, userId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/%s/reactivate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSendUserInviteRequest generates requests for SendUserInvite
+func NewSendUserInviteRequest(server string, userId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/%s/send_invite", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// CreateActionWithBodyWithResponse request with any body
+	CreateActionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateActionResponse, error)
+
+	CreateActionWithResponse(ctx context.Context, body CreateActionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateActionResponse, error)
+
+	// DeleteActionWithResponse request
+	DeleteActionWithResponse(ctx context.Context, actionId int, reqEditors ...RequestEditorFn) (*DeleteActionResponse, error)
+
+	// GetActionWithResponse request
+	GetActionWithResponse(ctx context.Context, actionId int, reqEditors ...RequestEditorFn) (*GetActionResponse, error)
+
+	// UpdateActionWithBodyWithResponse request with any body
+	UpdateActionWithBodyWithResponse(ctx context.Context, actionId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateActionResponse, error)
+
+	UpdateActionWithResponse(ctx context.Context, actionId int, body UpdateActionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateActionResponse, error)
+
+	// CreateAlertWithBodyWithResponse request with any body
+	CreateAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error)
+
+	CreateAlertWithResponse(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error)
+
+	// GetAlertWithResponse request
+	GetAlertWithResponse(ctx context.Context, alertId int, reqEditors ...RequestEditorFn) (*GetAlertResponse, error)
+
+	// UpdateAlertWithBodyWithResponse request with any body
+	UpdateAlertWithBodyWithResponse(ctx context.Context, alertId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertResponse, error)
+
+	UpdateAlertWithResponse(ctx context.Context, alertId int, body UpdateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertResponse, error)
+
+	// DeleteCacheConfigWithBodyWithResponse request with any body
+	DeleteCacheConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteCacheConfigResponse, error)
+
+	DeleteCacheConfigWithResponse(ctx context.Context, body DeleteCacheConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteCacheConfigResponse, error)
+
+	// ListCacheConfigsWithResponse request
+	ListCacheConfigsWithResponse(ctx context.Context, params *ListCacheConfigsParams, reqEditors ...RequestEditorFn) (*ListCacheConfigsResponse, error)
+
+	// UpdateCacheConfigWithBodyWithResponse request with any body
+	UpdateCacheConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCacheConfigResponse, error)
+
+	UpdateCacheConfigWithResponse(ctx context.Context, body UpdateCacheConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCacheConfigResponse, error)
+
+	// CreateCardWithBodyWithResponse request with any body
+	CreateCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
+
+	CreateCardWithResponse(ctx context.Context, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
+
+	// GetCardWithResponse request
+	GetCardWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardResponse, error)
+
+	// UpdateCardWithBodyWithResponse request with any body
+	UpdateCardWithBodyWithResponse(ctx context.Context, cardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error)
+
+	UpdateCardWithResponse(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error)
+
+	// DeleteCardPublicLinkWithResponse request
+	DeleteCardPublicLinkWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*DeleteCardPublicLinkResponse, error)
+
+	// CreateCardPublicLinkWithResponse request
+	CreateCardPublicLinkWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*CreateCardPublicLinkResponse, error)
+
+	// ListCollectionsWithResponse request
+	ListCollectionsWithResponse(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*ListCollectionsResponse, error)
+
+	// CreateCollectionWithBodyWithResponse request with any body
+	CreateCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error)
+
+	CreateCollectionWithResponse(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error)
+
+	// GetCollectionPermissionsGraphWithResponse request
+	GetCollectionPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCollectionPermissionsGraphResponse, error)
+
+	// ReplaceCollectionPermissionsGraphWithBodyWithResponse request with any body
+	ReplaceCollectionPermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error)
+
+	ReplaceCollectionPermissionsGraphWithResponse(ctx context.Context, body ReplaceCollectionPermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error)
+
+	// GetCollectionWithResponse request
+	GetCollectionWithResponse(ctx context.Context, collectionId string, reqEditors ...RequestEditorFn) (*GetCollectionResponse, error)
+
+	// UpdateCollectionWithBodyWithResponse request with any body
+	UpdateCollectionWithBodyWithResponse(ctx context.Context, collectionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error)
+
+	UpdateCollectionWithResponse(ctx context.Context, collectionId string, body UpdateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error)
+
+	// ListCollectionItemsWithResponse request
+	ListCollectionItemsWithResponse(ctx context.Context, collectionId string, params *ListCollectionItemsParams, reqEditors ...RequestEditorFn) (*ListCollectionItemsResponse, error)
+
+	// CreateDashboardWithBodyWithResponse request with any body
+	CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error)
+
+	CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error)
+
+	// DeleteDashboardWithResponse request
+	DeleteDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*DeleteDashboardResponse, error)
+
+	// GetDashboardWithResponse request
+	GetDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error)
+
+	// UpdateDashboardWithBodyWithResponse request with any body
+	UpdateDashboardWithBodyWithResponse(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error)
+
+	UpdateDashboardWithResponse(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error)
+
+	// CopyDashboardWithBodyWithResponse request with any body
+	CopyDashboardWithBodyWithResponse(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CopyDashboardResponse, error)
+
+	CopyDashboardWithResponse(ctx context.Context, dashboardId int, body CopyDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CopyDashboardResponse, error)
+
+	// DeleteDashboardPublicLinkWithResponse request
+	DeleteDashboardPublicLinkWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*DeleteDashboardPublicLinkResponse, error)
+
+	// CreateDashboardPublicLinkWithResponse request
+	CreateDashboardPublicLinkWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*CreateDashboardPublicLinkResponse, error)
+
+	// ListDatabasesWithResponse request
+	ListDatabasesWithResponse(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error)
+
+	// CreateDatabaseWithBodyWithResponse request with any body
+	CreateDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+
+	CreateDatabaseWithResponse(ctx context.Context, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+
+	// DeleteDatabaseWithResponse request
+	DeleteDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error)
+
+	// GetDatabaseWithResponse request
+	GetDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error)
+
+	// UpdateDatabaseWithBodyWithResponse request with any body
+	UpdateDatabaseWithBodyWithResponse(ctx context.Context, databaseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+
+	UpdateDatabaseWithResponse(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+
+	// ListImpersonationsWithResponse request
+	ListImpersonationsWithResponse(ctx context.Context, params *ListImpersonationsParams, reqEditors ...RequestEditorFn) (*ListImpersonationsResponse, error)
+
+	// DeleteImpersonationWithResponse request
+	DeleteImpersonationWithResponse(ctx context.Context, impersonationId int, reqEditors ...RequestEditorFn) (*DeleteImpersonationResponse, error)
+
+	// GetContentTranslationCsvWithResponse request
+	GetContentTranslationCsvWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetContentTranslationCsvResponse, error)
+
+	// GetContentTranslationDictionaryWithResponse request
+	GetContentTranslationDictionaryWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetContentTranslationDictionaryResponse, error)
+
+	// UploadContentTranslationDictionaryWithBodyWithResponse request with any body
+	UploadContentTranslationDictionaryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadContentTranslationDictionaryResponse, error)
+
+	// GetFieldWithResponse request
+	GetFieldWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*GetFieldResponse, error)
+
+	// UpdateFieldWithBodyWithResponse request with any body
+	UpdateFieldWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error)
+
+	UpdateFieldWithResponse(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error)
+
+	// CreateSandboxWithBodyWithResponse request with any body
+	CreateSandboxWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSandboxResponse, error)
+
+	CreateSandboxWithResponse(ctx context.Context, body CreateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSandboxResponse, error)
+
+	// DeleteSandboxWithResponse request
+	DeleteSandboxWithResponse(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*DeleteSandboxResponse, error)
+
+	// GetSandboxWithResponse request
+	GetSandboxWithResponse(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*GetSandboxResponse, error)
+
+	// UpdateSandboxWithBodyWithResponse request with any body
+	UpdateSandboxWithBodyWithResponse(ctx context.Context, sandboxId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSandboxResponse, error)
+
+	UpdateSandboxWithResponse(ctx context.Context, sandboxId int, body UpdateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSandboxResponse, error)
+
+	// GetPermissionsGraphWithResponse request
+	GetPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPermissionsGraphResponse, error)
+
+	// ReplacePermissionsGraphWithBodyWithResponse request with any body
+	ReplacePermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error)
+
+	ReplacePermissionsGraphWithResponse(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error)
+
+	// ListPermissionsGroupsWithResponse request
+	ListPermissionsGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPermissionsGroupsResponse, error)
+
+	// CreatePermissionsGroupWithBodyWithResponse request with any body
+	CreatePermissionsGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error)
+
+	CreatePermissionsGroupWithResponse(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error)
+
+	// DeletePermissionsGroupWithResponse request
+	DeletePermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*DeletePermissionsGroupResponse, error)
+
+	// GetPermissionsGroupWithResponse request
+	GetPermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*GetPermissionsGroupResponse, error)
+
+	// UpdatePermissionsGroupWithBodyWithResponse request with any body
+	UpdatePermissionsGroupWithBodyWithResponse(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error)
+
+	UpdatePermissionsGroupWithResponse(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error)
+
+	// CreatePermissionsMembershipWithBodyWithResponse request with any body
+	CreatePermissionsMembershipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsMembershipResponse, error)
+
+	CreatePermissionsMembershipWithResponse(ctx context.Context, body CreatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsMembershipResponse, error)
+
+	// DeletePermissionsMembershipWithResponse request
+	DeletePermissionsMembershipWithResponse(ctx context.Context, membershipId int, reqEditors ...RequestEditorFn) (*DeletePermissionsMembershipResponse, error)
+
+	// UpdatePermissionsMembershipWithBodyWithResponse request with any body
+	UpdatePermissionsMembershipWithBodyWithResponse(ctx context.Context, membershipId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePermissionsMembershipResponse, error)
+
+	UpdatePermissionsMembershipWithResponse(ctx context.Context, membershipId int, body UpdatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePermissionsMembershipResponse, error)
+
+	// CreatePulseWithBodyWithResponse request with any body
+	CreatePulseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePulseResponse, error)
+
+	CreatePulseWithResponse(ctx context.Context, body CreatePulseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePulseResponse, error)
+
+	// GetPulseWithResponse request
+	GetPulseWithResponse(ctx context.Context, pulseId int, reqEditors ...RequestEditorFn) (*GetPulseResponse, error)
+
+	// UpdatePulseWithBodyWithResponse request with any body
+	UpdatePulseWithBodyWithResponse(ctx context.Context, pulseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePulseResponse, error)
+
+	UpdatePulseWithResponse(ctx context.Context, pulseId int, body UpdatePulseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePulseResponse, error)
+
+	// ListRevisionsWithResponse request
+	ListRevisionsWithResponse(ctx context.Context, params *ListRevisionsParams, reqEditors ...RequestEditorFn) (*ListRevisionsResponse, error)
+
+	// RevertRevisionWithBodyWithResponse request with any body
+	RevertRevisionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevertRevisionResponse, error)
+
+	RevertRevisionWithResponse(ctx context.Context, body RevertRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*RevertRevisionResponse, error)
+
+	// CreateSessionWithBodyWithResponse request with any body
+	CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+
+	CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+
+	// ForgotPasswordWithBodyWithResponse request with any body
+	ForgotPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ForgotPasswordResponse, error)
+
+	ForgotPasswordWithResponse(ctx context.Context, body ForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ForgotPasswordResponse, error)
+
+	// ListTablesWithResponse request
+	ListTablesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTablesResponse, error)
+
+	// UpdateTableWithBodyWithResponse request with any body
+	UpdateTableWithBodyWithResponse(ctx context.Context, tableId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error)
+
+	UpdateTableWithResponse(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error)
+
+	// GetTableMetadataWithResponse request
+	GetTableMetadataWithResponse(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*GetTableMetadataResponse, error)
+
+	// CreateTimelineWithBodyWithResponse request with any body
+	CreateTimelineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTimelineResponse, error)
+
+	CreateTimelineWithResponse(ctx context.Context, body CreateTimelineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTimelineResponse, error)
+
+	// CreateTimelineEventWithBodyWithResponse request with any body
+	CreateTimelineEventWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTimelineEventResponse, error)
+
+	CreateTimelineEventWithResponse(ctx context.Context, body CreateTimelineEventJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTimelineEventResponse, error)
+
+	// GetTimelineEventWithResponse request
+	GetTimelineEventWithResponse(ctx context.Context, timelineEventId int, reqEditors ...RequestEditorFn) (*GetTimelineEventResponse, error)
+
+	// UpdateTimelineEventWithBodyWithResponse request with any body
+	UpdateTimelineEventWithBodyWithResponse(ctx context.Context, timelineEventId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimelineEventResponse, error)
+
+	UpdateTimelineEventWithResponse(ctx context.Context, timelineEventId int, body UpdateTimelineEventJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimelineEventResponse, error)
+
+	// GetTimelineWithResponse request
+	GetTimelineWithResponse(ctx context.Context, timelineId int, reqEditors ...RequestEditorFn) (*GetTimelineResponse, error)
+
+	// UpdateTimelineWithBodyWithResponse request with any body
+	UpdateTimelineWithBodyWithResponse(ctx context.Context, timelineId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimelineResponse, error)
+
+	UpdateTimelineWithResponse(ctx context.Context, timelineId int, body UpdateTimelineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimelineResponse, error)
+
+	// ListUsersWithResponse request
+	ListUsersWithResponse(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*ListUsersResponse, error)
+
+	// CreateUserWithBodyWithResponse request with any body
+	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	// DeleteUserWithResponse request
+	DeleteUserWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error)
+
+	// GetUserWithResponse request
+	GetUserWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
+
+	// UpdateUserWithBodyWithResponse request with any body
+	UpdateUserWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error)
+
+	UpdateUserWithResponse(ctx context.Context, userId int, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error)
+
+	// ReactivateUserWithResponse request
+	ReactivateUserWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*ReactivateUserResponse, error)
+
+	// SendUserInviteWithResponse request
+	SendUserInviteWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*SendUserInviteResponse, error)
+}
+
+type CreateActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Action
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Action
+}
+
+// Status returns HTTPResponse.Status
+func (r GetActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Action
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Alert
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Alert
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Alert
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCacheConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCacheConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCacheConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCacheConfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CacheConfigList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCacheConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCacheConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCacheConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateCacheConfigResult
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCacheConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCacheConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Card
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Card
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Card
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCardPublicLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCardPublicLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCardPublicLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCardPublicLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PublicLink
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCardPublicLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCardPublicLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCollectionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Collection
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCollectionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCollectionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCollectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Collection
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCollectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCollectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCollectionPermissionsGraphResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CollectionPermissionsGraph
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCollectionPermissionsGraphResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCollectionPermissionsGraphResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplaceCollectionPermissionsGraphResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CollectionPermissionsGraph
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplaceCollectionPermissionsGraphResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplaceCollectionPermissionsGraphResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCollectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Collection
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCollectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCollectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCollectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Collection
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCollectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCollectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCollectionItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CollectionItemList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCollectionItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCollectionItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CopyDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r CopyDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CopyDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDashboardPublicLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDashboardPublicLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDashboardPublicLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDashboardPublicLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PublicLink
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDashboardPublicLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDashboardPublicLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Database
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Database
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Database
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListImpersonationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ConnectionImpersonation
+}
+
+// Status returns HTTPResponse.Status
+func (r ListImpersonationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListImpersonationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteImpersonationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteImpersonationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteImpersonationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetContentTranslationCsvResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetContentTranslationCsvResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetContentTranslationCsvResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetContentTranslationDictionaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetContentTranslationDictionaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetContentTranslationDictionaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadContentTranslationDictionaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message           *string `json:"message,omitempty"`
+		Success           *bool   `json:"success,omitempty"`
+		TranslationsCount *int    `json:"translations_count,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadContentTranslationDictionaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadContentTranslationDictionaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Field
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Field
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSandboxResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Sandbox
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSandboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSandboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSandboxResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSandboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSandboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSandboxResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Sandbox
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSandboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSandboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSandboxResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Sandbox
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSandboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSandboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPermissionsGraphResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionsGraph
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPermissionsGraphResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPermissionsGraphResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplacePermissionsGraphResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionsGraph
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplacePermissionsGraphResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplacePermissionsGraphResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPermissionsGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PermissionsGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPermissionsGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPermissionsGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePermissionsGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionsGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePermissionsGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePermissionsGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePermissionsGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePermissionsGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePermissionsGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPermissionsGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionsGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPermissionsGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPermissionsGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePermissionsGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionsGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePermissionsGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePermissionsGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePermissionsMembershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PermissionsGroupMember
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePermissionsMembershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePermissionsMembershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePermissionsMembershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePermissionsMembershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePermissionsMembershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePermissionsMembershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionsMembership
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePermissionsMembershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePermissionsMembershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePulseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Pulse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePulseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePulseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPulseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Pulse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPulseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPulseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePulseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Pulse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePulseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePulseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Revision
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevertRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Revision
+}
+
+// Status returns HTTPResponse.Status
+func (r RevertRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevertRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Session
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ForgotPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ForgotPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ForgotPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTablesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Table
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTablesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTablesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTableResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Table
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTableMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TableMetadata
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTableMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTableMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTimelineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Timeline
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTimelineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTimelineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTimelineEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimelineEvent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTimelineEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTimelineEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTimelineEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimelineEvent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTimelineEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTimelineEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTimelineEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimelineEvent
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTimelineEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTimelineEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTimelineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Timeline
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTimelineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTimelineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTimelineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Timeline
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTimelineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTimelineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReactivateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r ReactivateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReactivateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SendUserInviteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SendUserInviteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SendUserInviteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CreateActionWithBodyWithResponse request with arbitrary body returning *CreateActionResponse
+func (c *ClientWithResponses) CreateActionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateActionResponse, error) {
+	rsp, err := c.CreateActionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateActionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateActionWithResponse(ctx context.Context, body CreateActionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateActionResponse, error) {
+	rsp, err := c.CreateAction(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateActionResponse(rsp)
+}
+
+// DeleteActionWithResponse request returning *DeleteActionResponse
+func (c *ClientWithResponses) DeleteActionWithResponse(ctx context.Context, actionId int, reqEditors ...RequestEditorFn) (*DeleteActionResponse, error) {
+	rsp, err := c.DeleteAction(ctx, actionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteActionResponse(rsp)
+}
+
+// GetActionWithResponse request returning *GetActionResponse
+func (c *ClientWithResponses) GetActionWithResponse(ctx context.Context, actionId int, reqEditors ...RequestEditorFn) (*GetActionResponse, error) {
+	rsp, err := c.GetAction(ctx, actionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetActionResponse(rsp)
+}
+
+// UpdateActionWithBodyWithResponse request with arbitrary body returning *UpdateActionResponse
+func (c *ClientWithResponses) UpdateActionWithBodyWithResponse(ctx context.Context, actionId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateActionResponse, error) {
+	rsp, err := c.UpdateActionWithBody(ctx, actionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateActionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateActionWithResponse(ctx context.Context, actionId int, body UpdateActionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateActionResponse, error) {
+	rsp, err := c.UpdateAction(ctx, actionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateActionResponse(rsp)
+}
+
+// CreateAlertWithBodyWithResponse request with arbitrary body returning *CreateAlertResponse
+func (c *ClientWithResponses) CreateAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error) {
+	rsp, err := c.CreateAlertWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAlertWithResponse(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error) {
+	rsp, err := c.CreateAlert(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertResponse(rsp)
+}
+
+// GetAlertWithResponse request returning *GetAlertResponse
+func (c *ClientWithResponses) GetAlertWithResponse(ctx context.Context, alertId int, reqEditors ...RequestEditorFn) (*GetAlertResponse, error) {
+	rsp, err := c.GetAlert(ctx, alertId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertResponse(rsp)
+}
+
+// UpdateAlertWithBodyWithResponse request with arbitrary body returning *UpdateAlertResponse
+func (c *ClientWithResponses) UpdateAlertWithBodyWithResponse(ctx context.Context, alertId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertResponse, error) {
+	rsp, err := c.UpdateAlertWithBody(ctx, alertId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAlertWithResponse(ctx context.Context, alertId int, body UpdateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertResponse, error) {
+	rsp, err := c.UpdateAlert(ctx, alertId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertResponse(rsp)
+}
+
+// DeleteCacheConfigWithBodyWithResponse request with arbitrary body returning *DeleteCacheConfigResponse
+func (c *ClientWithResponses) DeleteCacheConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteCacheConfigResponse, error) {
+	rsp, err := c.DeleteCacheConfigWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCacheConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteCacheConfigWithResponse(ctx context.Context, body DeleteCacheConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteCacheConfigResponse, error) {
+	rsp, err := c.DeleteCacheConfig(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCacheConfigResponse(rsp)
+}
+
+// ListCacheConfigsWithResponse request returning *ListCacheConfigsResponse
+func (c *ClientWithResponses) ListCacheConfigsWithResponse(ctx context.Context, params *ListCacheConfigsParams, reqEditors ...RequestEditorFn) (*ListCacheConfigsResponse, error) {
+	rsp, err := c.ListCacheConfigs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCacheConfigsResponse(rsp)
+}
+
+// UpdateCacheConfigWithBodyWithResponse request with arbitrary body returning *UpdateCacheConfigResponse
+func (c *ClientWithResponses) UpdateCacheConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCacheConfigResponse, error) {
+	rsp, err := c.UpdateCacheConfigWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCacheConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCacheConfigWithResponse(ctx context.Context, body UpdateCacheConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCacheConfigResponse, error) {
+	rsp, err := c.UpdateCacheConfig(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCacheConfigResponse(rsp)
+}
+
+// CreateCardWithBodyWithResponse request with arbitrary body returning *CreateCardResponse
+func (c *ClientWithResponses) CreateCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
+	rsp, err := c.CreateCardWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCardWithResponse(ctx context.Context, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
+	rsp, err := c.CreateCard(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCardResponse(rsp)
+}
+
+// GetCardWithResponse request returning *GetCardResponse
+func (c *ClientWithResponses) GetCardWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardResponse, error) {
+	rsp, err := c.GetCard(ctx, cardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCardResponse(rsp)
+}
+
+// UpdateCardWithBodyWithResponse request with arbitrary body returning *UpdateCardResponse
+func (c *ClientWithResponses) UpdateCardWithBodyWithResponse(ctx context.Context, cardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error) {
+	rsp, err := c.UpdateCardWithBody(ctx, cardId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCardWithResponse(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error) {
+	rsp, err := c.UpdateCard(ctx, cardId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCardResponse(rsp)
+}
+
+// DeleteCardPublicLinkWithResponse request returning *DeleteCardPublicLinkResponse
+func (c *ClientWithResponses) DeleteCardPublicLinkWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*DeleteCardPublicLinkResponse, error) {
+	rsp, err := c.DeleteCardPublicLink(ctx, cardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCardPublicLinkResponse(rsp)
+}
+
+// CreateCardPublicLinkWithResponse request returning *CreateCardPublicLinkResponse
+func (c *ClientWithResponses) CreateCardPublicLinkWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*CreateCardPublicLinkResponse, error) {
+	rsp, err := c.CreateCardPublicLink(ctx, cardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCardPublicLinkResponse(rsp)
+}
+
+// ListCollectionsWithResponse request returning *ListCollectionsResponse
+func (c *ClientWithResponses) ListCollectionsWithResponse(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*ListCollectionsResponse, error) {
+	rsp, err := c.ListCollections(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCollectionsResponse(rsp)
+}
+
+// CreateCollectionWithBodyWithResponse request with arbitrary body returning *CreateCollectionResponse
+func (c *ClientWithResponses) CreateCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error) {
+	rsp, err := c.CreateCollectionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCollectionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCollectionWithResponse(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error) {
+	rsp, err := c.CreateCollection(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCollectionResponse(rsp)
+}
+
+// GetCollectionPermissionsGraphWithResponse request returning *GetCollectionPermissionsGraphResponse
+func (c *ClientWithResponses) GetCollectionPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCollectionPermissionsGraphResponse, error) {
+	rsp, err := c.GetCollectionPermissionsGraph(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCollectionPermissionsGraphResponse(rsp)
+}
+
+// ReplaceCollectionPermissionsGraphWithBodyWithResponse request with arbitrary body returning *ReplaceCollectionPermissionsGraphResponse
+func (c *ClientWithResponses) ReplaceCollectionPermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error) {
+	rsp, err := c.ReplaceCollectionPermissionsGraphWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceCollectionPermissionsGraphResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReplaceCollectionPermissionsGraphWithResponse(ctx context.Context, body ReplaceCollectionPermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error) {
+	rsp, err := c.ReplaceCollectionPermissionsGraph(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceCollectionPermissionsGraphResponse(rsp)
+}
+
+// GetCollectionWithResponse request returning *GetCollectionResponse
+func (c *ClientWithResponses) GetCollectionWithResponse(ctx context.Context, collectionId string, reqEditors ...RequestEditorFn) (*GetCollectionResponse, error) {
+	rsp, err := c.GetCollection(ctx, collectionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCollectionResponse(rsp)
+}
+
+// UpdateCollectionWithBodyWithResponse request with arbitrary body returning *UpdateCollectionResponse
+func (c *ClientWithResponses) UpdateCollectionWithBodyWithResponse(ctx context.Context, collectionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error) {
+	rsp, err := c.UpdateCollectionWithBody(ctx, collectionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCollectionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCollectionWithResponse(ctx context.Context, collectionId string, body UpdateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error) {
+	rsp, err := c.UpdateCollection(ctx, collectionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCollectionResponse(rsp)
+}
+
+// ListCollectionItemsWithResponse request returning *ListCollectionItemsResponse
+func (c *ClientWithResponses) ListCollectionItemsWithResponse(ctx context.Context, collectionId string, params *ListCollectionItemsParams, reqEditors ...RequestEditorFn) (*ListCollectionItemsResponse, error) {
+	rsp, err := c.ListCollectionItems(ctx, collectionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCollectionItemsResponse(rsp)
+}
+
+// CreateDashboardWithBodyWithResponse request with arbitrary body returning *CreateDashboardResponse
+func (c *ClientWithResponses) CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error) {
+	rsp, err := c.CreateDashboardWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDashboardResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error) {
+	rsp, err := c.CreateDashboard(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDashboardResponse(rsp)
+}
+
+// DeleteDashboardWithResponse request returning *DeleteDashboardResponse
+func (c *ClientWithResponses) DeleteDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*DeleteDashboardResponse, error) {
+	rsp, err := c.DeleteDashboard(ctx, dashboardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDashboardResponse(rsp)
+}
+
+// GetDashboardWithResponse request returning *GetDashboardResponse
+func (c *ClientWithResponses) GetDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error) {
+	rsp, err := c.GetDashboard(ctx, dashboardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardResponse(rsp)
+}
+
+// UpdateDashboardWithBodyWithResponse request with arbitrary body returning *UpdateDashboardResponse
+func (c *ClientWithResponses) UpdateDashboardWithBodyWithResponse(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error) {
+	rsp, err := c.UpdateDashboardWithBody(ctx, dashboardId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDashboardResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDashboardWithResponse(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error) {
+	rsp, err := c.UpdateDashboard(ctx, dashboardId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDashboardResponse(rsp)
+}
+
+// CopyDashboardWithBodyWithResponse request with arbitrary body returning *CopyDashboardResponse
+func (c *ClientWithResponses) CopyDashboardWithBodyWithResponse(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CopyDashboardResponse, error) {
+	rsp, err := c.CopyDashboardWithBody(ctx, dashboardId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCopyDashboardResponse(rsp)
+}
+
+func (c *ClientWithResponses) CopyDashboardWithResponse(ctx context.Context, dashboardId int, body CopyDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CopyDashboardResponse, error) {
+	rsp, err := c.CopyDashboard(ctx, dashboardId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCopyDashboardResponse(rsp)
+}
+
+// DeleteDashboardPublicLinkWithResponse request returning *DeleteDashboardPublicLinkResponse
+func (c *ClientWithResponses) DeleteDashboardPublicLinkWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*DeleteDashboardPublicLinkResponse, error) {
+	rsp, err := c.DeleteDashboardPublicLink(ctx, dashboardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDashboardPublicLinkResponse(rsp)
+}
+
+// CreateDashboardPublicLinkWithResponse request returning *CreateDashboardPublicLinkResponse
+func (c *ClientWithResponses) CreateDashboardPublicLinkWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*CreateDashboardPublicLinkResponse, error) {
+	rsp, err := c.CreateDashboardPublicLink(ctx, dashboardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDashboardPublicLinkResponse(rsp)
+}
+
+// ListDatabasesWithResponse request returning *ListDatabasesResponse
+func (c *ClientWithResponses) ListDatabasesWithResponse(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error) {
+	rsp, err := c.ListDatabases(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabasesResponse(rsp)
+}
+
+// CreateDatabaseWithBodyWithResponse request with arbitrary body returning *CreateDatabaseResponse
+func (c *ClientWithResponses) CreateDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
+	rsp, err := c.CreateDatabaseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseResponse(rsp)
+}
 
-	CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+func (c *ClientWithResponses) CreateDatabaseWithResponse(ctx context.Context, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
+	rsp, err := c.CreateDatabase(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseResponse(rsp)
+}
 
-	// ListTablesWithResponse request
-	ListTablesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTablesResponse, error)
+// DeleteDatabaseWithResponse request returning *DeleteDatabaseResponse
+func (c *ClientWithResponses) DeleteDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error) {
+	rsp, err := c.DeleteDatabase(ctx, databaseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseResponse(rsp)
+}
 
-	// UpdateTableWithBodyWithResponse request with any body
-	UpdateTableWithBodyWithResponse(ctx context.Context, tableId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error)
+// GetDatabaseWithResponse request returning *GetDatabaseResponse
+func (c *ClientWithResponses) GetDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error) {
+	rsp, err := c.GetDatabase(ctx, databaseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseResponse(rsp)
+}
 
-	UpdateTableWithResponse(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error)
+// UpdateDatabaseWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseResponse
+func (c *ClientWithResponses) UpdateDatabaseWithBodyWithResponse(ctx context.Context, databaseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
+	rsp, err := c.UpdateDatabaseWithBody(ctx, databaseId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseResponse(rsp)
+}
 
-	// GetTableMetadataWithResponse request
-	GetTableMetadataWithResponse(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*GetTableMetadataResponse, error)
+func (c *ClientWithResponses) UpdateDatabaseWithResponse(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
+	rsp, err := c.UpdateDatabase(ctx, databaseId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseResponse(rsp)
+}
 
-	// ListUsersWithResponse request
-	ListUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUsersResponse, error)
+// ListImpersonationsWithResponse request returning *ListImpersonationsResponse
+func (c *ClientWithResponses) ListImpersonationsWithResponse(ctx context.Context, params *ListImpersonationsParams, reqEditors ...RequestEditorFn) (*ListImpersonationsResponse, error) {
+	rsp, err := c.ListImpersonations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListImpersonationsResponse(rsp)
+}
 
-	// CreateUserWithBodyWithResponse request with any body
-	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+// DeleteImpersonationWithResponse request returning *DeleteImpersonationResponse
+func (c *ClientWithResponses) DeleteImpersonationWithResponse(ctx context.Context, impersonationId int, reqEditors ...RequestEditorFn) (*DeleteImpersonationResponse, error) {
+	rsp, err := c.DeleteImpersonation(ctx, impersonationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteImpersonationResponse(rsp)
+}
 
-	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+// GetContentTranslationCsvWithResponse request returning *GetContentTranslationCsvResponse
+func (c *ClientWithResponses) GetContentTranslationCsvWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetContentTranslationCsvResponse, error) {
+	rsp, err := c.GetContentTranslationCsv(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetContentTranslationCsvResponse(rsp)
+}
 
-	// DeleteUserWithResponse request
-	DeleteUserWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error)
+// GetContentTranslationDictionaryWithResponse request returning *GetContentTranslationDictionaryResponse
+func (c *ClientWithResponses) GetContentTranslationDictionaryWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetContentTranslationDictionaryResponse, error) {
+	rsp, err := c.GetContentTranslationDictionary(ctx, token, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetContentTranslationDictionaryResponse(rsp)
+}
 
-	// GetUserWithResponse request
-	GetUserWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
+// UploadContentTranslationDictionaryWithBodyWithResponse request with arbitrary body returning *UploadContentTranslationDictionaryResponse
+func (c *ClientWithResponses) UploadContentTranslationDictionaryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadContentTranslationDictionaryResponse, error) {
+	rsp, err := c.UploadContentTranslationDictionaryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadContentTranslationDictionaryResponse(rsp)
+}
 
-	// UpdateUserWithBodyWithResponse request with any body
-	UpdateUserWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error)
+// GetFieldWithResponse request returning *GetFieldResponse
+func (c *ClientWithResponses) GetFieldWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*GetFieldResponse, error) {
+	rsp, err := c.GetField(ctx, fieldId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFieldResponse(rsp)
+}
 
-	UpdateUserWithResponse(ctx context.Context, userId int, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error)
+// UpdateFieldWithBodyWithResponse request with arbitrary body returning *UpdateFieldResponse
+func (c *ClientWithResponses) UpdateFieldWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error) {
+	rsp, err := c.UpdateFieldWithBody(ctx, fieldId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFieldResponse(rsp)
 }
 
-type CreateCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Card
+func (c *ClientWithResponses) UpdateFieldWithResponse(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error) {
+	rsp, err := c.UpdateField(ctx, fieldId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFieldResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CreateSandboxWithBodyWithResponse request with arbitrary body returning *CreateSandboxResponse
+func (c *ClientWithResponses) CreateSandboxWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSandboxResponse, error) {
+	rsp, err := c.CreateSandboxWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateSandboxResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) CreateSandboxWithResponse(ctx context.Context, body CreateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSandboxResponse, error) {
+	rsp, err := c.CreateSandbox(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateSandboxResponse(rsp)
 }
 
-type GetCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Card
+// DeleteSandboxWithResponse request returning *DeleteSandboxResponse
+func (c *ClientWithResponses) DeleteSandboxWithResponse(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*DeleteSandboxResponse, error) {
+	rsp, err := c.DeleteSandbox(ctx, sandboxId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSandboxResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetSandboxWithResponse request returning *GetSandboxResponse
+func (c *ClientWithResponses) GetSandboxWithResponse(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*GetSandboxResponse, error) {
+	rsp, err := c.GetSandbox(ctx, sandboxId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetSandboxResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateSandboxWithBodyWithResponse request with arbitrary body returning *UpdateSandboxResponse
+func (c *ClientWithResponses) UpdateSandboxWithBodyWithResponse(ctx context.Context, sandboxId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSandboxResponse, error) {
+	rsp, err := c.UpdateSandboxWithBody(ctx, sandboxId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateSandboxResponse(rsp)
 }
 
-type UpdateCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Card
+func (c *ClientWithResponses) UpdateSandboxWithResponse(ctx context.Context, sandboxId int, body UpdateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSandboxResponse, error) {
+	rsp, err := c.UpdateSandbox(ctx, sandboxId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSandboxResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetPermissionsGraphWithResponse request returning *GetPermissionsGraphResponse
+func (c *ClientWithResponses) GetPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPermissionsGraphResponse, error) {
+	rsp, err := c.GetPermissionsGraph(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetPermissionsGraphResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ReplacePermissionsGraphWithBodyWithResponse request with arbitrary body returning *ReplacePermissionsGraphResponse
+func (c *ClientWithResponses) ReplacePermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error) {
+	rsp, err := c.ReplacePermissionsGraphWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseReplacePermissionsGraphResponse(rsp)
 }
 
-type ListCollectionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Collection
+func (c *ClientWithResponses) ReplacePermissionsGraphWithResponse(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error) {
+	rsp, err := c.ReplacePermissionsGraph(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplacePermissionsGraphResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListCollectionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ListPermissionsGroupsWithResponse request returning *ListPermissionsGroupsResponse
+func (c *ClientWithResponses) ListPermissionsGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPermissionsGroupsResponse, error) {
+	rsp, err := c.ListPermissionsGroups(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseListPermissionsGroupsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCollectionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreatePermissionsGroupWithBodyWithResponse request with arbitrary body returning *CreatePermissionsGroupResponse
+func (c *ClientWithResponses) CreatePermissionsGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error) {
+	rsp, err := c.CreatePermissionsGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreatePermissionsGroupResponse(rsp)
 }
 
-type CreateCollectionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Collection
+func (c *ClientWithResponses) CreatePermissionsGroupWithResponse(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error) {
+	rsp, err := c.CreatePermissionsGroup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePermissionsGroupResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateCollectionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeletePermissionsGroupWithResponse request returning *DeletePermissionsGroupResponse
+func (c *ClientWithResponses) DeletePermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*DeletePermissionsGroupResponse, error) {
+	rsp, err := c.DeletePermissionsGroup(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeletePermissionsGroupResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCollectionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetPermissionsGroupWithResponse request returning *GetPermissionsGroupResponse
+func (c *ClientWithResponses) GetPermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*GetPermissionsGroupResponse, error) {
+	rsp, err := c.GetPermissionsGroup(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetPermissionsGroupResponse(rsp)
 }
 
-type GetCollectionPermissionsGraphResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CollectionPermissionsGraph
+// UpdatePermissionsGroupWithBodyWithResponse request with arbitrary body returning *UpdatePermissionsGroupResponse
+func (c *ClientWithResponses) UpdatePermissionsGroupWithBodyWithResponse(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error) {
+	rsp, err := c.UpdatePermissionsGroupWithBody(ctx, groupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePermissionsGroupResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetCollectionPermissionsGraphResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdatePermissionsGroupWithResponse(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error) {
+	rsp, err := c.UpdatePermissionsGroup(ctx, groupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdatePermissionsGroupResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCollectionPermissionsGraphResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreatePermissionsMembershipWithBodyWithResponse request with arbitrary body returning *CreatePermissionsMembershipResponse
+func (c *ClientWithResponses) CreatePermissionsMembershipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsMembershipResponse, error) {
+	rsp, err := c.CreatePermissionsMembershipWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreatePermissionsMembershipResponse(rsp)
 }
 
-type ReplaceCollectionPermissionsGraphResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CollectionPermissionsGraph
+func (c *ClientWithResponses) CreatePermissionsMembershipWithResponse(ctx context.Context, body CreatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsMembershipResponse, error) {
+	rsp, err := c.CreatePermissionsMembership(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePermissionsMembershipResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ReplaceCollectionPermissionsGraphResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeletePermissionsMembershipWithResponse request returning *DeletePermissionsMembershipResponse
+func (c *ClientWithResponses) DeletePermissionsMembershipWithResponse(ctx context.Context, membershipId int, reqEditors ...RequestEditorFn) (*DeletePermissionsMembershipResponse, error) {
+	rsp, err := c.DeletePermissionsMembership(ctx, membershipId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeletePermissionsMembershipResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReplaceCollectionPermissionsGraphResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdatePermissionsMembershipWithBodyWithResponse request with arbitrary body returning *UpdatePermissionsMembershipResponse
+func (c *ClientWithResponses) UpdatePermissionsMembershipWithBodyWithResponse(ctx context.Context, membershipId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePermissionsMembershipResponse, error) {
+	rsp, err := c.UpdatePermissionsMembershipWithBody(ctx, membershipId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdatePermissionsMembershipResponse(rsp)
 }
 
-type GetCollectionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Collection
+func (c *ClientWithResponses) UpdatePermissionsMembershipWithResponse(ctx context.Context, membershipId int, body UpdatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePermissionsMembershipResponse, error) {
+	rsp, err := c.UpdatePermissionsMembership(ctx, membershipId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePermissionsMembershipResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetCollectionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CreatePulseWithBodyWithResponse request with arbitrary body returning *CreatePulseResponse
+func (c *ClientWithResponses) CreatePulseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePulseResponse, error) {
+	rsp, err := c.CreatePulseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreatePulseResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCollectionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) CreatePulseWithResponse(ctx context.Context, body CreatePulseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePulseResponse, error) {
+	rsp, err := c.CreatePulse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreatePulseResponse(rsp)
 }
 
-type UpdateCollectionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Collection
+// GetPulseWithResponse request returning *GetPulseResponse
+func (c *ClientWithResponses) GetPulseWithResponse(ctx context.Context, pulseId int, reqEditors ...RequestEditorFn) (*GetPulseResponse, error) {
+	rsp, err := c.GetPulse(ctx, pulseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPulseResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateCollectionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdatePulseWithBodyWithResponse request with arbitrary body returning *UpdatePulseResponse
+func (c *ClientWithResponses) UpdatePulseWithBodyWithResponse(ctx context.Context, pulseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePulseResponse, error) {
+	rsp, err := c.UpdatePulseWithBody(ctx, pulseId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdatePulseResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCollectionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdatePulseWithResponse(ctx context.Context, pulseId int, body UpdatePulseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePulseResponse, error) {
+	rsp, err := c.UpdatePulse(ctx, pulseId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdatePulseResponse(rsp)
 }
 
-type ListCollectionItemsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CollectionItemList
+// ListRevisionsWithResponse request returning *ListRevisionsResponse
+func (c *ClientWithResponses) ListRevisionsWithResponse(ctx context.Context, params *ListRevisionsParams, reqEditors ...RequestEditorFn) (*ListRevisionsResponse, error) {
+	rsp, err := c.ListRevisions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRevisionsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListCollectionItemsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// RevertRevisionWithBodyWithResponse request with arbitrary body returning *RevertRevisionResponse
+func (c *ClientWithResponses) RevertRevisionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevertRevisionResponse, error) {
+	rsp, err := c.RevertRevisionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseRevertRevisionResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCollectionItemsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) RevertRevisionWithResponse(ctx context.Context, body RevertRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*RevertRevisionResponse, error) {
+	rsp, err := c.RevertRevision(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseRevertRevisionResponse(rsp)
 }
 
-type CreateDashboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Dashboard
+// CreateSessionWithBodyWithResponse request with arbitrary body returning *CreateSessionResponse
+func (c *ClientWithResponses) CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
+	rsp, err := c.CreateSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSessionResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateDashboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
+	rsp, err := c.CreateSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateSessionResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDashboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ForgotPasswordWithBodyWithResponse request with arbitrary body returning *ForgotPasswordResponse
+func (c *ClientWithResponses) ForgotPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ForgotPasswordResponse, error) {
+	rsp, err := c.ForgotPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseForgotPasswordResponse(rsp)
 }
 
-type DeleteDashboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) ForgotPasswordWithResponse(ctx context.Context, body ForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ForgotPasswordResponse, error) {
+	rsp, err := c.ForgotPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseForgotPasswordResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteDashboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ListTablesWithResponse request returning *ListTablesResponse
+func (c *ClientWithResponses) ListTablesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTablesResponse, error) {
+	rsp, err := c.ListTables(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseListTablesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDashboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateTableWithBodyWithResponse request with arbitrary body returning *UpdateTableResponse
+func (c *ClientWithResponses) UpdateTableWithBodyWithResponse(ctx context.Context, tableId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error) {
+	rsp, err := c.UpdateTableWithBody(ctx, tableId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateTableResponse(rsp)
 }
 
-type GetDashboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Dashboard
+func (c *ClientWithResponses) UpdateTableWithResponse(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error) {
+	rsp, err := c.UpdateTable(ctx, tableId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTableResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDashboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetTableMetadataWithResponse request returning *GetTableMetadataResponse
+func (c *ClientWithResponses) GetTableMetadataWithResponse(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*GetTableMetadataResponse, error) {
+	rsp, err := c.GetTableMetadata(ctx, tableId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetTableMetadataResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDashboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateTimelineWithBodyWithResponse request with arbitrary body returning *CreateTimelineResponse
+func (c *ClientWithResponses) CreateTimelineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTimelineResponse, error) {
+	rsp, err := c.CreateTimelineWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateTimelineResponse(rsp)
 }
 
-type UpdateDashboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Dashboard
+func (c *ClientWithResponses) CreateTimelineWithResponse(ctx context.Context, body CreateTimelineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTimelineResponse, error) {
+	rsp, err := c.CreateTimeline(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTimelineResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateDashboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CreateTimelineEventWithBodyWithResponse request with arbitrary body returning *CreateTimelineEventResponse
+func (c *ClientWithResponses) CreateTimelineEventWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTimelineEventResponse, error) {
+	rsp, err := c.CreateTimelineEventWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateTimelineEventResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDashboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) CreateTimelineEventWithResponse(ctx context.Context, body CreateTimelineEventJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTimelineEventResponse, error) {
+	rsp, err := c.CreateTimelineEvent(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateTimelineEventResponse(rsp)
 }
 
-type ListDatabasesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseList
+// GetTimelineEventWithResponse request returning *GetTimelineEventResponse
+func (c *ClientWithResponses) GetTimelineEventWithResponse(ctx context.Context, timelineEventId int, reqEditors ...RequestEditorFn) (*GetTimelineEventResponse, error) {
+	rsp, err := c.GetTimelineEvent(ctx, timelineEventId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTimelineEventResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListDatabasesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateTimelineEventWithBodyWithResponse request with arbitrary body returning *UpdateTimelineEventResponse
+func (c *ClientWithResponses) UpdateTimelineEventWithBodyWithResponse(ctx context.Context, timelineEventId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimelineEventResponse, error) {
+	rsp, err := c.UpdateTimelineEventWithBody(ctx, timelineEventId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateTimelineEventResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabasesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateTimelineEventWithResponse(ctx context.Context, timelineEventId int, body UpdateTimelineEventJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimelineEventResponse, error) {
+	rsp, err := c.UpdateTimelineEvent(ctx, timelineEventId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateTimelineEventResponse(rsp)
 }
 
-type CreateDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Database
+// GetTimelineWithResponse request returning *GetTimelineResponse
+func (c *ClientWithResponses) GetTimelineWithResponse(ctx context.Context, timelineId int, reqEditors ...RequestEditorFn) (*GetTimelineResponse, error) {
+	rsp, err := c.GetTimeline(ctx, timelineId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTimelineResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateTimelineWithBodyWithResponse request with arbitrary body returning *UpdateTimelineResponse
+func (c *ClientWithResponses) UpdateTimelineWithBodyWithResponse(ctx context.Context, timelineId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimelineResponse, error) {
+	rsp, err := c.UpdateTimelineWithBody(ctx, timelineId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateTimelineResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateTimelineWithResponse(ctx context.Context, timelineId int, body UpdateTimelineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimelineResponse, error) {
+	rsp, err := c.UpdateTimeline(ctx, timelineId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateTimelineResponse(rsp)
 }
 
-type DeleteDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// ListUsersWithResponse request returning *ListUsersResponse
+func (c *ClientWithResponses) ListUsersWithResponse(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*ListUsersResponse, error) {
+	rsp, err := c.ListUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUsersResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateUserResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateUserResponse(rsp)
 }
 
-type GetDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Database
+// DeleteUserWithResponse request returning *DeleteUserResponse
+func (c *ClientWithResponses) DeleteUserWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
+	rsp, err := c.DeleteUser(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetUserWithResponse request returning *GetUserResponse
+func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
+	rsp, err := c.GetUser(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetUserResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateUserWithBodyWithResponse request with arbitrary body returning *UpdateUserResponse
+func (c *ClientWithResponses) UpdateUserWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
+	rsp, err := c.UpdateUserWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateUserResponse(rsp)
 }
 
-type UpdateDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Database
+func (c *ClientWithResponses) UpdateUserWithResponse(ctx context.Context, userId int, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
+	rsp, err := c.UpdateUser(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ReactivateUserWithResponse request returning *ReactivateUserResponse
+func (c *ClientWithResponses) ReactivateUserWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*ReactivateUserResponse, error) {
+	rsp, err := c.ReactivateUser(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseReactivateUserResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SendUserInviteWithResponse request returning *SendUserInviteResponse
+func (c *ClientWithResponses) SendUserInviteWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*SendUserInviteResponse, error) {
+	rsp, err := c.SendUserInvite(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSendUserInviteResponse(rsp)
 }
 
-type GetContentTranslationCsvResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// ParseCreateActionResponse parses an HTTP response from a CreateActionWithResponse call
+func ParseCreateActionResponse(rsp *http.Response) (*CreateActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetContentTranslationCsvResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &CreateActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetContentTranslationCsvResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Action
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type GetContentTranslationDictionaryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetContentTranslationDictionaryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteActionResponse parses an HTTP response from a DeleteActionWithResponse call
+func ParseDeleteActionResponse(rsp *http.Response) (*DeleteActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetContentTranslationDictionaryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
+
+	return response, nil
 }
 
-type UploadContentTranslationDictionaryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Message           *string `json:"message,omitempty"`
-		Success           *bool   `json:"success,omitempty"`
-		TranslationsCount *int    `json:"translations_count,omitempty"`
+// ParseGetActionResponse parses an HTTP response from a GetActionWithResponse call
+func ParseGetActionResponse(rsp *http.Response) (*GetActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r UploadContentTranslationDictionaryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadContentTranslationDictionaryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Action
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type GetFieldResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Field
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetFieldResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateActionResponse parses an HTTP response from a UpdateActionWithResponse call
+func ParseUpdateActionResponse(rsp *http.Response) (*UpdateActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFieldResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateFieldResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Field
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Action
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateFieldResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateFieldResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseCreateAlertResponse parses an HTTP response from a CreateAlertWithResponse call
+func ParseCreateAlertResponse(rsp *http.Response) (*CreateAlertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetPermissionsGraphResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PermissionsGraph
-}
+	response := &CreateAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Alert
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetPermissionsGraphResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPermissionsGraphResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetAlertResponse parses an HTTP response from a GetAlertWithResponse call
+func ParseGetAlertResponse(rsp *http.Response) (*GetAlertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Alert
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type ReplacePermissionsGraphResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PermissionsGraph
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ReplacePermissionsGraphResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateAlertResponse parses an HTTP response from a UpdateAlertWithResponse call
+func ParseUpdateAlertResponse(rsp *http.Response) (*UpdateAlertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReplacePermissionsGraphResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreatePermissionsGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PermissionsGroup
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Alert
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CreatePermissionsGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreatePermissionsGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteCacheConfigResponse parses an HTTP response from a DeleteCacheConfigWithResponse call
+func ParseDeleteCacheConfigResponse(rsp *http.Response) (*DeleteCacheConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeletePermissionsGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	response := &DeleteCacheConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeletePermissionsGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListCacheConfigsResponse parses an HTTP response from a ListCacheConfigsWithResponse call
+func ParseListCacheConfigsResponse(rsp *http.Response) (*ListCacheConfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeletePermissionsGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListCacheConfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetPermissionsGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PermissionsGroup
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CacheConfigList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetPermissionsGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPermissionsGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type UpdatePermissionsGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PermissionsGroup
-}
+// ParseUpdateCacheConfigResponse parses an HTTP response from a UpdateCacheConfigWithResponse call
+func ParseUpdateCacheConfigResponse(rsp *http.Response) (*UpdateCacheConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdatePermissionsGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &UpdateCacheConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePermissionsGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateCacheConfigResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type CreateSessionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Session
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateSessionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateCardResponse parses an HTTP response from a CreateCardWithResponse call
+func ParseCreateCardResponse(rsp *http.Response) (*CreateCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateSessionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type ListTablesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Table
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ListTablesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListTablesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type UpdateTableResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Table
-}
+// ParseGetCardResponse parses an HTTP response from a GetCardWithResponse call
+func ParseGetCardResponse(rsp *http.Response) (*GetCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateTableResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTableResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type GetTableMetadataResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TableMetadata
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTableMetadataResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateCardResponse parses an HTTP response from a UpdateCardWithResponse call
+func ParseUpdateCardResponse(rsp *http.Response) (*UpdateCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTableMetadataResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type ListUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]User
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteCardPublicLinkResponse parses an HTTP response from a DeleteCardPublicLinkWithResponse call
+func ParseDeleteCardPublicLinkResponse(rsp *http.Response) (*DeleteCardPublicLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteCardPublicLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreateUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateCardPublicLinkResponse parses an HTTP response from a CreateCardPublicLinkWithResponse call
+func ParseCreateCardPublicLinkResponse(rsp *http.Response) (*CreateCardPublicLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateCardPublicLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
-
-// Status returns HTTPResponse.Status
-func (r DeleteUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PublicLink
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListCollectionsResponse parses an HTTP response from a ListCollectionsWithResponse call
+func ParseListCollectionsResponse(rsp *http.Response) (*ListCollectionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListCollectionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// CreateCardWithBodyWithResponse request with arbitrary body returning *CreateCardResponse
-func (c *ClientWithResponses) CreateCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
-	rsp, err := c.CreateCardWithBody(ctx, contentType, body, reqEditors...)
+// ParseCreateCollectionResponse parses an HTTP response from a CreateCollectionWithResponse call
+func ParseCreateCollectionResponse(rsp *http.Response) (*CreateCollectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCardResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateCardWithResponse(ctx context.Context, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
-	rsp, err := c.CreateCard(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateCollectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateCardResponse(rsp)
-}
 
-// GetCardWithResponse request returning *GetCardResponse
-func (c *ClientWithResponses) GetCardWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardResponse, error) {
-	rsp, err := c.GetCard(ctx, cardId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetCardResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateCardWithBodyWithResponse request with arbitrary body returning *UpdateCardResponse
-func (c *ClientWithResponses) UpdateCardWithBodyWithResponse(ctx context.Context, cardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error) {
-	rsp, err := c.UpdateCardWithBody(ctx, cardId, contentType, body, reqEditors...)
+// ParseGetCollectionPermissionsGraphResponse parses an HTTP response from a GetCollectionPermissionsGraphWithResponse call
+func ParseGetCollectionPermissionsGraphResponse(rsp *http.Response) (*GetCollectionPermissionsGraphResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateCardResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateCardWithResponse(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error) {
-	rsp, err := c.UpdateCard(ctx, cardId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetCollectionPermissionsGraphResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateCardResponse(rsp)
-}
 
-// ListCollectionsWithResponse request returning *ListCollectionsResponse
-func (c *ClientWithResponses) ListCollectionsWithResponse(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*ListCollectionsResponse, error) {
-	rsp, err := c.ListCollections(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CollectionPermissionsGraph
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseListCollectionsResponse(rsp)
+
+	return response, nil
 }
 
-// CreateCollectionWithBodyWithResponse request with arbitrary body returning *CreateCollectionResponse
-func (c *ClientWithResponses) CreateCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error) {
-	rsp, err := c.CreateCollectionWithBody(ctx, contentType, body, reqEditors...)
+// ParseReplaceCollectionPermissionsGraphResponse parses an HTTP response from a ReplaceCollectionPermissionsGraphWithResponse call
+func ParseReplaceCollectionPermissionsGraphResponse(rsp *http.Response) (*ReplaceCollectionPermissionsGraphResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCollectionResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateCollectionWithResponse(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error) {
-	rsp, err := c.CreateCollection(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ReplaceCollectionPermissionsGraphResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateCollectionResponse(rsp)
-}
 
-// GetCollectionPermissionsGraphWithResponse request returning *GetCollectionPermissionsGraphResponse
-func (c *ClientWithResponses) GetCollectionPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCollectionPermissionsGraphResponse, error) {
-	rsp, err := c.GetCollectionPermissionsGraph(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CollectionPermissionsGraph
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetCollectionPermissionsGraphResponse(rsp)
+
+	return response, nil
 }
 
-// ReplaceCollectionPermissionsGraphWithBodyWithResponse request with arbitrary body returning *ReplaceCollectionPermissionsGraphResponse
-func (c *ClientWithResponses) ReplaceCollectionPermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error) {
-	rsp, err := c.ReplaceCollectionPermissionsGraphWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetCollectionResponse parses an HTTP response from a GetCollectionWithResponse call
+func ParseGetCollectionResponse(rsp *http.Response) (*GetCollectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseReplaceCollectionPermissionsGraphResponse(rsp)
+
+	response := &GetCollectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ReplaceCollectionPermissionsGraphWithResponse(ctx context.Context, body ReplaceCollectionPermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error) {
-	rsp, err := c.ReplaceCollectionPermissionsGraph(ctx, body, reqEditors...)
+// ParseUpdateCollectionResponse parses an HTTP response from a UpdateCollectionWithResponse call
+func ParseUpdateCollectionResponse(rsp *http.Response) (*UpdateCollectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseReplaceCollectionPermissionsGraphResponse(rsp)
-}
 
-// GetCollectionWithResponse request returning *GetCollectionResponse
-func (c *ClientWithResponses) GetCollectionWithResponse(ctx context.Context, collectionId string, reqEditors ...RequestEditorFn) (*GetCollectionResponse, error) {
-	rsp, err := c.GetCollection(ctx, collectionId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateCollectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetCollectionResponse(rsp)
-}
 
-// UpdateCollectionWithBodyWithResponse request with arbitrary body returning *UpdateCollectionResponse
-func (c *ClientWithResponses) UpdateCollectionWithBodyWithResponse(ctx context.Context, collectionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error) {
-	rsp, err := c.UpdateCollectionWithBody(ctx, collectionId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateCollectionResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateCollectionWithResponse(ctx context.Context, collectionId string, body UpdateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error) {
-	rsp, err := c.UpdateCollection(ctx, collectionId, body, reqEditors...)
+// ParseListCollectionItemsResponse parses an HTTP response from a ListCollectionItemsWithResponse call
+func ParseListCollectionItemsResponse(rsp *http.Response) (*ListCollectionItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateCollectionResponse(rsp)
-}
 
-// ListCollectionItemsWithResponse request returning *ListCollectionItemsResponse
-func (c *ClientWithResponses) ListCollectionItemsWithResponse(ctx context.Context, collectionId string, params *ListCollectionItemsParams, reqEditors ...RequestEditorFn) (*ListCollectionItemsResponse, error) {
-	rsp, err := c.ListCollectionItems(ctx, collectionId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListCollectionItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListCollectionItemsResponse(rsp)
-}
 
-// CreateDashboardWithBodyWithResponse request with arbitrary body returning *CreateDashboardResponse
-func (c *ClientWithResponses) CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error) {
-	rsp, err := c.CreateDashboardWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CollectionItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateDashboardResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error) {
-	rsp, err := c.CreateDashboard(ctx, body, reqEditors...)
+// ParseCreateDashboardResponse parses an HTTP response from a CreateDashboardWithResponse call
+func ParseCreateDashboardResponse(rsp *http.Response) (*CreateDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDashboardResponse(rsp)
-}
 
-// DeleteDashboardWithResponse request returning *DeleteDashboardResponse
-func (c *ClientWithResponses) DeleteDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*DeleteDashboardResponse, error) {
-	rsp, err := c.DeleteDashboard(ctx, dashboardId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteDashboardResponse(rsp)
-}
 
-// GetDashboardWithResponse request returning *GetDashboardResponse
-func (c *ClientWithResponses) GetDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error) {
-	rsp, err := c.GetDashboard(ctx, dashboardId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetDashboardResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateDashboardWithBodyWithResponse request with arbitrary body returning *UpdateDashboardResponse
-func (c *ClientWithResponses) UpdateDashboardWithBodyWithResponse(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error) {
-	rsp, err := c.UpdateDashboardWithBody(ctx, dashboardId, contentType, body, reqEditors...)
+// ParseDeleteDashboardResponse parses an HTTP response from a DeleteDashboardWithResponse call
+func ParseDeleteDashboardResponse(rsp *http.Response) (*DeleteDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDashboardResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateDashboardWithResponse(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error) {
-	rsp, err := c.UpdateDashboard(ctx, dashboardId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateDashboardResponse(rsp)
+
+	return response, nil
 }
 
-// ListDatabasesWithResponse request returning *ListDatabasesResponse
-func (c *ClientWithResponses) ListDatabasesWithResponse(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error) {
-	rsp, err := c.ListDatabases(ctx, params, reqEditors...)
+// ParseGetDashboardResponse parses an HTTP response from a GetDashboardWithResponse call
+func ParseGetDashboardResponse(rsp *http.Response) (*GetDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDatabasesResponse(rsp)
-}
 
-// CreateDatabaseWithBodyWithResponse request with arbitrary body returning *CreateDatabaseResponse
-func (c *ClientWithResponses) CreateDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
-	rsp, err := c.CreateDatabaseWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateDatabaseResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateDatabaseWithResponse(ctx context.Context, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
-	rsp, err := c.CreateDatabase(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateDatabaseResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteDatabaseWithResponse request returning *DeleteDatabaseResponse
-func (c *ClientWithResponses) DeleteDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error) {
-	rsp, err := c.DeleteDatabase(ctx, databaseId, reqEditors...)
+// ParseUpdateDashboardResponse parses an HTTP response from a UpdateDashboardWithResponse call
+func ParseUpdateDashboardResponse(rsp *http.Response) (*UpdateDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDatabaseResponse(rsp)
-}
 
-// GetDatabaseWithResponse request returning *GetDatabaseResponse
-func (c *ClientWithResponses) GetDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error) {
-	rsp, err := c.GetDatabase(ctx, databaseId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetDatabaseResponse(rsp)
-}
 
-// UpdateDatabaseWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseResponse
-func (c *ClientWithResponses) UpdateDatabaseWithBodyWithResponse(ctx context.Context, databaseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
-	rsp, err := c.UpdateDatabaseWithBody(ctx, databaseId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateDatabaseResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateDatabaseWithResponse(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
-	rsp, err := c.UpdateDatabase(ctx, databaseId, body, reqEditors...)
+// ParseCopyDashboardResponse parses an HTTP response from a CopyDashboardWithResponse call
+func ParseCopyDashboardResponse(rsp *http.Response) (*CopyDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDatabaseResponse(rsp)
-}
 
-// GetContentTranslationCsvWithResponse request returning *GetContentTranslationCsvResponse
-func (c *ClientWithResponses) GetContentTranslationCsvWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetContentTranslationCsvResponse, error) {
-	rsp, err := c.GetContentTranslationCsv(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CopyDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetContentTranslationCsvResponse(rsp)
-}
 
-// GetContentTranslationDictionaryWithResponse request returning *GetContentTranslationDictionaryResponse
-func (c *ClientWithResponses) GetContentTranslationDictionaryWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetContentTranslationDictionaryResponse, error) {
-	rsp, err := c.GetContentTranslationDictionary(ctx, token, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetContentTranslationDictionaryResponse(rsp)
+
+	return response, nil
 }
 
-// UploadContentTranslationDictionaryWithBodyWithResponse request with arbitrary body returning *UploadContentTranslationDictionaryResponse
-func (c *ClientWithResponses) UploadContentTranslationDictionaryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadContentTranslationDictionaryResponse, error) {
-	rsp, err := c.UploadContentTranslationDictionaryWithBody(ctx, contentType, body, reqEditors...)
+// ParseDeleteDashboardPublicLinkResponse parses an HTTP response from a DeleteDashboardPublicLinkWithResponse call
+func ParseDeleteDashboardPublicLinkResponse(rsp *http.Response) (*DeleteDashboardPublicLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUploadContentTranslationDictionaryResponse(rsp)
-}
 
-// GetFieldWithResponse request returning *GetFieldResponse
-func (c *ClientWithResponses) GetFieldWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*GetFieldResponse, error) {
-	rsp, err := c.GetField(ctx, fieldId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteDashboardPublicLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetFieldResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateFieldWithBodyWithResponse request with arbitrary body returning *UpdateFieldResponse
-func (c *ClientWithResponses) UpdateFieldWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error) {
-	rsp, err := c.UpdateFieldWithBody(ctx, fieldId, contentType, body, reqEditors...)
+// ParseCreateDashboardPublicLinkResponse parses an HTTP response from a CreateDashboardPublicLinkWithResponse call
+func ParseCreateDashboardPublicLinkResponse(rsp *http.Response) (*CreateDashboardPublicLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateFieldResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateFieldWithResponse(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error) {
-	rsp, err := c.UpdateField(ctx, fieldId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateDashboardPublicLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateFieldResponse(rsp)
-}
 
-// GetPermissionsGraphWithResponse request returning *GetPermissionsGraphResponse
-func (c *ClientWithResponses) GetPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPermissionsGraphResponse, error) {
-	rsp, err := c.GetPermissionsGraph(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PublicLink
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetPermissionsGraphResponse(rsp)
+
+	return response, nil
 }
 
-// ReplacePermissionsGraphWithBodyWithResponse request with arbitrary body returning *ReplacePermissionsGraphResponse
-func (c *ClientWithResponses) ReplacePermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error) {
-	rsp, err := c.ReplacePermissionsGraphWithBody(ctx, contentType, body, reqEditors...)
+// ParseListDatabasesResponse parses an HTTP response from a ListDatabasesWithResponse call
+func ParseListDatabasesResponse(rsp *http.Response) (*ListDatabasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseReplacePermissionsGraphResponse(rsp)
-}
 
-func (c *ClientWithResponses) ReplacePermissionsGraphWithResponse(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error) {
-	rsp, err := c.ReplacePermissionsGraph(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListDatabasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseReplacePermissionsGraphResponse(rsp)
-}
 
-// CreatePermissionsGroupWithBodyWithResponse request with arbitrary body returning *CreatePermissionsGroupResponse
-func (c *ClientWithResponses) CreatePermissionsGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error) {
-	rsp, err := c.CreatePermissionsGroupWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreatePermissionsGroupResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreatePermissionsGroupWithResponse(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error) {
-	rsp, err := c.CreatePermissionsGroup(ctx, body, reqEditors...)
+// ParseCreateDatabaseResponse parses an HTTP response from a CreateDatabaseWithResponse call
+func ParseCreateDatabaseResponse(rsp *http.Response) (*CreateDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePermissionsGroupResponse(rsp)
-}
 
-// DeletePermissionsGroupWithResponse request returning *DeletePermissionsGroupResponse
-func (c *ClientWithResponses) DeletePermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*DeletePermissionsGroupResponse, error) {
-	rsp, err := c.DeletePermissionsGroup(ctx, groupId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeletePermissionsGroupResponse(rsp)
-}
 
-// GetPermissionsGroupWithResponse request returning *GetPermissionsGroupResponse
-func (c *ClientWithResponses) GetPermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*GetPermissionsGroupResponse, error) {
-	rsp, err := c.GetPermissionsGroup(ctx, groupId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Database
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetPermissionsGroupResponse(rsp)
+
+	return response, nil
 }
 
-// UpdatePermissionsGroupWithBodyWithResponse request with arbitrary body returning *UpdatePermissionsGroupResponse
-func (c *ClientWithResponses) UpdatePermissionsGroupWithBodyWithResponse(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error) {
-	rsp, err := c.UpdatePermissionsGroupWithBody(ctx, groupId, contentType, body, reqEditors...)
+// ParseDeleteDatabaseResponse parses an HTTP response from a DeleteDatabaseWithResponse call
+func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePermissionsGroupResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdatePermissionsGroupWithResponse(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error) {
-	rsp, err := c.UpdatePermissionsGroup(ctx, groupId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdatePermissionsGroupResponse(rsp)
+
+	return response, nil
 }
 
-// CreateSessionWithBodyWithResponse request with arbitrary body returning *CreateSessionResponse
-func (c *ClientWithResponses) CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
-	rsp, err := c.CreateSessionWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetDatabaseResponse parses an HTTP response from a GetDatabaseWithResponse call
+func ParseGetDatabaseResponse(rsp *http.Response) (*GetDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateSessionResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
-	rsp, err := c.CreateSession(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateSessionResponse(rsp)
-}
 
-// ListTablesWithResponse request returning *ListTablesResponse
-func (c *ClientWithResponses) ListTablesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTablesResponse, error) {
-	rsp, err := c.ListTables(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Database
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseListTablesResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateTableWithBodyWithResponse request with arbitrary body returning *UpdateTableResponse
-func (c *ClientWithResponses) UpdateTableWithBodyWithResponse(ctx context.Context, tableId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error) {
-	rsp, err := c.UpdateTableWithBody(ctx, tableId, contentType, body, reqEditors...)
+// ParseUpdateDatabaseResponse parses an HTTP response from a UpdateDatabaseWithResponse call
+func ParseUpdateDatabaseResponse(rsp *http.Response) (*UpdateDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateTableResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTableWithResponse(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error) {
-	rsp, err := c.UpdateTable(ctx, tableId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateTableResponse(rsp)
-}
 
-// GetTableMetadataWithResponse request returning *GetTableMetadataResponse
-func (c *ClientWithResponses) GetTableMetadataWithResponse(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*GetTableMetadataResponse, error) {
-	rsp, err := c.GetTableMetadata(ctx, tableId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Database
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetTableMetadataResponse(rsp)
+
+	return response, nil
 }
 
-// ListUsersWithResponse request returning *ListUsersResponse
-func (c *ClientWithResponses) ListUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUsersResponse, error) {
-	rsp, err := c.ListUsers(ctx, reqEditors...)
+// ParseListImpersonationsResponse parses an HTTP response from a ListImpersonationsWithResponse call
+func ParseListImpersonationsResponse(rsp *http.Response) (*ListImpersonationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListUsersResponse(rsp)
-}
 
-// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
-func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
-	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListImpersonationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateUserResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
-	rsp, err := c.CreateUser(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ConnectionImpersonation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateUserResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteUserWithResponse request returning *DeleteUserResponse
-func (c *ClientWithResponses) DeleteUserWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
-	rsp, err := c.DeleteUser(ctx, userId, reqEditors...)
+// ParseDeleteImpersonationResponse parses an HTTP response from a DeleteImpersonationWithResponse call
+func ParseDeleteImpersonationResponse(rsp *http.Response) (*DeleteImpersonationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteUserResponse(rsp)
-}
 
-// GetUserWithResponse request returning *GetUserResponse
-func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
-	rsp, err := c.GetUser(ctx, userId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteImpersonationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetUserResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateUserWithBodyWithResponse request with arbitrary body returning *UpdateUserResponse
-func (c *ClientWithResponses) UpdateUserWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
-	rsp, err := c.UpdateUserWithBody(ctx, userId, contentType, body, reqEditors...)
+// ParseGetContentTranslationCsvResponse parses an HTTP response from a GetContentTranslationCsvWithResponse call
+func ParseGetContentTranslationCsvResponse(rsp *http.Response) (*GetContentTranslationCsvResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateUserResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateUserWithResponse(ctx context.Context, userId int, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
-	rsp, err := c.UpdateUser(ctx, userId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetContentTranslationCsvResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateUserResponse(rsp)
+
+	return response, nil
 }
 
-// ParseCreateCardResponse parses an HTTP response from a CreateCardWithResponse call
-func ParseCreateCardResponse(rsp *http.Response) (*CreateCardResponse, error) {
+// ParseGetContentTranslationDictionaryResponse parses an HTTP response from a GetContentTranslationDictionaryWithResponse call
+func ParseGetContentTranslationDictionaryResponse(rsp *http.Response) (*GetContentTranslationDictionaryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCardResponse{
+	response := &GetContentTranslationDictionaryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Card
+		var dest map[string]interface{}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5470,22 +11752,26 @@ func ParseCreateCardResponse(rsp *http.Response) (*CreateCardResponse, error) {
 	return response, nil
 }
 
-// ParseGetCardResponse parses an HTTP response from a GetCardWithResponse call
-func ParseGetCardResponse(rsp *http.Response) (*GetCardResponse, error) {
+// ParseUploadContentTranslationDictionaryResponse parses an HTTP response from a UploadContentTranslationDictionaryWithResponse call
+func ParseUploadContentTranslationDictionaryResponse(rsp *http.Response) (*UploadContentTranslationDictionaryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCardResponse{
+	response := &UploadContentTranslationDictionaryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Card
+		var dest struct {
+			Message           *string `json:"message,omitempty"`
+			Success           *bool   `json:"success,omitempty"`
+			TranslationsCount *int    `json:"translations_count,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5496,22 +11782,22 @@ func ParseGetCardResponse(rsp *http.Response) (*GetCardResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateCardResponse parses an HTTP response from a UpdateCardWithResponse call
-func ParseUpdateCardResponse(rsp *http.Response) (*UpdateCardResponse, error) {
+// ParseGetFieldResponse parses an HTTP response from a GetFieldWithResponse call
+func ParseGetFieldResponse(rsp *http.Response) (*GetFieldResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateCardResponse{
+	response := &GetFieldResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Card
+		var dest Field
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5521,23 +11807,23 @@ func ParseUpdateCardResponse(rsp *http.Response) (*UpdateCardResponse, error) {
 
 	return response, nil
 }
-
-// ParseListCollectionsResponse parses an HTTP response from a ListCollectionsWithResponse call
-func ParseListCollectionsResponse(rsp *http.Response) (*ListCollectionsResponse, error) {
+
+// ParseUpdateFieldResponse parses an HTTP response from a UpdateFieldWithResponse call
+func ParseUpdateFieldResponse(rsp *http.Response) (*UpdateFieldResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCollectionsResponse{
+	response := &UpdateFieldResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Collection
+		var dest Field
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5548,22 +11834,22 @@ func ParseListCollectionsResponse(rsp *http.Response) (*ListCollectionsResponse,
 	return response, nil
 }
 
-// ParseCreateCollectionResponse parses an HTTP response from a CreateCollectionWithResponse call
-func ParseCreateCollectionResponse(rsp *http.Response) (*CreateCollectionResponse, error) {
+// ParseCreateSandboxResponse parses an HTTP response from a CreateSandboxWithResponse call
+func ParseCreateSandboxResponse(rsp *http.Response) (*CreateSandboxResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCollectionResponse{
+	response := &CreateSandboxResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Collection
+		var dest Sandbox
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5574,48 +11860,38 @@ func ParseCreateCollectionResponse(rsp *http.Response) (*CreateCollectionRespons
 	return response, nil
 }
 
-// ParseGetCollectionPermissionsGraphResponse parses an HTTP response from a GetCollectionPermissionsGraphWithResponse call
-func ParseGetCollectionPermissionsGraphResponse(rsp *http.Response) (*GetCollectionPermissionsGraphResponse, error) {
+// ParseDeleteSandboxResponse parses an HTTP response from a DeleteSandboxWithResponse call
+func ParseDeleteSandboxResponse(rsp *http.Response) (*DeleteSandboxResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCollectionPermissionsGraphResponse{
+	response := &DeleteSandboxResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CollectionPermissionsGraph
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseReplaceCollectionPermissionsGraphResponse parses an HTTP response from a ReplaceCollectionPermissionsGraphWithResponse call
-func ParseReplaceCollectionPermissionsGraphResponse(rsp *http.Response) (*ReplaceCollectionPermissionsGraphResponse, error) {
+// ParseGetSandboxResponse parses an HTTP response from a GetSandboxWithResponse call
+func ParseGetSandboxResponse(rsp *http.Response) (*GetSandboxResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReplaceCollectionPermissionsGraphResponse{
+	response := &GetSandboxResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CollectionPermissionsGraph
+		var dest Sandbox
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5626,22 +11902,22 @@ func ParseReplaceCollectionPermissionsGraphResponse(rsp *http.Response) (*Replac
 	return response, nil
 }
 
-// ParseGetCollectionResponse parses an HTTP response from a GetCollectionWithResponse call
-func ParseGetCollectionResponse(rsp *http.Response) (*GetCollectionResponse, error) {
+// ParseUpdateSandboxResponse parses an HTTP response from a UpdateSandboxWithResponse call
+func ParseUpdateSandboxResponse(rsp *http.Response) (*UpdateSandboxResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCollectionResponse{
+	response := &UpdateSandboxResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Collection
+		var dest Sandbox
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5652,22 +11928,22 @@ func ParseGetCollectionResponse(rsp *http.Response) (*GetCollectionResponse, err
 	return response, nil
 }
 
-// ParseUpdateCollectionResponse parses an HTTP response from a UpdateCollectionWithResponse call
-func ParseUpdateCollectionResponse(rsp *http.Response) (*UpdateCollectionResponse, error) {
+// ParseGetPermissionsGraphResponse parses an HTTP response from a GetPermissionsGraphWithResponse call
+func ParseGetPermissionsGraphResponse(rsp *http.Response) (*GetPermissionsGraphResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateCollectionResponse{
+	response := &GetPermissionsGraphResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Collection
+		var dest PermissionsGraph
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5678,22 +11954,22 @@ func ParseUpdateCollectionResponse(rsp *http.Response) (*UpdateCollectionRespons
 	return response, nil
 }
 
-// ParseListCollectionItemsResponse parses an HTTP response from a ListCollectionItemsWithResponse call
-func ParseListCollectionItemsResponse(rsp *http.Response) (*ListCollectionItemsResponse, error) {
+// ParseReplacePermissionsGraphResponse parses an HTTP response from a ReplacePermissionsGraphWithResponse call
+func ParseReplacePermissionsGraphResponse(rsp *http.Response) (*ReplacePermissionsGraphResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCollectionItemsResponse{
+	response := &ReplacePermissionsGraphResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CollectionItemList
+		var dest PermissionsGraph
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5704,22 +11980,22 @@ func ParseListCollectionItemsResponse(rsp *http.Response) (*ListCollectionItemsR
 	return response, nil
 }
 
-// ParseCreateDashboardResponse parses an HTTP response from a CreateDashboardWithResponse call
-func ParseCreateDashboardResponse(rsp *http.Response) (*CreateDashboardResponse, error) {
+// ParseListPermissionsGroupsResponse parses an HTTP response from a ListPermissionsGroupsWithResponse call
+func ParseListPermissionsGroupsResponse(rsp *http.Response) (*ListPermissionsGroupsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDashboardResponse{
+	response := &ListPermissionsGroupsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Dashboard
+		var dest []PermissionsGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5730,64 +12006,64 @@ func ParseCreateDashboardResponse(rsp *http.Response) (*CreateDashboardResponse,
 	return response, nil
 }
 
-// ParseDeleteDashboardResponse parses an HTTP response from a DeleteDashboardWithResponse call
-func ParseDeleteDashboardResponse(rsp *http.Response) (*DeleteDashboardResponse, error) {
+// ParseCreatePermissionsGroupResponse parses an HTTP response from a CreatePermissionsGroupWithResponse call
+func ParseCreatePermissionsGroupResponse(rsp *http.Response) (*CreatePermissionsGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDashboardResponse{
+	response := &CreatePermissionsGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PermissionsGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetDashboardResponse parses an HTTP response from a GetDashboardWithResponse call
-func ParseGetDashboardResponse(rsp *http.Response) (*GetDashboardResponse, error) {
+// ParseDeletePermissionsGroupResponse parses an HTTP response from a DeletePermissionsGroupWithResponse call
+func ParseDeletePermissionsGroupResponse(rsp *http.Response) (*DeletePermissionsGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDashboardResponse{
+	response := &DeletePermissionsGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Dashboard
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseUpdateDashboardResponse parses an HTTP response from a UpdateDashboardWithResponse call
-func ParseUpdateDashboardResponse(rsp *http.Response) (*UpdateDashboardResponse, error) {
+// ParseGetPermissionsGroupResponse parses an HTTP response from a GetPermissionsGroupWithResponse call
+func ParseGetPermissionsGroupResponse(rsp *http.Response) (*GetPermissionsGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDashboardResponse{
+	response := &GetPermissionsGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Dashboard
+		var dest PermissionsGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5798,22 +12074,22 @@ func ParseUpdateDashboardResponse(rsp *http.Response) (*UpdateDashboardResponse,
 	return response, nil
 }
 
-// ParseListDatabasesResponse parses an HTTP response from a ListDatabasesWithResponse call
-func ParseListDatabasesResponse(rsp *http.Response) (*ListDatabasesResponse, error) {
+// ParseUpdatePermissionsGroupResponse parses an HTTP response from a UpdatePermissionsGroupWithResponse call
+func ParseUpdatePermissionsGroupResponse(rsp *http.Response) (*UpdatePermissionsGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabasesResponse{
+	response := &UpdatePermissionsGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseList
+		var dest PermissionsGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5824,22 +12100,22 @@ func ParseListDatabasesResponse(rsp *http.Response) (*ListDatabasesResponse, err
 	return response, nil
 }
 
-// ParseCreateDatabaseResponse parses an HTTP response from a CreateDatabaseWithResponse call
-func ParseCreateDatabaseResponse(rsp *http.Response) (*CreateDatabaseResponse, error) {
+// ParseCreatePermissionsMembershipResponse parses an HTTP response from a CreatePermissionsMembershipWithResponse call
+func ParseCreatePermissionsMembershipResponse(rsp *http.Response) (*CreatePermissionsMembershipResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseResponse{
+	response := &CreatePermissionsMembershipResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Database
+		var dest []PermissionsGroupMember
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5850,15 +12126,15 @@ func ParseCreateDatabaseResponse(rsp *http.Response) (*CreateDatabaseResponse, e
 	return response, nil
 }
 
-// ParseDeleteDatabaseResponse parses an HTTP response from a DeleteDatabaseWithResponse call
-func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, error) {
+// ParseDeletePermissionsMembershipResponse parses an HTTP response from a DeletePermissionsMembershipWithResponse call
+func ParseDeletePermissionsMembershipResponse(rsp *http.Response) (*DeletePermissionsMembershipResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseResponse{
+	response := &DeletePermissionsMembershipResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5866,22 +12142,22 @@ func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, e
 	return response, nil
 }
 
-// ParseGetDatabaseResponse parses an HTTP response from a GetDatabaseWithResponse call
-func ParseGetDatabaseResponse(rsp *http.Response) (*GetDatabaseResponse, error) {
+// ParseUpdatePermissionsMembershipResponse parses an HTTP response from a UpdatePermissionsMembershipWithResponse call
+func ParseUpdatePermissionsMembershipResponse(rsp *http.Response) (*UpdatePermissionsMembershipResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseResponse{
+	response := &UpdatePermissionsMembershipResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Database
+		var dest PermissionsMembership
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5892,22 +12168,22 @@ func ParseGetDatabaseResponse(rsp *http.Response) (*GetDatabaseResponse, error)
 	return response, nil
 }
 
-// ParseUpdateDatabaseResponse parses an HTTP response from a UpdateDatabaseWithResponse call
-func ParseUpdateDatabaseResponse(rsp *http.Response) (*UpdateDatabaseResponse, error) {
+// ParseCreatePulseResponse parses an HTTP response from a CreatePulseWithResponse call
+func ParseCreatePulseResponse(rsp *http.Response) (*CreatePulseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatabaseResponse{
+	response := &CreatePulseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Database
+		var dest Pulse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5918,38 +12194,48 @@ func ParseUpdateDatabaseResponse(rsp *http.Response) (*UpdateDatabaseResponse, e
 	return response, nil
 }
 
-// ParseGetContentTranslationCsvResponse parses an HTTP response from a GetContentTranslationCsvWithResponse call
-func ParseGetContentTranslationCsvResponse(rsp *http.Response) (*GetContentTranslationCsvResponse, error) {
+// ParseGetPulseResponse parses an HTTP response from a GetPulseWithResponse call
+func ParseGetPulseResponse(rsp *http.Response) (*GetPulseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetContentTranslationCsvResponse{
+	response := &GetPulseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Pulse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetContentTranslationDictionaryResponse parses an HTTP response from a GetContentTranslationDictionaryWithResponse call
-func ParseGetContentTranslationDictionaryResponse(rsp *http.Response) (*GetContentTranslationDictionaryResponse, error) {
+// ParseUpdatePulseResponse parses an HTTP response from a UpdatePulseWithResponse call
+func ParseUpdatePulseResponse(rsp *http.Response) (*UpdatePulseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetContentTranslationDictionaryResponse{
+	response := &UpdatePulseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
+		var dest Pulse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5960,26 +12246,22 @@ func ParseGetContentTranslationDictionaryResponse(rsp *http.Response) (*GetConte
 	return response, nil
 }
 
-// ParseUploadContentTranslationDictionaryResponse parses an HTTP response from a UploadContentTranslationDictionaryWithResponse call
-func ParseUploadContentTranslationDictionaryResponse(rsp *http.Response) (*UploadContentTranslationDictionaryResponse, error) {
+// ParseListRevisionsResponse parses an HTTP response from a ListRevisionsWithResponse call
+func ParseListRevisionsResponse(rsp *http.Response) (*ListRevisionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UploadContentTranslationDictionaryResponse{
+	response := &ListRevisionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Message           *string `json:"message,omitempty"`
-			Success           *bool   `json:"success,omitempty"`
-			TranslationsCount *int    `json:"translations_count,omitempty"`
-		}
+		var dest []Revision
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5990,22 +12272,22 @@ func ParseUploadContentTranslationDictionaryResponse(rsp *http.Response) (*Uploa
 	return response, nil
 }
 
-// ParseGetFieldResponse parses an HTTP response from a GetFieldWithResponse call
-func ParseGetFieldResponse(rsp *http.Response) (*GetFieldResponse, error) {
+// ParseRevertRevisionResponse parses an HTTP response from a RevertRevisionWithResponse call
+func ParseRevertRevisionResponse(rsp *http.Response) (*RevertRevisionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFieldResponse{
+	response := &RevertRevisionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Field
+		var dest Revision
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6016,22 +12298,22 @@ func ParseGetFieldResponse(rsp *http.Response) (*GetFieldResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateFieldResponse parses an HTTP response from a UpdateFieldWithResponse call
-func ParseUpdateFieldResponse(rsp *http.Response) (*UpdateFieldResponse, error) {
+// ParseCreateSessionResponse parses an HTTP response from a CreateSessionWithResponse call
+func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateFieldResponse{
+	response := &CreateSessionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Field
+		var dest Session
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6042,48 +12324,38 @@ func ParseUpdateFieldResponse(rsp *http.Response) (*UpdateFieldResponse, error)
 	return response, nil
 }
 
-// ParseGetPermissionsGraphResponse parses an HTTP response from a GetPermissionsGraphWithResponse call
-func ParseGetPermissionsGraphResponse(rsp *http.Response) (*GetPermissionsGraphResponse, error) {
+// ParseForgotPasswordResponse parses an HTTP response from a ForgotPasswordWithResponse call
+func ParseForgotPasswordResponse(rsp *http.Response) (*ForgotPasswordResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetPermissionsGraphResponse{
+	response := &ForgotPasswordResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PermissionsGraph
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseReplacePermissionsGraphResponse parses an HTTP response from a ReplacePermissionsGraphWithResponse call
-func ParseReplacePermissionsGraphResponse(rsp *http.Response) (*ReplacePermissionsGraphResponse, error) {
+// ParseListTablesResponse parses an HTTP response from a ListTablesWithResponse call
+func ParseListTablesResponse(rsp *http.Response) (*ListTablesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReplacePermissionsGraphResponse{
+	response := &ListTablesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PermissionsGraph
+		var dest []Table
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6094,22 +12366,22 @@ func ParseReplacePermissionsGraphResponse(rsp *http.Response) (*ReplacePermissio
 	return response, nil
 }
 
-// ParseCreatePermissionsGroupResponse parses an HTTP response from a CreatePermissionsGroupWithResponse call
-func ParseCreatePermissionsGroupResponse(rsp *http.Response) (*CreatePermissionsGroupResponse, error) {
+// ParseUpdateTableResponse parses an HTTP response from a UpdateTableWithResponse call
+func ParseUpdateTableResponse(rsp *http.Response) (*UpdateTableResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreatePermissionsGroupResponse{
+	response := &UpdateTableResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PermissionsGroup
+		var dest Table
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6120,38 +12392,48 @@ func ParseCreatePermissionsGroupResponse(rsp *http.Response) (*CreatePermissions
 	return response, nil
 }
 
-// ParseDeletePermissionsGroupResponse parses an HTTP response from a DeletePermissionsGroupWithResponse call
-func ParseDeletePermissionsGroupResponse(rsp *http.Response) (*DeletePermissionsGroupResponse, error) {
+// ParseGetTableMetadataResponse parses an HTTP response from a GetTableMetadataWithResponse call
+func ParseGetTableMetadataResponse(rsp *http.Response) (*GetTableMetadataResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeletePermissionsGroupResponse{
+	response := &GetTableMetadataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TableMetadata
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetPermissionsGroupResponse parses an HTTP response from a GetPermissionsGroupWithResponse call
-func ParseGetPermissionsGroupResponse(rsp *http.Response) (*GetPermissionsGroupResponse, error) {
+// ParseCreateTimelineResponse parses an HTTP response from a CreateTimelineWithResponse call
+func ParseCreateTimelineResponse(rsp *http.Response) (*CreateTimelineResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetPermissionsGroupResponse{
+	response := &CreateTimelineResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PermissionsGroup
+		var dest Timeline
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6162,22 +12444,22 @@ func ParseGetPermissionsGroupResponse(rsp *http.Response) (*GetPermissionsGroupR
 	return response, nil
 }
 
-// ParseUpdatePermissionsGroupResponse parses an HTTP response from a UpdatePermissionsGroupWithResponse call
-func ParseUpdatePermissionsGroupResponse(rsp *http.Response) (*UpdatePermissionsGroupResponse, error) {
+// ParseCreateTimelineEventResponse parses an HTTP response from a CreateTimelineEventWithResponse call
+func ParseCreateTimelineEventResponse(rsp *http.Response) (*CreateTimelineEventResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdatePermissionsGroupResponse{
+	response := &CreateTimelineEventResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PermissionsGroup
+		var dest TimelineEvent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6188,22 +12470,22 @@ func ParseUpdatePermissionsGroupResponse(rsp *http.Response) (*UpdatePermissions
 	return response, nil
 }
 
-// ParseCreateSessionResponse parses an HTTP response from a CreateSessionWithResponse call
-func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, error) {
+// ParseGetTimelineEventResponse parses an HTTP response from a GetTimelineEventWithResponse call
+func ParseGetTimelineEventResponse(rsp *http.Response) (*GetTimelineEventResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateSessionResponse{
+	response := &GetTimelineEventResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Session
+		var dest TimelineEvent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6214,22 +12496,22 @@ func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, err
 	return response, nil
 }
 
-// ParseListTablesResponse parses an HTTP response from a ListTablesWithResponse call
-func ParseListTablesResponse(rsp *http.Response) (*ListTablesResponse, error) {
+// ParseUpdateTimelineEventResponse parses an HTTP response from a UpdateTimelineEventWithResponse call
+func ParseUpdateTimelineEventResponse(rsp *http.Response) (*UpdateTimelineEventResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListTablesResponse{
+	response := &UpdateTimelineEventResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Table
+		var dest TimelineEvent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6240,22 +12522,22 @@ func ParseListTablesResponse(rsp *http.Response) (*ListTablesResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateTableResponse parses an HTTP response from a UpdateTableWithResponse call
-func ParseUpdateTableResponse(rsp *http.Response) (*UpdateTableResponse, error) {
+// ParseGetTimelineResponse parses an HTTP response from a GetTimelineWithResponse call
+func ParseGetTimelineResponse(rsp *http.Response) (*GetTimelineResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateTableResponse{
+	response := &GetTimelineResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Table
+		var dest Timeline
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6266,22 +12548,22 @@ func ParseUpdateTableResponse(rsp *http.Response) (*UpdateTableResponse, error)
 	return response, nil
 }
 
-// ParseGetTableMetadataResponse parses an HTTP response from a GetTableMetadataWithResponse call
-func ParseGetTableMetadataResponse(rsp *http.Response) (*GetTableMetadataResponse, error) {
+// ParseUpdateTimelineResponse parses an HTTP response from a UpdateTimelineWithResponse call
+func ParseUpdateTimelineResponse(rsp *http.Response) (*UpdateTimelineResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTableMetadataResponse{
+	response := &UpdateTimelineResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TableMetadata
+		var dest Timeline
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6307,7 +12589,7 @@ func ParseListUsersResponse(rsp *http.Response) (*ListUsersResponse, error) {
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []User
+		var dest UserList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6411,3 +12693,45 @@ func ParseUpdateUserResponse(rsp *http.Response) (*UpdateUserResponse, error) {
 
 	return response, nil
 }
+
+// ParseReactivateUserResponse parses an HTTP response from a ReactivateUserWithResponse call
+func ParseReactivateUserResponse(rsp *http.Response) (*ReactivateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReactivateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSendUserInviteResponse parses an HTTP response from a SendUserInviteWithResponse call
+func ParseSendUserInviteResponse(rsp *http.Response) (*SendUserInviteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SendUserInviteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
@@ -54,3 +54,11 @@ func (c *ClientWithResponses) DoHTTPRequest(ctx context.Context, method, path st
 
 	return client.Do(req)
 }
+
+// SiteUrl returns the base URL of the Metabase instance, derived from the API endpoint by removing the `/api` suffix.
+// This is used to build links to Metabase pages, e.g. public links.
+func (c *ClientWithResponses) SiteUrl() string {
+	_, server, _ := c.GetHTTPClient()
+
+	return strings.TrimSuffix(strings.TrimSuffix(server, "/"), "/api")
+}
@@ -8,6 +8,86 @@ type MetabaseResponse interface {
 	HasExpectedStatusWithoutExpectedBody() bool
 }
 
+func (r *CreateActionResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreateActionResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *GetActionResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetActionResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *UpdateActionResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UpdateActionResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *DeleteActionResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *DeleteActionResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *CreateAlertResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreateAlertResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *GetAlertResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetAlertResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *UpdateAlertResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UpdateAlertResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *ListCacheConfigsResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ListCacheConfigsResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *UpdateCacheConfigResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UpdateCacheConfigResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *DeleteCacheConfigResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *DeleteCacheConfigResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
 func (r *CreateCardResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -48,6 +128,22 @@ func (r *ReplaceCollectionPermissionsGraphResponse) HasExpectedStatusWithoutExpe
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *CreateCardPublicLinkResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreateCardPublicLinkResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *DeleteCardPublicLinkResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *DeleteCardPublicLinkResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
 func (r *CreateCollectionResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -56,6 +152,14 @@ func (r *CreateCollectionResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *ListCollectionsResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ListCollectionsResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *GetCollectionResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -112,6 +216,30 @@ func (r *DeleteDashboardResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return false
 }
 
+func (r *CopyDashboardResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CopyDashboardResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *CreateDashboardPublicLinkResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreateDashboardPublicLinkResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *DeleteDashboardPublicLinkResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *DeleteDashboardPublicLinkResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
 func (r *CreateDatabaseResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -120,6 +248,14 @@ func (r *CreateDatabaseResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *ListDatabasesResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ListDatabasesResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *GetDatabaseResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -160,6 +296,14 @@ func (r *ReplacePermissionsGraphResponse) HasExpectedStatusWithoutExpectedBody()
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *ListPermissionsGroupsResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ListPermissionsGroupsResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *CreatePermissionsGroupResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -192,6 +336,70 @@ func (r *DeletePermissionsGroupResponse) HasExpectedStatusWithoutExpectedBody()
 	return false
 }
 
+func (r *CreatePermissionsMembershipResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreatePermissionsMembershipResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *UpdatePermissionsMembershipResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UpdatePermissionsMembershipResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *DeletePermissionsMembershipResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *DeletePermissionsMembershipResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *CreatePulseResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreatePulseResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *GetPulseResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetPulseResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *UpdatePulseResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UpdatePulseResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *ListRevisionsResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ListRevisionsResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *RevertRevisionResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *RevertRevisionResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *CreateSessionResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -200,6 +408,14 @@ func (r *CreateSessionResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *ForgotPasswordResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ForgotPasswordResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
 func (r *ListTablesResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -240,6 +456,54 @@ func (r *UpdateFieldResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *CreateTimelineResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreateTimelineResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *GetTimelineResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetTimelineResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *UpdateTimelineResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UpdateTimelineResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *CreateTimelineEventResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreateTimelineEventResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *GetTimelineEventResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetTimelineEventResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *UpdateTimelineEventResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UpdateTimelineEventResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *GetContentTranslationCsvResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -264,6 +528,54 @@ func (r *UploadContentTranslationDictionaryResponse) HasExpectedStatusWithoutExp
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *ListImpersonationsResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ListImpersonationsResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *DeleteImpersonationResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *DeleteImpersonationResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *CreateSandboxResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreateSandboxResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *GetSandboxResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetSandboxResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *UpdateSandboxResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UpdateSandboxResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *DeleteSandboxResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *DeleteSandboxResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
 func (r *CreateUserResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -272,6 +584,14 @@ func (r *CreateUserResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *ListUsersResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ListUsersResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *GetUserResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -295,3 +615,19 @@ func (r *DeleteUserResponse) BodyString() string {
 func (r *DeleteUserResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return false
 }
+
+func (r *ReactivateUserResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ReactivateUserResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *SendUserInviteResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *SendUserInviteResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
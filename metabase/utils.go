@@ -1,8 +1,17 @@
 package metabase
 
+// The default ID of the `All Users` permissions group, to which all users automatically belong.
+const AllUsersPermissionsGroupId = 1
+
 // The default ID of the `Administrators` permissions group, created automatically by Terraform.
 const AdministratorsPermissionsGroupId = 2
 
+// The `magic_group_type` of the `All Users` permissions group.
+const AllUsersMagicGroupType = "all-internal-users"
+
+// The `magic_group_type` of the `Administrators` permissions group.
+const AdministratorsMagicGroupType = "admin"
+
 // The ID of the `Metabase Analytics` database, automatically created for pro plans.
 const MetabaseAnalyticsDatabaseId = "13371337"
 